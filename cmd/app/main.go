@@ -1,10 +1,15 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
+	"os/user"
+	_ "time/tzdata"
 
+	"expense-tracker/internal/config"
+	"expense-tracker/internal/core/domain"
 	"expense-tracker/internal/core/usecase"
 	"expense-tracker/internal/handler/tui"
 	"expense-tracker/internal/repository/sqlite"
@@ -18,6 +23,43 @@ func main() {
 		log.Fatalf("Failed to get user home directory: %v", err)
 	}
 
+	configPath, err := config.DefaultPath()
+	if err != nil {
+		log.Fatalf("Failed to locate config file: %v", err)
+	}
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		log.Fatalf("Failed to load %s: %v", configPath, err)
+	}
+	if err := tui.ConfigureKeybindings(cfg.KeybindingOverrides()); err != nil {
+		log.Fatalf("Invalid keybindings in %s: %v", configPath, err)
+	}
+	theme, err := loadTheme(cfg.Theme, configPath)
+	if err != nil {
+		log.Fatalf("Failed to load theme: %v", err)
+	}
+	tui.UseTheme(theme)
+	locale, err := domain.NewLocale(cfg.Locale, cfg.Format)
+	if err != nil {
+		log.Fatalf("Invalid locale settings in %s: %v", configPath, err)
+	}
+	tui.UseLocale(locale)
+	timezone, err := domain.LoadTimezone(cfg.Timezone)
+	if err != nil {
+		log.Fatalf("Invalid timezone in %s: %v", configPath, err)
+	}
+	domain.UseTimezone(timezone)
+	if cfg.FutureDays < 0 {
+		log.Fatalf("Invalid future_days in %s: must not be negative", configPath)
+	}
+	tui.UseFutureDays(cfg.FutureDays)
+	if cfg.DuplicateDays < 0 {
+		log.Fatalf("Invalid duplicate_days in %s: must not be negative", configPath)
+	}
+	if cfg.TrashDays < 0 {
+		log.Fatalf("Invalid trash_days in %s: must not be negative", configPath)
+	}
+
 	// Ensure the database directory exists
 	dbDir := homeDir + "/.local/share/expensetracker"
 	if err := os.MkdirAll(dbDir, 0755); err != nil {
@@ -29,14 +71,38 @@ func main() {
 		log.Fatalf("Failed to initialize database: %v", err)
 	}
 	defer db.Close()
+	db.SetActor(currentUser())
 
 	transactionRepo := sqlite.NewTransactionRepository(db)
 	categoryRepo := sqlite.NewCategoryRepository(db)
+	payeeRepo := sqlite.NewPayeeRepository(db)
+	ruleRepo := sqlite.NewRuleRepository(db)
+	auditRepo := sqlite.NewAuditRepository(db)
+	uow := sqlite.NewUnitOfWork(db)
 
-	transactionUseCase := usecase.NewTransactionUseCase(transactionRepo, categoryRepo)
+	learner := usecase.NewCategoryLearner(transactionRepo)
+	history := usecase.NewUndoHistory(transactionRepo, uow, learner, 0)
+	transactionUseCase := usecase.NewTransactionUseCase(transactionRepo, categoryRepo, payeeRepo, ruleRepo, uow, learner, history)
 	summaryUseCase := usecase.NewSummaryUseCase(transactionRepo)
+	payeeUseCase := usecase.NewPayeeUseCase(payeeRepo, transactionRepo, uow)
+	ruleUseCase := usecase.NewRuleUseCase(ruleRepo, transactionRepo, categoryRepo, payeeRepo, learner, history)
+	duplicateUseCase := usecase.NewDuplicateUseCase(transactionRepo, learner, history, cfg.DuplicateDays)
+	reconciliationUseCase := usecase.NewReconciliationUseCase(transactionRepo)
+	auditUseCase := usecase.NewAuditUseCase(auditRepo)
+	trashUseCase := usecase.NewTrashUseCase(transactionRepo, history, cfg.TrashDays)
 
-	model := tui.NewModel(transactionUseCase, summaryUseCase)
+	ctx := context.Background()
+	if err := payeeUseCase.ImportPayees(ctx, cfg.Payees); err != nil {
+		log.Fatalf("Invalid payees in %s: %v", configPath, err)
+	}
+	if _, err := payeeUseCase.NormalizePayees(ctx); err != nil {
+		log.Fatalf("Failed to assign payees: %v", err)
+	}
+	if _, err := trashUseCase.PurgeExpired(ctx); err != nil {
+		log.Fatalf("Failed to empty the trash: %v", err)
+	}
+
+	model := tui.NewModel(transactionUseCase, summaryUseCase, ruleUseCase, duplicateUseCase, reconciliationUseCase, auditUseCase, trashUseCase, history)
 
 	p := tea.NewProgram(model, tea.WithAltScreen())
 
@@ -45,3 +111,41 @@ func main() {
 		os.Exit(1)
 	}
 }
+
+// currentUser is the name the audit log records for changes made in this session
+func currentUser() string {
+	if current, err := user.Current(); err == nil && current.Username != "" {
+		return current.Username
+	}
+	return os.Getenv("USER")
+}
+
+// loadTheme resolves the configured theme. NO_COLOR always wins; "auto" or no setting
+// follows the terminal background; any other unknown name is read from the themes directory.
+func loadTheme(name, configPath string) (tui.Theme, error) {
+	if os.Getenv("NO_COLOR") != "" || name == "" || name == tui.ThemeAuto {
+		return tui.DetectTheme(), nil
+	}
+	if theme, ok := tui.BuiltinTheme(name); ok {
+		return theme, nil
+	}
+
+	path := config.ThemePath(configPath, name)
+	file, err := config.LoadThemeFile(path)
+	if err != nil {
+		return tui.Theme{}, fmt.Errorf("%q is not a built-in theme and %s could not be loaded: %w", name, path, err)
+	}
+
+	base := tui.DetectTheme()
+	if file.Base != "" {
+		var ok bool
+		if base, ok = tui.BuiltinTheme(file.Base); !ok {
+			return tui.Theme{}, fmt.Errorf("%s: unknown base theme %q", path, file.Base)
+		}
+	}
+	theme, err := tui.CustomTheme(name, base, file.Colors, file.CategoryPalette)
+	if err != nil {
+		return tui.Theme{}, fmt.Errorf("%s: %w", path, err)
+	}
+	return theme, nil
+}
@@ -0,0 +1,141 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// FileName is the name of the config file inside the config directory
+const FileName = "config.yaml"
+
+// Config holds the user settings read from the config file. Every section is optional.
+type Config struct {
+	// Theme is "auto", a built-in theme name or the name of a file in the themes directory
+	Theme string `yaml:"theme"`
+
+	// Keybindings maps action names such as "form.save" to the keys that trigger them
+	Keybindings map[string]KeyList `yaml:"keybindings"`
+
+	// Locale is a built-in locale name such as "de-DE"; Format overrides single settings of it
+	Locale string            `yaml:"locale"`
+	Format map[string]string `yaml:"format"`
+
+	// Timezone is the IANA name of the timezone days and periods are taken in, such as
+	// "Europe/Berlin"; empty uses the system's
+	Timezone string `yaml:"timezone"`
+
+	// FutureDays is how many days ahead dates typed into forms may lie; 0 rejects future dates
+	FutureDays int `yaml:"future_days"`
+
+	// DuplicateDays is how many days apart two entries with the same amount may be dated
+	// to be flagged as possible duplicates; 0 keeps the default of 3
+	DuplicateDays int `yaml:"duplicate_days"`
+
+	// TrashDays is how many days deleted transactions stay in the trash before they are
+	// purged for good; 0 keeps the default of 30
+	TrashDays int `yaml:"trash_days"`
+
+	// Payees maps payee names to the description patterns that belong to them, such as
+	// "amzn mktp*"; * stands for any text
+	Payees map[string][]string `yaml:"payees"`
+}
+
+// ThemeFile is a custom theme: a built-in base theme with some colors replaced
+type ThemeFile struct {
+	Base            string            `yaml:"base"`
+	Colors          map[string]string `yaml:"colors"`
+	CategoryPalette []string          `yaml:"category_palette"`
+}
+
+// KeyList is one or more keys; the config file accepts a single key or a list
+type KeyList []string
+
+// UnmarshalYAML accepts both `save: ctrl+w` and `save: [ctrl+w, f2]`
+func (k *KeyList) UnmarshalYAML(value *yaml.Node) error {
+	switch value.Kind {
+	case yaml.ScalarNode:
+		*k = KeyList{value.Value}
+		return nil
+	case yaml.SequenceNode:
+		var keys []string
+		if err := value.Decode(&keys); err != nil {
+			return err
+		}
+		*k = keys
+		return nil
+	default:
+		return fmt.Errorf("line %d: expected a key or a list of keys", value.Line)
+	}
+}
+
+// KeybindingOverrides returns the keybindings section as plain string slices
+func (c *Config) KeybindingOverrides() map[string][]string {
+	overrides := make(map[string][]string, len(c.Keybindings))
+	for action, keys := range c.Keybindings {
+		overrides[action] = []string(keys)
+	}
+	return overrides
+}
+
+// DefaultPath returns $XDG_CONFIG_HOME/expensetracker/config.yaml, falling back to ~/.config
+func DefaultPath() (string, error) {
+	dir := os.Getenv("XDG_CONFIG_HOME")
+	if dir == "" {
+		homeDir, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("failed to get user home directory: %w", err)
+		}
+		dir = filepath.Join(homeDir, ".config")
+	}
+	return filepath.Join(dir, "expensetracker", FileName), nil
+}
+
+// Load reads the config file at path. A missing file is not an error and yields an empty config.
+func Load(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return &Config{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+	return Parse(data)
+}
+
+// ThemePath returns the file a custom theme is read from: themes/<name>.yaml next to the config file
+func ThemePath(configPath, name string) string {
+	return filepath.Join(filepath.Dir(configPath), "themes", name+".yaml")
+}
+
+// LoadThemeFile reads a custom theme file
+func LoadThemeFile(path string) (*ThemeFile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read theme file: %w", err)
+	}
+
+	theme := &ThemeFile{}
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(theme); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("failed to parse theme file: %w", err)
+	}
+	return theme, nil
+}
+
+// Parse decodes a config file, rejecting unknown sections so typos don't go unnoticed
+func Parse(data []byte) (*Config, error) {
+	cfg := &Config{}
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(cfg); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("failed to parse config file: %w", err)
+	}
+	return cfg, nil
+}
@@ -0,0 +1,177 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type ConfigTestSuite struct {
+	suite.Suite
+}
+
+func TestConfigSuite(t *testing.T) {
+	suite.Run(t, new(ConfigTestSuite))
+}
+
+func (suite *ConfigTestSuite) TestParse_Keybindings() {
+	assert := assert.New(suite.T())
+
+	cfg, err := Parse([]byte(`
+keybindings:
+  form.save: ctrl+w
+  list.up: [up, ctrl+p]
+`))
+
+	suite.Require().NoError(err)
+	assert.Equal(KeyList{"ctrl+w"}, cfg.Keybindings["form.save"])
+	assert.Equal(KeyList{"up", "ctrl+p"}, cfg.Keybindings["list.up"])
+	assert.Equal(map[string][]string{
+		"form.save": {"ctrl+w"},
+		"list.up":   {"up", "ctrl+p"},
+	}, cfg.KeybindingOverrides())
+}
+
+func (suite *ConfigTestSuite) TestParse_Empty() {
+	cfg, err := Parse([]byte(""))
+
+	suite.Require().NoError(err)
+	assert.Empty(suite.T(), cfg.Keybindings)
+}
+
+func (suite *ConfigTestSuite) TestParse_Errors() {
+	assert := assert.New(suite.T())
+
+	_, err := Parse([]byte("keybinding:\n  form.save: ctrl+w\n"))
+	assert.Error(err, "unknown section")
+
+	_, err = Parse([]byte("keybindings:\n  form.save: {key: ctrl+w}\n"))
+	assert.Error(err)
+	assert.Contains(err.Error(), "expected a key or a list of keys")
+
+	_, err = Parse([]byte("keybindings: [oops"))
+	assert.Error(err)
+}
+
+func (suite *ConfigTestSuite) TestLoad() {
+	assert := assert.New(suite.T())
+	dir := suite.T().TempDir()
+
+	cfg, err := Load(filepath.Join(dir, "missing.yaml"))
+	suite.Require().NoError(err)
+	assert.Empty(cfg.Keybindings)
+
+	path := filepath.Join(dir, FileName)
+	suite.Require().NoError(os.WriteFile(path, []byte("keybindings:\n  global.help: f1\n"), 0644))
+
+	cfg, err = Load(path)
+	suite.Require().NoError(err)
+	assert.Equal(KeyList{"f1"}, cfg.Keybindings["global.help"])
+}
+
+func (suite *ConfigTestSuite) TestDefaultPath() {
+	assert := assert.New(suite.T())
+
+	suite.T().Setenv("XDG_CONFIG_HOME", "/tmp/xdg")
+	path, err := DefaultPath()
+	suite.Require().NoError(err)
+	assert.Equal("/tmp/xdg/expensetracker/config.yaml", path)
+
+	suite.T().Setenv("XDG_CONFIG_HOME", "")
+	suite.T().Setenv("HOME", "/home/tester")
+	path, err = DefaultPath()
+	suite.Require().NoError(err)
+	assert.Equal("/home/tester/.config/expensetracker/config.yaml", path)
+}
+
+func (suite *ConfigTestSuite) TestParse_Theme() {
+	cfg, err := Parse([]byte("theme: light\n"))
+
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), "light", cfg.Theme)
+}
+
+func (suite *ConfigTestSuite) TestParse_Locale() {
+	cfg, err := Parse([]byte(`
+locale: de-DE
+format:
+  currency_symbol: EUR
+  negative_style: parentheses
+`))
+
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), "de-DE", cfg.Locale)
+	assert.Equal(suite.T(), map[string]string{"currency_symbol": "EUR", "negative_style": "parentheses"}, cfg.Format)
+}
+
+func (suite *ConfigTestSuite) TestParse_Timezone() {
+	cfg, err := Parse([]byte("timezone: Europe/Berlin\n"))
+
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), "Europe/Berlin", cfg.Timezone)
+}
+
+func (suite *ConfigTestSuite) TestParse_FutureDays() {
+	cfg, err := Parse([]byte("future_days: 30\n"))
+
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), 30, cfg.FutureDays)
+}
+
+func (suite *ConfigTestSuite) TestParse_DuplicateDays() {
+	cfg, err := Parse([]byte("duplicate_days: 5\n"))
+
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), 5, cfg.DuplicateDays)
+}
+
+func (suite *ConfigTestSuite) TestParse_TrashDays() {
+	cfg, err := Parse([]byte("trash_days: 90\n"))
+
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), 90, cfg.TrashDays)
+}
+
+func (suite *ConfigTestSuite) TestParse_Payees() {
+	cfg, err := Parse([]byte("payees:\n  Amazon: [\"amzn mktp*\", amazon eu]\n  Netflix: []\n"))
+
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), map[string][]string{
+		"Amazon":  {"amzn mktp*", "amazon eu"},
+		"Netflix": {},
+	}, cfg.Payees)
+}
+
+func (suite *ConfigTestSuite) TestLoadThemeFile() {
+	assert := assert.New(suite.T())
+	dir := suite.T().TempDir()
+	configPath := filepath.Join(dir, FileName)
+
+	path := ThemePath(configPath, "solarized")
+	assert.Equal(filepath.Join(dir, "themes", "solarized.yaml"), path)
+
+	_, err := LoadThemeFile(path)
+	assert.Error(err, "missing theme file")
+
+	suite.Require().NoError(os.MkdirAll(filepath.Dir(path), 0755))
+	suite.Require().NoError(os.WriteFile(path, []byte(`
+base: light
+colors:
+  primary: "#268bd2"
+  text_primary: "#073642"
+category_palette: ["#b58900", "#cb4b16"]
+`), 0644))
+
+	theme, err := LoadThemeFile(path)
+	suite.Require().NoError(err)
+	assert.Equal("light", theme.Base)
+	assert.Equal("#268bd2", theme.Colors["primary"])
+	assert.Equal([]string{"#b58900", "#cb4b16"}, theme.CategoryPalette)
+
+	suite.Require().NoError(os.WriteFile(path, []byte("colours:\n  primary: red\n"), 0644))
+	_, err = LoadThemeFile(path)
+	assert.Error(err, "unknown field")
+}
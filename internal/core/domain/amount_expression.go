@@ -0,0 +1,167 @@
+package domain
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// amountOperators are the characters that make an amount input an expression
+const amountOperators = "+-*/×÷()"
+
+// IsAmountExpression reports whether input is arithmetic rather than a single, possibly signed, number
+func IsAmountExpression(input string) bool {
+	text := strings.TrimLeft(strings.TrimSpace(input), "+-")
+	return strings.ContainsAny(text, amountOperators)
+}
+
+// EvaluateAmount computes an amount typed as arithmetic such as "12.50+3.20*2" or "120/3"
+// with exact decimal arithmetic and rounds the result to cents. Numbers use the locale's
+// separators and may carry its currency symbol; * and / bind tighter than + and -.
+func (l *Locale) EvaluateAmount(input string) (float64, error) {
+	parser := &amountParser{locale: l, input: []rune(strings.ReplaceAll(input, l.CurrencySymbol, ""))}
+
+	value, err := parser.expression()
+	if err != nil {
+		return 0, err
+	}
+	parser.skipSpaces()
+	if parser.pos < len(parser.input) {
+		return 0, fmt.Errorf("unexpected %q", string(parser.input[parser.pos]))
+	}
+
+	cents := new(big.Rat).Mul(value, big.NewRat(100, 1))
+	rounded := new(big.Int).Quo(cents.Num(), cents.Denom())
+	remainder := new(big.Int).Rem(cents.Num(), cents.Denom())
+	if new(big.Int).Mul(new(big.Int).Abs(remainder), big.NewInt(2)).Cmp(cents.Denom()) >= 0 {
+		rounded.Add(rounded, big.NewInt(int64(remainder.Sign())))
+	}
+	result, _ := new(big.Rat).SetFrac(rounded, big.NewInt(100)).Float64()
+	return result, nil
+}
+
+// amountParser is a recursive descent parser over one amount expression
+type amountParser struct {
+	locale *Locale
+	input  []rune
+	pos    int
+}
+
+func (p *amountParser) skipSpaces() {
+	for p.pos < len(p.input) && strings.ContainsRune(" \u00a0\u202f", p.input[p.pos]) {
+		p.pos++
+	}
+}
+
+// peek returns the next operator or parenthesis, or 0 when a number or the end follows
+func (p *amountParser) peek() rune {
+	p.skipSpaces()
+	if p.pos < len(p.input) && strings.ContainsRune(amountOperators, p.input[p.pos]) {
+		return p.input[p.pos]
+	}
+	return 0
+}
+
+// expression := term (("+" | "-") term)*
+func (p *amountParser) expression() (*big.Rat, error) {
+	value, err := p.term()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		op := p.peek()
+		if op != '+' && op != '-' {
+			return value, nil
+		}
+		p.pos++
+		right, err := p.term()
+		if err != nil {
+			return nil, err
+		}
+		if op == '+' {
+			value.Add(value, right)
+		} else {
+			value.Sub(value, right)
+		}
+	}
+}
+
+// term := factor (("*" | "/") factor)*
+func (p *amountParser) term() (*big.Rat, error) {
+	value, err := p.factor()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		op := p.peek()
+		if op != '*' && op != '×' && op != '/' && op != '÷' {
+			return value, nil
+		}
+		p.pos++
+		right, err := p.factor()
+		if err != nil {
+			return nil, err
+		}
+		if op == '*' || op == '×' {
+			value.Mul(value, right)
+		} else {
+			if right.Sign() == 0 {
+				return nil, fmt.Errorf("division by zero")
+			}
+			value.Quo(value, right)
+		}
+	}
+}
+
+// factor := ("+" | "-") factor | "(" expression ")" | number
+func (p *amountParser) factor() (*big.Rat, error) {
+	switch p.peek() {
+	case '-':
+		p.pos++
+		value, err := p.factor()
+		if err != nil {
+			return nil, err
+		}
+		return value.Neg(value), nil
+	case '+':
+		p.pos++
+		return p.factor()
+	case '(':
+		p.pos++
+		value, err := p.expression()
+		if err != nil {
+			return nil, err
+		}
+		if p.peek() != ')' {
+			return nil, fmt.Errorf("missing closing parenthesis")
+		}
+		p.pos++
+		return value, nil
+	case 0:
+		return p.number()
+	default:
+		return nil, fmt.Errorf("expected a number before %q", string(p.input[p.pos]))
+	}
+}
+
+// number reads everything up to the next operator as one number in the locale's format
+func (p *amountParser) number() (*big.Rat, error) {
+	start := p.pos
+	for p.pos < len(p.input) && !strings.ContainsRune(amountOperators, p.input[p.pos]) {
+		p.pos++
+	}
+	text := p.locale.stripSpaces(string(p.input[start:p.pos]))
+	if text == "" {
+		return nil, fmt.Errorf("expected a number")
+	}
+
+	canonical, ok := p.locale.canonicalNumber(text)
+	if !ok {
+		return nil, fmt.Errorf("invalid number %q", text)
+	}
+	value, ok := new(big.Rat).SetString(canonical)
+	if !ok {
+		return nil, fmt.Errorf("invalid number %q", text)
+	}
+	return value, nil
+}
@@ -0,0 +1,89 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type AmountExpressionTestSuite struct {
+	suite.Suite
+}
+
+func TestAmountExpressionSuite(t *testing.T) {
+	suite.Run(t, new(AmountExpressionTestSuite))
+}
+
+func (suite *AmountExpressionTestSuite) TestEvaluateAmount() {
+	testCases := []struct {
+		locale   string
+		input    string
+		expected float64
+	}{
+		{"en-US", "12.50", 12.5},
+		{"en-US", "12.50+3.20*2", 18.9},
+		{"en-US", "120/3", 40},
+		{"en-US", "100/3", 33.33},
+		{"en-US", "200/3", 66.67},
+		{"en-US", "0.1+0.2", 0.3},
+		{"en-US", "(12.50 + 7.50) / 4", 5},
+		{"en-US", "2*(3+4)", 14},
+		{"en-US", "-5+10", 5},
+		{"en-US", "10 - -2", 12},
+		{"en-US", "$1,200.00 / 4", 300},
+		{"en-US", "3 × 4 ÷ 2", 6},
+		{"en-US", "0.005", 0.01},
+		{"en-US", "-0.005", -0.01},
+		{"de-DE", "12,50+3,20*2", 18.9},
+		{"de-DE", "1.234,56 € - 34,56", 1200},
+		{"fr-FR", "1 234,56 + 0,44", 1235},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.locale+" "+tc.input, func() {
+			locale, err := NewLocale(tc.locale, nil)
+			suite.Require().NoError(err)
+
+			value, err := locale.EvaluateAmount(tc.input)
+			suite.Require().NoError(err)
+			assert.Equal(suite.T(), tc.expected, value)
+		})
+	}
+}
+
+func (suite *AmountExpressionTestSuite) TestEvaluateAmount_Errors() {
+	testCases := []struct {
+		input   string
+		message string
+	}{
+		{"", "expected a number"},
+		{"12+", "expected a number"},
+		{"10/0", "division by zero"},
+		{"10/(5-5)", "division by zero"},
+		{"(12+3", "missing closing parenthesis"},
+		{"12+3)", "unexpected \")\""},
+		{"*3", "expected a number before \"*\""},
+		{"12..5+1", "invalid number \"12..5\""},
+		{"abc", "invalid number"},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.input, func() {
+			_, err := DefaultLocale().EvaluateAmount(tc.input)
+			suite.Require().Error(err)
+			assert.Contains(suite.T(), err.Error(), tc.message)
+		})
+	}
+}
+
+func (suite *AmountExpressionTestSuite) TestIsAmountExpression() {
+	assert := assert.New(suite.T())
+
+	assert.True(IsAmountExpression("12.50+3.20"))
+	assert.True(IsAmountExpression("120/3"))
+	assert.True(IsAmountExpression("-5+10"))
+	assert.False(IsAmountExpression("12.50"))
+	assert.False(IsAmountExpression("-12.50"))
+	assert.False(IsAmountExpression("1.234,56 €"))
+}
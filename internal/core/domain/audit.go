@@ -0,0 +1,216 @@
+package domain
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// AuditAction is the kind of change an audit entry records
+type AuditAction string
+
+const (
+	AuditCreate AuditAction = "create"
+	AuditUpdate AuditAction = "update"
+	AuditDelete AuditAction = "delete"
+)
+
+// Entities the audit log records changes of
+const (
+	AuditTransaction = "transaction"
+	AuditCategory    = "category"
+)
+
+// AuditEntry is one change in the append-only audit log. Before and After are JSON
+// snapshots of the entity; Before is empty for creates and After for deletes.
+type AuditEntry struct {
+	ID       int             `json:"id"`
+	At       time.Time       `json:"at"`
+	Actor    string          `json:"actor"`
+	Entity   string          `json:"entity"`
+	EntityID int             `json:"entity_id"`
+	Action   AuditAction     `json:"action"`
+	Before   json.RawMessage `json:"before,omitempty"`
+	After    json.RawMessage `json:"after,omitempty"`
+}
+
+// AuditChange is one field that differs between the snapshots of an audit entry
+type AuditChange struct {
+	Field  string
+	Before string
+	After  string
+}
+
+// NewAuditEntry compares two snapshots of an entity, nil when it did not exist, and returns
+// the entry recording the change between them. It returns nil when nothing changed.
+func NewAuditEntry(entity string, id int, before, after interface{}) (*AuditEntry, error) {
+	beforeJSON, err := auditSnapshot(before)
+	if err != nil {
+		return nil, err
+	}
+	afterJSON, err := auditSnapshot(after)
+	if err != nil {
+		return nil, err
+	}
+
+	entry := &AuditEntry{Entity: entity, EntityID: id, Before: beforeJSON, After: afterJSON}
+	switch {
+	case beforeJSON == nil && afterJSON == nil:
+		return nil, nil
+	case beforeJSON == nil:
+		entry.Action = AuditCreate
+	case afterJSON == nil:
+		entry.Action = AuditDelete
+	case bytes.Equal(beforeJSON, afterJSON):
+		return nil, nil
+	default:
+		entry.Action = AuditUpdate
+	}
+	return entry, nil
+}
+
+// auditSnapshot marshals an entity for the audit log, nil for a nil pointer
+func auditSnapshot(entity interface{}) (json.RawMessage, error) {
+	if entity == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(entity)
+	if err != nil {
+		return nil, fmt.Errorf("failed to snapshot %T: %w", entity, err)
+	}
+	if string(data) == "null" {
+		return nil, nil
+	}
+	return data, nil
+}
+
+// Changes lists the fields that differ between the snapshots, sorted by name. A create
+// lists every field it set and a delete every field the entity had.
+func (e *AuditEntry) Changes() ([]AuditChange, error) {
+	before, err := auditFields(e.Before)
+	if err != nil {
+		return nil, err
+	}
+	after, err := auditFields(e.After)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := map[string]bool{}
+	for field := range before {
+		fields[field] = true
+	}
+	for field := range after {
+		fields[field] = true
+	}
+
+	var changes []AuditChange
+	for field := range fields {
+		if field == "id" || bytes.Equal(before[field], after[field]) {
+			continue
+		}
+		changes = append(changes, AuditChange{
+			Field:  field,
+			Before: auditValue(before[field]),
+			After:  auditValue(after[field]),
+		})
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Field < changes[j].Field
+	})
+	return changes, nil
+}
+
+// Summary describes the entry in a few words, e.g. "amount, category"
+func (e *AuditEntry) Summary() string {
+	switch e.Action {
+	case AuditCreate:
+		return "created"
+	case AuditDelete:
+		return "deleted"
+	}
+
+	changes, err := e.Changes()
+	if err != nil {
+		return "updated"
+	}
+	fields := make([]string, len(changes))
+	for i, change := range changes {
+		fields[i] = change.Field
+	}
+	return strings.Join(fields, ", ")
+}
+
+// Subject names the entity the way people know it: the description of a transaction or
+// the name of a category, taken from the latest snapshot
+func (e *AuditEntry) Subject() string {
+	snapshot := e.After
+	if len(snapshot) == 0 {
+		snapshot = e.Before
+	}
+	fields, err := auditFields(snapshot)
+	if err != nil {
+		return ""
+	}
+	if description, ok := fields["description"]; ok {
+		return auditValue(description)
+	}
+	return auditValue(fields["name"])
+}
+
+func auditFields(snapshot json.RawMessage) (map[string]json.RawMessage, error) {
+	fields := map[string]json.RawMessage{}
+	if len(snapshot) == 0 {
+		return fields, nil
+	}
+	if err := json.Unmarshal(snapshot, &fields); err != nil {
+		return nil, fmt.Errorf("failed to read audit snapshot: %w", err)
+	}
+	return fields, nil
+}
+
+// auditValue renders a snapshot value for people: objects by their name, lists joined
+// with commas, anything else as its plain JSON value
+func auditValue(raw json.RawMessage) string {
+	if len(raw) == 0 {
+		return ""
+	}
+	var value interface{}
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return string(raw)
+	}
+	return formatAuditValue(value)
+}
+
+func formatAuditValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	case []interface{}:
+		items := make([]string, len(v))
+		for i, item := range v {
+			items[i] = formatAuditValue(item)
+		}
+		return strings.Join(items, ", ")
+	case map[string]interface{}:
+		if name, ok := v["name"].(string); ok {
+			return name
+		}
+		// split lines: category and amount
+		if category, ok := v["category"].(map[string]interface{}); ok {
+			return fmt.Sprintf("%s %s", formatAuditValue(category), formatAuditValue(v["amount"]))
+		}
+	}
+	data, _ := json.Marshal(value)
+	return string(data)
+}
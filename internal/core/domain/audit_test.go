@@ -0,0 +1,113 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type AuditTestSuite struct {
+	suite.Suite
+	transaction *Transaction
+}
+
+func TestAuditSuite(t *testing.T) {
+	suite.Run(t, new(AuditTestSuite))
+}
+
+func (suite *AuditTestSuite) SetupTest() {
+	suite.transaction = &Transaction{
+		ID:          7,
+		Description: "Groceries",
+		Amount:      42.5,
+		Date:        time.Date(2026, 9, 14, 0, 0, 0, 0, time.UTC),
+		Type:        "expense",
+		Category:    &Category{ID: 1, Name: "Food & Dining"},
+		Tags:        []string{"weekly"},
+	}
+}
+
+func (suite *AuditTestSuite) TestNewAuditEntry_Actions() {
+	assert := assert.New(suite.T())
+
+	created, err := NewAuditEntry(AuditTransaction, 7, nil, suite.transaction)
+	suite.Require().NoError(err)
+	assert.Equal(AuditCreate, created.Action)
+	assert.Equal(AuditTransaction, created.Entity)
+	assert.Equal(7, created.EntityID)
+	assert.Nil(created.Before)
+	assert.Equal("created", created.Summary())
+
+	var missing *Transaction
+	deleted, err := NewAuditEntry(AuditTransaction, 7, suite.transaction, missing)
+	suite.Require().NoError(err)
+	assert.Equal(AuditDelete, deleted.Action)
+	assert.Nil(deleted.After)
+
+	unchanged, err := NewAuditEntry(AuditTransaction, 7, suite.transaction, suite.transaction.Clone())
+	suite.Require().NoError(err)
+	assert.Nil(unchanged)
+
+	nothing, err := NewAuditEntry(AuditTransaction, 7, missing, missing)
+	suite.Require().NoError(err)
+	assert.Nil(nothing)
+}
+
+func (suite *AuditTestSuite) TestChanges() {
+	assert := assert.New(suite.T())
+
+	after := suite.transaction.Clone()
+	after.Amount = 45
+	after.Category = &Category{ID: 2, Name: "Shopping"}
+	after.Tags = []string{"weekly", "market"}
+
+	entry, err := NewAuditEntry(AuditTransaction, 7, suite.transaction, after)
+	suite.Require().NoError(err)
+	assert.Equal(AuditUpdate, entry.Action)
+
+	changes, err := entry.Changes()
+	suite.Require().NoError(err)
+	assert.Equal([]AuditChange{
+		{Field: "amount", Before: "42.5", After: "45"},
+		{Field: "category", Before: "Food & Dining", After: "Shopping"},
+		{Field: "tags", Before: "weekly", After: "weekly, market"},
+	}, changes)
+	assert.Equal("amount, category, tags", entry.Summary())
+	assert.Equal("Groceries", entry.Subject())
+}
+
+func (suite *AuditTestSuite) TestSubject() {
+	assert := assert.New(suite.T())
+
+	deleted, err := NewAuditEntry(AuditTransaction, 7, suite.transaction, nil)
+	suite.Require().NoError(err)
+	assert.Equal("Groceries", deleted.Subject(), "taken from the snapshot before a delete")
+
+	category, err := NewAuditEntry(AuditCategory, 3, nil, &Category{ID: 3, Name: "Pets"})
+	suite.Require().NoError(err)
+	assert.Equal("Pets", category.Subject())
+}
+
+func (suite *AuditTestSuite) TestChanges_CreateAndSplits() {
+	assert := assert.New(suite.T())
+
+	suite.transaction.Splits = []*Split{
+		{ID: 1, Category: &Category{ID: 1, Name: "Food & Dining"}, Amount: 30},
+		{ID: 2, Category: &Category{ID: 3, Name: "Healthcare"}, Amount: 12.5},
+	}
+	entry, err := NewAuditEntry(AuditTransaction, 7, nil, suite.transaction)
+	suite.Require().NoError(err)
+
+	changes, err := entry.Changes()
+	suite.Require().NoError(err)
+	fields := map[string]AuditChange{}
+	for _, change := range changes {
+		fields[change.Field] = change
+	}
+	assert.NotContains(fields, "id")
+	assert.Equal(AuditChange{Field: "description", After: "Groceries"}, fields["description"])
+	assert.Equal("Food & Dining 30, Healthcare 12.5", fields["splits"].After)
+	assert.Equal("2026-09-14T00:00:00Z", fields["date"].After)
+}
@@ -0,0 +1,120 @@
+package domain
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// FilterCategories returns the categories whose names fuzzy match query, best match first.
+// A name matches when it contains the letters of query in order, so "bu" finds
+// "Bills & Utilities". Exact names rank above prefixes, prefixes above word starts and
+// word starts above scattered letters; equal matches keep their order in categories.
+func FilterCategories(query string, categories []*Category) []*Category {
+	query = strings.ToLower(strings.Join(strings.Fields(query), ""))
+	if query == "" {
+		return categories
+	}
+
+	type match struct {
+		category *Category
+		score    int
+	}
+	var matches []match
+	for _, category := range categories {
+		if score, ok := fuzzyScore(query, category.Name); ok {
+			matches = append(matches, match{category, score})
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].score > matches[j].score
+	})
+
+	filtered := make([]*Category, len(matches))
+	for i, m := range matches {
+		filtered[i] = m.category
+	}
+	return filtered
+}
+
+// fuzzyScore rates how well name matches a lower case query without spaces
+func fuzzyScore(query, name string) (int, bool) {
+	lower := strings.ToLower(name)
+	compact := strings.Join(strings.Fields(lower), "")
+	switch {
+	case lower == query || compact == query:
+		return 1000, true
+	case strings.HasPrefix(compact, query):
+		return 800, true
+	}
+	for _, word := range strings.FieldsFunc(lower, isWordSeparator) {
+		if strings.HasPrefix(word, query) {
+			return 600, true
+		}
+	}
+	if strings.Contains(compact, query) {
+		return 400, true
+	}
+
+	// Letters in order: reward runs and letters that start a word
+	runes := []rune(lower)
+	score, last := 0, -1
+	pos := 0
+	for _, r := range query {
+		for pos < len(runes) && runes[pos] != r {
+			pos++
+		}
+		if pos == len(runes) {
+			return 0, false
+		}
+		score++
+		if pos == last+1 {
+			score += 2
+		}
+		if pos == 0 || isWordSeparator(runes[pos-1]) {
+			score += 3
+		}
+		last = pos
+		pos++
+	}
+	return score, true
+}
+
+func isWordSeparator(r rune) bool {
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+}
+
+// OrderByRecency moves the categories listed in recentIDs, most recent first, to the front
+// and leaves the rest in their original order. It returns how many categories were moved.
+func OrderByRecency(categories []*Category, recentIDs []int) ([]*Category, int) {
+	byID := make(map[int]*Category, len(categories))
+	for _, category := range categories {
+		byID[category.ID] = category
+	}
+
+	ordered := make([]*Category, 0, len(categories))
+	moved := make(map[int]bool, len(recentIDs))
+	for _, id := range recentIDs {
+		if category, ok := byID[id]; ok && !moved[id] {
+			ordered = append(ordered, category)
+			moved[id] = true
+		}
+	}
+	for _, category := range categories {
+		if !moved[category.ID] {
+			ordered = append(ordered, category)
+		}
+	}
+	return ordered, len(moved)
+}
+
+// FindCategory returns the category named name, ignoring case and surrounding spaces
+func FindCategory(name string, categories []*Category) *Category {
+	name = strings.TrimSpace(name)
+	for _, category := range categories {
+		if strings.EqualFold(category.Name, name) {
+			return category
+		}
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type CategoryFilterTestSuite struct {
+	suite.Suite
+	categories []*Category
+}
+
+func TestCategoryFilterSuite(t *testing.T) {
+	suite.Run(t, new(CategoryFilterTestSuite))
+}
+
+func (suite *CategoryFilterTestSuite) SetupTest() {
+	suite.categories = []*Category{
+		{ID: 1, Name: "Bills & Utilities"},
+		{ID: 2, Name: "Entertainment"},
+		{ID: 3, Name: "Food & Dining"},
+		{ID: 4, Name: "Other"},
+		{ID: 5, Name: "Shopping"},
+		{ID: 6, Name: "Transportation"},
+	}
+}
+
+func filteredNames(categories []*Category) []string {
+	result := make([]string, len(categories))
+	for i, category := range categories {
+		result[i] = category.Name
+	}
+	return result
+}
+
+func (suite *CategoryFilterTestSuite) TestFilterCategories() {
+	testCases := []struct {
+		query    string
+		expected []string
+	}{
+		{"", []string{"Bills & Utilities", "Entertainment", "Food & Dining", "Other", "Shopping", "Transportation"}},
+		{"other", []string{"Other"}},
+		{"sh", []string{"Shopping"}},
+		{"din", []string{"Food & Dining"}},
+		{"bu", []string{"Bills & Utilities"}},
+		{"tra", []string{"Transportation", "Entertainment"}},
+		{"  FOOD ", []string{"Food & Dining"}},
+		{"t", []string{"Transportation", "Bills & Utilities", "Entertainment", "Other"}},
+		{"trnsp", []string{"Transportation"}},
+		{"xyz", []string{}},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.query, func() {
+			assert.Equal(suite.T(), tc.expected, filteredNames(FilterCategories(tc.query, suite.categories)))
+		})
+	}
+}
+
+func (suite *CategoryFilterTestSuite) TestFilterCategories_KeepsOrderOfEqualMatches() {
+	ordered := []*Category{suite.categories[5], suite.categories[1]}
+
+	assert.Equal(suite.T(), []string{"Transportation", "Entertainment"}, filteredNames(FilterCategories("n", ordered)))
+}
+
+func (suite *CategoryFilterTestSuite) TestOrderByRecency() {
+	ordered, recent := OrderByRecency(suite.categories, []int{5, 3, 99, 5})
+
+	assert.Equal(suite.T(), 2, recent)
+	assert.Equal(suite.T(), []string{"Shopping", "Food & Dining", "Bills & Utilities", "Entertainment", "Other", "Transportation"}, filteredNames(ordered))
+}
+
+func (suite *CategoryFilterTestSuite) TestOrderByRecency_NoHistory() {
+	ordered, recent := OrderByRecency(suite.categories, nil)
+
+	assert.Equal(suite.T(), 0, recent)
+	assert.Equal(suite.T(), suite.categories, ordered)
+}
+
+func (suite *CategoryFilterTestSuite) TestFindCategory() {
+	assert.Equal(suite.T(), suite.categories[3], FindCategory(" OTHER ", suite.categories))
+	assert.Nil(suite.T(), FindCategory("Oth", suite.categories))
+}
@@ -0,0 +1,185 @@
+package domain
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// MinSuggestionConfidence is the confidence a suggestion has to exceed, so an even split
+// between two categories suggests neither
+const MinSuggestionConfidence = 0.5
+
+// CategorySuggestion is the category the classifier expects for a transaction
+type CategorySuggestion struct {
+	Category   *Category `json:"category"`
+	Confidence float64   `json:"confidence"` // 0..1
+}
+
+// CategoryClassifier is a naive Bayes classifier over the words of a description and the
+// order of magnitude of the amount. Income and expenses are learned separately. It can
+// learn and forget single transactions, so it follows edits without being retrained.
+type CategoryClassifier struct {
+	models map[string]*classifierModel
+}
+
+type classifierModel struct {
+	documents  int
+	classes    map[int]*classifierClass
+	vocabulary map[string]int // feature → number of learned transactions with it
+}
+
+type classifierClass struct {
+	category  *Category
+	documents int
+	features  map[string]int
+	total     int
+}
+
+func NewCategoryClassifier() *CategoryClassifier {
+	return &CategoryClassifier{models: map[string]*classifierModel{}}
+}
+
+// Learn adds transactions to what the classifier knows. Transactions without a category are skipped.
+func (c *CategoryClassifier) Learn(transactions ...*Transaction) {
+	for _, t := range transactions {
+		if t.Category == nil || t.Category.ID <= 0 {
+			continue
+		}
+		model := c.models[t.Type]
+		if model == nil {
+			model = &classifierModel{classes: map[int]*classifierClass{}, vocabulary: map[string]int{}}
+			c.models[t.Type] = model
+		}
+		class := model.classes[t.Category.ID]
+		if class == nil {
+			class = &classifierClass{features: map[string]int{}}
+			model.classes[t.Category.ID] = class
+		}
+		class.category = t.Category
+
+		model.documents++
+		class.documents++
+		for _, feature := range classifierFeatures(t.Description, t.Amount) {
+			model.vocabulary[feature]++
+			class.features[feature]++
+			class.total++
+		}
+	}
+}
+
+// Forget removes transactions learned earlier, e.g. before they are deleted or recategorized
+func (c *CategoryClassifier) Forget(transactions ...*Transaction) {
+	for _, t := range transactions {
+		if t.Category == nil {
+			continue
+		}
+		model := c.models[t.Type]
+		if model == nil {
+			continue
+		}
+		class := model.classes[t.Category.ID]
+		if class == nil {
+			continue
+		}
+
+		model.documents--
+		class.documents--
+		for _, feature := range classifierFeatures(t.Description, t.Amount) {
+			if class.features[feature] == 0 {
+				continue
+			}
+			class.features[feature]--
+			class.total--
+			if class.features[feature] == 0 {
+				delete(class.features, feature)
+			}
+			if model.vocabulary[feature]--; model.vocabulary[feature] <= 0 {
+				delete(model.vocabulary, feature)
+			}
+		}
+		if class.documents <= 0 {
+			delete(model.classes, t.Category.ID)
+		}
+	}
+}
+
+// Suggest returns the most likely category for a transaction, or nil when none of the
+// description's words were seen before or the best guess is not above MinSuggestionConfidence.
+// Ties go to the category with the lower ID, so the result never depends on map order.
+func (c *CategoryClassifier) Suggest(description string, amount float64, transactionType string) *CategorySuggestion {
+	model := c.models[transactionType]
+	if model == nil || len(model.classes) == 0 {
+		return nil
+	}
+
+	var known []string
+	descriptionKnown := false
+	for _, feature := range classifierFeatures(description, amount) {
+		if model.vocabulary[feature] > 0 {
+			known = append(known, feature)
+			descriptionKnown = descriptionKnown || !strings.HasPrefix(feature, amountFeaturePrefix)
+		}
+	}
+	if !descriptionKnown {
+		return nil
+	}
+
+	ids := make([]int, 0, len(model.classes))
+	for id := range model.classes {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	// Log probabilities with add-one smoothing, turned into shares that sum to one
+	scores := make([]float64, len(ids))
+	vocabulary := float64(len(model.vocabulary))
+	best := 0
+	for i, id := range ids {
+		class := model.classes[id]
+		score := math.Log(float64(class.documents+1) / float64(model.documents+len(ids)))
+		for _, feature := range known {
+			score += math.Log(float64(class.features[feature]+1) / (float64(class.total) + vocabulary))
+		}
+		scores[i] = score
+		if score > scores[best] {
+			best = i
+		}
+	}
+
+	var sum float64
+	for _, score := range scores {
+		sum += math.Exp(score - scores[best])
+	}
+	confidence := 1 / sum
+	if confidence <= MinSuggestionConfidence {
+		return nil
+	}
+	return &CategorySuggestion{Category: model.classes[ids[best]].category, Confidence: confidence}
+}
+
+// amountFeaturePrefix marks the feature holding the order of magnitude of the amount
+const amountFeaturePrefix = "#amount:"
+
+// classifierFeatures returns the distinct words of a description, lowercased and without
+// numbers such as dates or receipt numbers, plus a feature for the amount's power of two
+func classifierFeatures(description string, amount float64) []string {
+	seen := map[string]bool{}
+	var features []string
+	words := strings.FieldsFunc(strings.ToLower(description), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for _, word := range words {
+		if len([]rune(word)) < 2 || strings.IndexFunc(word, unicode.IsLetter) < 0 || seen[word] {
+			continue
+		}
+		seen[word] = true
+		features = append(features, word)
+	}
+	if amount > 0 {
+		features = append(features, amountFeaturePrefix+strconv.Itoa(int(math.Floor(math.Log2(amount)))))
+	}
+	return features
+}
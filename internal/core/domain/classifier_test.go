@@ -0,0 +1,124 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type ClassifierTestSuite struct {
+	suite.Suite
+	groceries  *Category
+	transport  *Category
+	shopping   *Category
+	salary     *Category
+	classifier *CategoryClassifier
+}
+
+func TestClassifierSuite(t *testing.T) {
+	suite.Run(t, new(ClassifierTestSuite))
+}
+
+func (suite *ClassifierTestSuite) SetupTest() {
+	suite.groceries = &Category{ID: 1, Name: "Groceries"}
+	suite.transport = &Category{ID: 2, Name: "Transportation"}
+	suite.shopping = &Category{ID: 3, Name: "Shopping"}
+	suite.salary = &Category{ID: 8, Name: "Salary"}
+
+	suite.classifier = NewCategoryClassifier()
+	suite.classifier.Learn(
+		&Transaction{Description: "Lidl Berlin 0412", Amount: 23.5, Type: "expense", Category: suite.groceries},
+		&Transaction{Description: "LIDL sagt danke", Amount: 41.2, Type: "expense", Category: suite.groceries},
+		&Transaction{Description: "Rewe Markt", Amount: 18, Type: "expense", Category: suite.groceries},
+		&Transaction{Description: "BVG Ticket", Amount: 3.2, Type: "expense", Category: suite.transport},
+		&Transaction{Description: "BVG monthly ticket", Amount: 86, Type: "expense", Category: suite.transport},
+		&Transaction{Description: "Amazon order", Amount: 35, Type: "expense", Category: suite.shopping},
+		&Transaction{Description: "ACME payroll", Amount: 3200, Type: "income", Category: suite.salary},
+	)
+}
+
+func (suite *ClassifierTestSuite) TestSuggest() {
+	assert := assert.New(suite.T())
+
+	testCases := []struct {
+		description string
+		amount      float64
+		expected    *Category
+	}{
+		{"LIDL 0815 Hamburg", 30, suite.groceries},
+		{"bvg", 3.2, suite.transport},
+		{"Ticket machine", 4, suite.transport},
+		{"amazon", 35, suite.shopping},
+	}
+
+	for _, tc := range testCases {
+		suggestion := suite.classifier.Suggest(tc.description, tc.amount, "expense")
+		suite.Require().NotNil(suggestion, tc.description)
+		assert.Equal(tc.expected, suggestion.Category, tc.description)
+		assert.Greater(suggestion.Confidence, MinSuggestionConfidence, tc.description)
+		assert.LessOrEqual(suggestion.Confidence, 1.0, tc.description)
+	}
+}
+
+func (suite *ClassifierTestSuite) TestSuggest_NothingKnown() {
+	assert := assert.New(suite.T())
+
+	assert.Nil(suite.classifier.Suggest("Dentist", 23.5, "expense"), "a known amount alone is no reason to suggest")
+	assert.Nil(suite.classifier.Suggest("", 23.5, "expense"))
+	assert.Nil(suite.classifier.Suggest("Lidl", 20, "income"), "income is learned separately")
+	assert.Nil(NewCategoryClassifier().Suggest("Lidl", 20, "expense"))
+}
+
+func (suite *ClassifierTestSuite) TestSuggest_Deterministic() {
+	classifier := NewCategoryClassifier()
+	classifier.Learn(
+		&Transaction{Description: "Market", Amount: 10, Type: "expense", Category: suite.shopping},
+		&Transaction{Description: "Market", Amount: 10, Type: "expense", Category: suite.groceries},
+	)
+
+	// An even split stays below the confidence needed
+	assert.Nil(suite.T(), classifier.Suggest("Market", 10, "expense"))
+
+	classifier.Learn(&Transaction{Description: "Market hall", Amount: 10, Type: "expense", Category: suite.groceries})
+	for range 20 {
+		suggestion := classifier.Suggest("Market", 10, "expense")
+		suite.Require().NotNil(suggestion)
+		assert.Equal(suite.T(), suite.groceries, suggestion.Category)
+	}
+}
+
+func (suite *ClassifierTestSuite) TestForget() {
+	assert := assert.New(suite.T())
+
+	amazon := &Transaction{Description: "Amazon order", Amount: 35, Type: "expense", Category: suite.shopping}
+	suite.classifier.Forget(amazon)
+	assert.Nil(suite.classifier.Suggest("amazon", 35, "expense"))
+
+	// Recategorizing is forgetting the old category and learning the new one
+	bvg := &Transaction{Description: "BVG Ticket", Amount: 3.2, Type: "expense", Category: suite.transport}
+	suite.classifier.Forget(bvg)
+	bvg.Category = suite.shopping
+	suite.classifier.Learn(bvg)
+	suggestion := suite.classifier.Suggest("BVG", 3.2, "expense")
+	suite.Require().NotNil(suggestion)
+	assert.Equal(suite.shopping, suggestion.Category)
+	suggestion = suite.classifier.Suggest("BVG monthly", 86, "expense")
+	suite.Require().NotNil(suggestion)
+	assert.Equal(suite.transport, suggestion.Category, "the monthly ticket still counts")
+
+	// Forgetting what was never learned changes nothing
+	suite.classifier.Forget(&Transaction{Description: "Unknown", Amount: 1, Type: "expense", Category: suite.groceries})
+	suite.classifier.Forget(&Transaction{Description: "Lidl", Amount: 1, Type: "expense"})
+	suggestion = suite.classifier.Suggest("Lidl", 30, "expense")
+	suite.Require().NotNil(suggestion)
+	assert.Equal(suite.groceries, suggestion.Category)
+}
+
+func (suite *ClassifierTestSuite) TestClassifierFeatures() {
+	assert := assert.New(suite.T())
+
+	assert.Equal([]string{"lidl", "berlin", "sagt", "#amount:4"}, classifierFeatures("LIDL Berlin 0412 lidl sagt, a", 23.5))
+	assert.Equal([]string{"b2b", "café"}, classifierFeatures("B2B Café", 0))
+	assert.Equal([]string{"#amount:-1"}, classifierFeatures("", 0.5))
+}
@@ -0,0 +1,195 @@
+package domain
+
+import (
+	"encoding/json"
+	"errors"
+	"slices"
+)
+
+// ErrStaleCommand is returned when the transactions a command would undo or redo were
+// changed in some other way since, so applying it would overwrite that change
+var ErrStaleCommand = errors.New("the transactions were changed since")
+
+// DefaultCommandLimit is how many changes the undo history keeps
+const DefaultCommandLimit = 100
+
+// Command is one reversible change to transactions, kept as their state before and after
+// it. A transaction missing from Before was created by the change, one missing from After
+// was deleted.
+type Command struct {
+	Description string
+	Before      []*Transaction
+	After       []*Transaction
+}
+
+// NewCommand records a change, nil when before and after are the same
+func NewCommand(description string, before, after []*Transaction) *Command {
+	if sameTransactions(before, after) {
+		return nil
+	}
+	return &Command{Description: description, Before: before, After: after}
+}
+
+// IDs lists the transactions the command touches in ascending order
+func (c *Command) IDs() []int {
+	var ids []int
+	for _, transaction := range append(slices.Clone(c.Before), c.After...) {
+		ids = append(ids, transaction.ID)
+	}
+	slices.Sort(ids)
+	return slices.Compact(ids)
+}
+
+// Deleted lists the transactions the command removes
+func (c *Command) Deleted() []int {
+	kept := map[int]bool{}
+	for _, transaction := range c.After {
+		kept[transaction.ID] = true
+	}
+
+	var deleted []int
+	for _, transaction := range c.Before {
+		if !kept[transaction.ID] {
+			deleted = append(deleted, transaction.ID)
+		}
+	}
+	return deleted
+}
+
+// Inverse returns the command that takes the transactions back to where they were
+func (c *Command) Inverse() *Command {
+	return &Command{Description: c.Description, Before: c.After, After: c.Before}
+}
+
+// Touches reports whether the command changes any of the given transactions
+func (c *Command) Touches(ids []int) bool {
+	for _, id := range c.IDs() {
+		if slices.Contains(ids, id) {
+			return true
+		}
+	}
+	return false
+}
+
+// AppliesTo reports whether current, the stored transactions with the command's IDs, are
+// still in the state the command starts from
+func (c *Command) AppliesTo(current []*Transaction) bool {
+	return sameTransactions(c.Before, current)
+}
+
+// sameTransactions compares two sets of transactions regardless of order. Split lines are
+// compared without their IDs, which change whenever the lines are written again.
+func sameTransactions(a, b []*Transaction) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	states := make(map[int]string, len(a))
+	for _, transaction := range a {
+		states[transaction.ID] = commandState(transaction)
+	}
+	for _, transaction := range b {
+		state, ok := states[transaction.ID]
+		if !ok || state != commandState(transaction) {
+			return false
+		}
+	}
+	return true
+}
+
+func commandState(transaction *Transaction) string {
+	clone := transaction.Clone()
+	for _, split := range clone.Splits {
+		split.ID = 0
+	}
+	data, err := json.Marshal(clone)
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
+
+// CommandStack keeps the commands that can be undone and the undone ones that can be redone.
+// A new command clears what could be redone, and the oldest commands fall off past the limit.
+type CommandStack struct {
+	limit int
+	undo  []*Command
+	redo  []*Command
+}
+
+// NewCommandStack returns an empty stack keeping up to limit commands, the default for zero or less
+func NewCommandStack(limit int) *CommandStack {
+	if limit <= 0 {
+		limit = DefaultCommandLimit
+	}
+	return &CommandStack{limit: limit}
+}
+
+// Push adds a command that was just executed
+func (s *CommandStack) Push(command *Command) {
+	s.undo = append(s.undo, command)
+	if len(s.undo) > s.limit {
+		s.undo = s.undo[len(s.undo)-s.limit:]
+	}
+	s.redo = nil
+}
+
+// NextUndo returns the command the next undo reverts, nil when there is none
+func (s *CommandStack) NextUndo() *Command {
+	if len(s.undo) == 0 {
+		return nil
+	}
+	return s.undo[len(s.undo)-1]
+}
+
+// NextRedo returns the command the next redo executes again, nil when there is none
+func (s *CommandStack) NextRedo() *Command {
+	if len(s.redo) == 0 {
+		return nil
+	}
+	return s.redo[len(s.redo)-1]
+}
+
+// Undone moves the next command to undo over to the redo side
+func (s *CommandStack) Undone() {
+	if command := s.NextUndo(); command != nil {
+		s.undo = s.undo[:len(s.undo)-1]
+		s.redo = append(s.redo, command)
+	}
+}
+
+// Redone moves the next command to redo back to the undo side
+func (s *CommandStack) Redone() {
+	if command := s.NextRedo(); command != nil {
+		s.redo = s.redo[:len(s.redo)-1]
+		s.undo = append(s.undo, command)
+	}
+}
+
+// Forget drops the commands that touch the given transactions, for when they are gone for
+// good. The commands undone before or redone after one of them go too, since they build on it.
+func (s *CommandStack) Forget(ids []int) {
+	s.undo = forgetCommands(s.undo, ids)
+	s.redo = forgetCommands(s.redo, ids)
+}
+
+// forgetCommands keeps the commands above the topmost one touching ids
+func forgetCommands(commands []*Command, ids []int) []*Command {
+	for i := len(commands) - 1; i >= 0; i-- {
+		if commands[i].Touches(ids) {
+			return slices.Clone(commands[i+1:])
+		}
+	}
+	return commands
+}
+
+// DropUndo forgets the next command to undo along with everything older, since those
+// changes build on it
+func (s *CommandStack) DropUndo() {
+	s.undo = nil
+}
+
+// DropRedo forgets the next command to redo along with everything undone before it
+func (s *CommandStack) DropRedo() {
+	s.redo = nil
+}
@@ -0,0 +1,139 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type CommandTestSuite struct {
+	suite.Suite
+	groceries *Transaction
+	rent      *Transaction
+}
+
+func TestCommandSuite(t *testing.T) {
+	suite.Run(t, new(CommandTestSuite))
+}
+
+func (suite *CommandTestSuite) SetupTest() {
+	suite.groceries = &Transaction{
+		ID:          3,
+		Description: "Groceries",
+		Amount:      42.5,
+		Date:        time.Date(2026, 9, 14, 0, 0, 0, 0, time.UTC),
+		Type:        "expense",
+		Category:    &Category{ID: 1, Name: "Food & Dining"},
+	}
+	suite.rent = &Transaction{
+		ID:          1,
+		Description: "Rent",
+		Amount:      900,
+		Date:        time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC),
+		Type:        "expense",
+		Category:    &Category{ID: 2, Name: "Housing"},
+	}
+}
+
+func (suite *CommandTestSuite) TestNewCommand() {
+	assert := assert.New(suite.T())
+
+	assert.Nil(NewCommand("Edited Groceries", []*Transaction{suite.groceries}, []*Transaction{suite.groceries.Clone()}),
+		"nothing changed")
+
+	edited := suite.groceries.Clone()
+	edited.Amount = 45
+	command := NewCommand("Edited Groceries", []*Transaction{suite.groceries, suite.rent}, []*Transaction{edited})
+	suite.Require().NotNil(command)
+	assert.Equal([]int{1, 3}, command.IDs())
+	assert.Equal([]int{1}, command.Deleted())
+
+	inverse := command.Inverse()
+	assert.Equal("Edited Groceries", inverse.Description)
+	assert.Equal([]*Transaction{edited}, inverse.Before)
+	assert.Empty(inverse.Deleted(), "undoing brings the deleted transaction back")
+}
+
+func (suite *CommandTestSuite) TestAppliesTo() {
+	assert := assert.New(suite.T())
+
+	suite.groceries.Splits = []*Split{
+		{ID: 4, Category: &Category{ID: 1, Name: "Food & Dining"}, Amount: 30},
+		{ID: 5, Category: &Category{ID: 3, Name: "Healthcare"}, Amount: 12.5},
+	}
+	command := NewCommand("Added Groceries", nil, []*Transaction{suite.groceries})
+	suite.Require().NotNil(command)
+	assert.True(command.AppliesTo(nil))
+	assert.False(command.AppliesTo([]*Transaction{suite.groceries}))
+
+	undo := command.Inverse()
+	rewritten := suite.groceries.Clone()
+	rewritten.Splits[0].ID, rewritten.Splits[1].ID = 8, 9
+	assert.True(undo.AppliesTo([]*Transaction{rewritten}), "split lines get new IDs when written again")
+
+	rewritten.Description = "Market"
+	assert.False(undo.AppliesTo([]*Transaction{rewritten}))
+}
+
+func (suite *CommandTestSuite) TestCommandStack() {
+	assert := assert.New(suite.T())
+
+	stack := NewCommandStack(2)
+	assert.Nil(stack.NextUndo())
+	assert.Nil(stack.NextRedo())
+
+	first := &Command{Description: "first"}
+	second := &Command{Description: "second"}
+	third := &Command{Description: "third"}
+	stack.Push(first)
+	stack.Push(second)
+	stack.Push(third)
+	assert.Equal(third, stack.NextUndo())
+
+	stack.Undone()
+	stack.Undone()
+	assert.Nil(stack.NextUndo(), "the oldest command fell off past the limit")
+	assert.Equal(second, stack.NextRedo())
+
+	stack.Redone()
+	assert.Equal(second, stack.NextUndo())
+	assert.Equal(third, stack.NextRedo())
+
+	stack.Push(first)
+	assert.Nil(stack.NextRedo(), "a new command clears what could be redone")
+
+	stack.DropUndo()
+	assert.Nil(stack.NextUndo())
+	stack.DropRedo()
+	assert.Nil(stack.NextRedo())
+	assert.Equal(DefaultCommandLimit, NewCommandStack(0).limit)
+}
+
+func (suite *CommandTestSuite) TestCommandStack_Forget() {
+	assert := assert.New(suite.T())
+
+	added := NewCommand("Added Rent", nil, []*Transaction{suite.rent})
+	edited := NewCommand("Edited Groceries", []*Transaction{suite.groceries}, []*Transaction{suite.rent})
+	other := &Command{Description: "other", Before: []*Transaction{{ID: 9}}}
+	assert.True(edited.Touches([]int{3}))
+	assert.False(other.Touches([]int{1, 3}))
+
+	stack := NewCommandStack(0)
+	stack.Push(added)
+	stack.Push(other)
+	stack.Forget([]int{1})
+	assert.Equal(other, stack.NextUndo(), "later commands that do not touch the transaction stay")
+	stack.Undone()
+	assert.Nil(stack.NextUndo(), "the command touching the transaction went")
+
+	stack.Push(other)
+	stack.Push(edited)
+	stack.Undone()
+	stack.Undone()
+	stack.Forget([]int{3})
+	assert.Equal(other, stack.NextRedo(), "redone before the dropped command")
+	stack.Redone()
+	assert.Nil(stack.NextRedo())
+}
@@ -0,0 +1,211 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DateParser resolves the dates users type into forms. Besides the locale's input format
+// and YYYY-MM-DD it understands "today", "yesterday", "-3d", "2 weeks ago", "friday",
+// "last friday", "15" (day of the current month) and "oct 3".
+type DateParser struct {
+	locale      *Locale
+	now         func() time.Time
+	horizonDays int
+}
+
+// NewDateParser returns a parser that resolves relative dates against now and rejects
+// dates more than horizonDays days after today
+func NewDateParser(locale *Locale, now func() time.Time, horizonDays int) *DateParser {
+	return &DateParser{
+		locale:      locale,
+		now:         now,
+		horizonDays: max(horizonDays, 0),
+	}
+}
+
+var (
+	offsetPattern     = regexp.MustCompile(`^([+-])\s*(\d+)\s*([dwmy]?)$`)
+	agoPattern        = regexp.MustCompile(`^(\d+)\s*(d|days?|w|weeks?|m|months?|y|years?)\s+ago$`)
+	weekdayPattern    = regexp.MustCompile(`^(?:(last|next|this)\s+)?([a-z]+)$`)
+	dayOfMonthPattern = regexp.MustCompile(`^\d{1,2}$`)
+	monthDayPattern   = regexp.MustCompile(`^([a-z]+)\.?\s+(\d{1,2})(?:,?\s+(\d{4}))?$`)
+	dayMonthPattern   = regexp.MustCompile(`^(\d{1,2})\.?\s+([a-z]+)\.?(?:,?\s+(\d{4}))?$`)
+	weekdayNames      = map[string]time.Weekday{}
+	monthNames        = map[string]time.Month{}
+)
+
+func init() {
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		name := strings.ToLower(day.String())
+		weekdayNames[name] = day
+		weekdayNames[name[:3]] = day
+	}
+	weekdayNames["tues"] = time.Tuesday
+	weekdayNames["thur"] = time.Thursday
+	weekdayNames["thurs"] = time.Thursday
+
+	for month := time.January; month <= time.December; month++ {
+		name := strings.ToLower(month.String())
+		monthNames[name] = month
+		monthNames[name[:3]] = month
+	}
+	monthNames["sept"] = time.September
+}
+
+// Today returns midnight of the current day in the clock's location
+func (p *DateParser) Today() time.Time {
+	now := p.now()
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+}
+
+// Parse resolves input to midnight of a day in the clock's location. Empty input is today.
+func (p *DateParser) Parse(input string) (time.Time, error) {
+	date, err := p.Resolve(input)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if err := p.CheckHorizon(date); err != nil {
+		return time.Time{}, err
+	}
+	return date, nil
+}
+
+// CheckHorizon rejects dates more than the parser's horizon after today
+func (p *DateParser) CheckHorizon(date time.Time) error {
+	limit := p.Today().AddDate(0, 0, p.horizonDays)
+	if !date.After(limit) {
+		return nil
+	}
+	if p.horizonDays == 0 {
+		return fmt.Errorf("%s is in the future", p.locale.FormatDate(date))
+	}
+	return fmt.Errorf("%s is more than %d days ahead", p.locale.FormatDate(date), p.horizonDays)
+}
+
+// Resolve works out the day input refers to without checking the horizon
+func (p *DateParser) Resolve(input string) (time.Time, error) {
+	text := strings.Join(strings.Fields(strings.ToLower(input)), " ")
+	today := p.Today()
+
+	switch text {
+	case "", "today", "now", "t":
+		return today, nil
+	case "yesterday", "y":
+		return today.AddDate(0, 0, -1), nil
+	case "tomorrow":
+		return today.AddDate(0, 0, 1), nil
+	}
+
+	if match := offsetPattern.FindStringSubmatch(text); match != nil {
+		n, _ := strconv.Atoi(match[2])
+		if match[1] == "-" {
+			n = -n
+		}
+		return shiftDays(today, n, match[3]), nil
+	}
+
+	if match := agoPattern.FindStringSubmatch(text); match != nil {
+		n, _ := strconv.Atoi(match[1])
+		return shiftDays(today, -n, match[2][:1]), nil
+	}
+
+	if match := weekdayPattern.FindStringSubmatch(text); match != nil {
+		if weekday, ok := weekdayNames[match[2]]; ok {
+			return resolveWeekday(today, weekday, match[1]), nil
+		}
+	}
+
+	if dayOfMonthPattern.MatchString(text) {
+		day, _ := strconv.Atoi(text)
+		return calendarDate(today.Year(), today.Month(), day, today.Location())
+	}
+
+	if match := monthDayPattern.FindStringSubmatch(text); match != nil {
+		if month, ok := monthNames[match[1]]; ok {
+			return p.monthDay(month, match[2], match[3])
+		}
+	}
+	if match := dayMonthPattern.FindStringSubmatch(text); match != nil {
+		if month, ok := monthNames[match[2]]; ok {
+			return p.monthDay(month, match[1], match[3])
+		}
+	}
+
+	if date, err := p.locale.ParseDate(input, today.Location()); err == nil {
+		return date, nil
+	}
+	return time.Time{}, fmt.Errorf("unrecognized date %q (try %s, today, -3d, last fri or oct 3)", strings.TrimSpace(input), p.locale.InputDateFormat)
+}
+
+// monthDay resolves "oct 3" style dates; without a year the current year is used
+func (p *DateParser) monthDay(month time.Month, day, year string) (time.Time, error) {
+	today := p.Today()
+	d, _ := strconv.Atoi(day)
+	y := today.Year()
+	if year != "" {
+		y, _ = strconv.Atoi(year)
+	}
+	return calendarDate(y, month, d, today.Location())
+}
+
+// shiftDays moves date by n units of d(ays), w(eeks), m(onths) or y(ears); days when unit is empty
+func shiftDays(date time.Time, n int, unit string) time.Time {
+	switch unit {
+	case "w":
+		return date.AddDate(0, 0, 7*n)
+	case "m":
+		return addMonths(date, n)
+	case "y":
+		return addMonths(date, 12*n)
+	default:
+		return date.AddDate(0, 0, n)
+	}
+}
+
+// addMonths moves date by n months, keeping to the last day of a month that is shorter
+// than date's day instead of rolling over into the next, e.g. March 31st to February 28th
+func addMonths(date time.Time, n int) time.Time {
+	first := time.Date(date.Year(), date.Month()+time.Month(n), 1, 0, 0, 0, 0, date.Location())
+	day := min(date.Day(), daysInMonth(first.Year(), first.Month()))
+	return time.Date(first.Year(), first.Month(), day, date.Hour(), date.Minute(), date.Second(), date.Nanosecond(), date.Location())
+}
+
+// resolveWeekday finds weekday relative to today: a bare or "this" weekday is the most
+// recent one including today, "last" the one strictly before today and "next" the one after
+func resolveWeekday(today time.Time, weekday time.Weekday, modifier string) time.Time {
+	back := (int(today.Weekday()) - int(weekday) + 7) % 7
+	switch modifier {
+	case "last":
+		if back == 0 {
+			back = 7
+		}
+	case "next":
+		forward := (int(weekday) - int(today.Weekday()) + 7) % 7
+		if forward == 0 {
+			forward = 7
+		}
+		return today.AddDate(0, 0, forward)
+	}
+	return today.AddDate(0, 0, -back)
+}
+
+// calendarDate builds a date, rejecting days the month does not have instead of rolling over
+func calendarDate(year int, month time.Month, day int, location *time.Location) (time.Time, error) {
+	days := daysInMonth(year, month)
+	if day < 1 {
+		return time.Time{}, fmt.Errorf("invalid day %d", day)
+	}
+	if day > days {
+		return time.Time{}, fmt.Errorf("%s %d has only %d days", month, year, days)
+	}
+	return time.Date(year, month, day, 0, 0, 0, 0, location), nil
+}
+
+// daysInMonth returns how many days month has in year
+func daysInMonth(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
@@ -0,0 +1,174 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type DateParserTestSuite struct {
+	suite.Suite
+	zone *time.Location
+	now  time.Time
+}
+
+func TestDateParserSuite(t *testing.T) {
+	suite.Run(t, new(DateParserTestSuite))
+}
+
+func (suite *DateParserTestSuite) SetupTest() {
+	suite.zone = time.FixedZone("UTC-5", -5*60*60)
+	// Wednesday afternoon
+	suite.now = time.Date(2026, 10, 14, 15, 30, 0, 0, suite.zone)
+}
+
+func (suite *DateParserTestSuite) parser(horizonDays int) *DateParser {
+	return NewDateParser(DefaultLocale(), func() time.Time { return suite.now }, horizonDays)
+}
+
+func (suite *DateParserTestSuite) date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, suite.zone)
+}
+
+func (suite *DateParserTestSuite) TestParse() {
+	testCases := []struct {
+		input    string
+		expected time.Time
+	}{
+		{"", suite.date(2026, 10, 14)},
+		{"today", suite.date(2026, 10, 14)},
+		{" Today ", suite.date(2026, 10, 14)},
+		{"yesterday", suite.date(2026, 10, 13)},
+		{"y", suite.date(2026, 10, 13)},
+		{"-3d", suite.date(2026, 10, 11)},
+		{"-3", suite.date(2026, 10, 11)},
+		{"- 2w", suite.date(2026, 9, 30)},
+		{"-1m", suite.date(2026, 9, 14)},
+		{"-1y", suite.date(2025, 10, 14)},
+		{"3 days ago", suite.date(2026, 10, 11)},
+		{"1 week ago", suite.date(2026, 10, 7)},
+		{"2 months ago", suite.date(2026, 8, 14)},
+		{"friday", suite.date(2026, 10, 9)},
+		{"fri", suite.date(2026, 10, 9)},
+		{"last friday", suite.date(2026, 10, 9)},
+		{"wednesday", suite.date(2026, 10, 14)},
+		{"this wed", suite.date(2026, 10, 14)},
+		{"last wed", suite.date(2026, 10, 7)},
+		{"Last Thurs", suite.date(2026, 10, 8)},
+		{"1", suite.date(2026, 10, 1)},
+		{"14", suite.date(2026, 10, 14)},
+		{"oct 3", suite.date(2026, 10, 3)},
+		{"October 3", suite.date(2026, 10, 3)},
+		{"3 oct", suite.date(2026, 10, 3)},
+		{"sept 30", suite.date(2026, 9, 30)},
+		{"dec 24, 2025", suite.date(2025, 12, 24)},
+		{"24 dec 2025", suite.date(2025, 12, 24)},
+		{"2026-10-01", suite.date(2026, 10, 1)},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.input, func() {
+			date, err := suite.parser(0).Parse(tc.input)
+			suite.Require().NoError(err)
+			assert.Equal(suite.T(), tc.expected, date)
+		})
+	}
+
+	// Month and year shifts keep to the end of a shorter month instead of rolling over
+	monthEnds := []struct {
+		today    time.Time
+		input    string
+		expected time.Time
+	}{
+		{suite.date(2026, 3, 31), "-1m", suite.date(2026, 2, 28)},
+		{suite.date(2028, 3, 31), "-1m", suite.date(2028, 2, 29)},
+		{suite.date(2026, 5, 31), "1 month ago", suite.date(2026, 4, 30)},
+		{suite.date(2026, 12, 31), "-10m", suite.date(2026, 2, 28)},
+		{suite.date(2027, 1, 31), "-2m", suite.date(2026, 11, 30)},
+		{suite.date(2028, 2, 29), "-1y", suite.date(2027, 2, 28)},
+		{suite.date(2028, 2, 29), "4 years ago", suite.date(2024, 2, 29)},
+	}
+
+	for _, tc := range monthEnds {
+		suite.Run(tc.today.Format(time.DateOnly)+" "+tc.input, func() {
+			suite.now = tc.today.Add(12 * time.Hour)
+			date, err := suite.parser(0).Parse(tc.input)
+			suite.Require().NoError(err)
+			assert.Equal(suite.T(), tc.expected, date)
+		})
+	}
+}
+
+func (suite *DateParserTestSuite) TestParse_Invalid() {
+	testCases := []struct {
+		input   string
+		message string
+	}{
+		{"someday", "unrecognized date"},
+		{"32", "has only 31 days"},
+		{"0", "invalid day"},
+		{"feb 30", "February 2026 has only 28 days"},
+		{"2026-13-01", "unrecognized date"},
+		{"last", "unrecognized date"},
+		{"3. okt", "unrecognized date"},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.input, func() {
+			_, err := suite.parser(0).Parse(tc.input)
+			suite.Require().Error(err)
+			assert.Contains(suite.T(), err.Error(), tc.message)
+		})
+	}
+}
+
+func (suite *DateParserTestSuite) TestParse_Horizon() {
+	testCases := []struct {
+		input       string
+		horizonDays int
+		expected    time.Time
+		message     string
+	}{
+		{"tomorrow", 0, time.Time{}, "Oct 15, 2026 is in the future"},
+		{"tomorrow", 1, suite.date(2026, 10, 15), ""},
+		{"15", 0, time.Time{}, "is in the future"},
+		{"next monday", 7, suite.date(2026, 10, 19), ""},
+		{"next wed", 7, suite.date(2026, 10, 21), ""},
+		{"+8d", 7, time.Time{}, "more than 7 days ahead"},
+		{"dec 24", 30, time.Time{}, "more than 30 days ahead"},
+		{"+1d", -5, time.Time{}, "is in the future"},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.input, func() {
+			date, err := suite.parser(tc.horizonDays).Parse(tc.input)
+			if tc.message != "" {
+				suite.Require().Error(err)
+				assert.Contains(suite.T(), err.Error(), tc.message)
+				return
+			}
+			suite.Require().NoError(err)
+			assert.Equal(suite.T(), tc.expected, date)
+		})
+	}
+}
+
+func (suite *DateParserTestSuite) TestParse_LocaleInputFormat() {
+	german, err := NewLocale("de-DE", nil)
+	suite.Require().NoError(err)
+	parser := NewDateParser(german, func() time.Time { return suite.now }, 0)
+
+	date, err := parser.Parse("01.10.2026")
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), suite.date(2026, 10, 1), date)
+
+	_, err = parser.Parse("10/01/2026")
+	suite.Require().Error(err)
+	assert.Contains(suite.T(), err.Error(), "DD.MM.YYYY")
+}
+
+func (suite *DateParserTestSuite) TestToday() {
+	assert.Equal(suite.T(), suite.date(2026, 10, 14), suite.parser(0).Today())
+}
@@ -0,0 +1,177 @@
+package domain
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// DefaultDuplicateWindowDays is how many days apart two entries may be dated and still be
+// taken for the same transaction, e.g. a receipt entered on the day of purchase and the
+// bank statement booking it two days later
+const DefaultDuplicateWindowDays = 3
+
+// MinDuplicateSimilarity is how similar two descriptions have to be for a duplicate
+const MinDuplicateSimilarity = 0.6
+
+// DuplicateKey identifies a pair of transactions regardless of their order
+type DuplicateKey struct {
+	First  int `json:"first"` // the lower ID
+	Second int `json:"second"`
+}
+
+func NewDuplicateKey(a, b int) DuplicateKey {
+	if a > b {
+		a, b = b, a
+	}
+	return DuplicateKey{First: a, Second: b}
+}
+
+// DuplicatePair is two transactions that are likely the same one entered twice
+type DuplicatePair struct {
+	Original   *Transaction `json:"original"` // the earlier entry
+	Duplicate  *Transaction `json:"duplicate"`
+	Similarity float64      `json:"similarity"` // of the descriptions, 0..1
+}
+
+func (p *DuplicatePair) Key() DuplicateKey {
+	return NewDuplicateKey(p.Original.ID, p.Duplicate.ID)
+}
+
+// DuplicateDetector finds likely duplicates: the same type and amount, dated at most
+// windowDays apart, with similar descriptions. Pairs the user dismissed and pairs that were
+// both reconciled are never reported.
+type DuplicateDetector struct {
+	windowDays int
+	dismissed  map[DuplicateKey]bool
+}
+
+func NewDuplicateDetector(windowDays int, dismissed []DuplicateKey) *DuplicateDetector {
+	detector := &DuplicateDetector{windowDays: windowDays, dismissed: make(map[DuplicateKey]bool, len(dismissed))}
+	for _, key := range dismissed {
+		detector.dismissed[key] = true
+	}
+	return detector
+}
+
+// Match reports whether two transactions look like the same one and how similar their descriptions are
+func (d *DuplicateDetector) Match(a, b *Transaction) (float64, bool) {
+	if a.Type != b.Type || toCents(a.Amount) != toCents(b.Amount) {
+		return 0, false
+	}
+	if a.ID > 0 && b.ID > 0 && (a.ID == b.ID || d.dismissed[NewDuplicateKey(a.ID, b.ID)]) {
+		return 0, false
+	}
+	if a.IsReconciled() && b.IsReconciled() {
+		return 0, false // both showed up on a statement, so they are two real transactions
+	}
+	if days := daysApart(a.Date, b.Date); days > d.windowDays {
+		return 0, false
+	}
+	similarity := DescriptionSimilarity(a.Description, b.Description)
+	return similarity, similarity >= MinDuplicateSimilarity
+}
+
+// FindFor returns the candidates a transaction that is about to be saved would duplicate,
+// the most similar first
+func (d *DuplicateDetector) FindFor(transaction *Transaction, candidates []*Transaction) []*DuplicatePair {
+	var pairs []*DuplicatePair
+	for _, candidate := range candidates {
+		if similarity, ok := d.Match(candidate, transaction); ok {
+			pairs = append(pairs, &DuplicatePair{Original: candidate, Duplicate: transaction, Similarity: similarity})
+		}
+	}
+	sort.SliceStable(pairs, func(i, j int) bool {
+		return pairs[i].Similarity > pairs[j].Similarity
+	})
+	return pairs
+}
+
+// FindAmong returns the likely duplicate pairs among saved transactions, oldest first.
+// The entry with the earlier date, or the lower ID on the same day, is the original.
+func (d *DuplicateDetector) FindAmong(transactions []*Transaction) []*DuplicatePair {
+	sorted := make([]*Transaction, len(transactions))
+	copy(sorted, transactions)
+	sort.Slice(sorted, func(i, j int) bool {
+		if !sorted[i].Date.Equal(sorted[j].Date) {
+			return sorted[i].Date.Before(sorted[j].Date)
+		}
+		return sorted[i].ID < sorted[j].ID
+	})
+
+	var pairs []*DuplicatePair
+	for i, original := range sorted {
+		for _, candidate := range sorted[i+1:] {
+			if daysApart(original.Date, candidate.Date) > d.windowDays {
+				break
+			}
+			if similarity, ok := d.Match(original, candidate); ok {
+				pairs = append(pairs, &DuplicatePair{Original: original, Duplicate: candidate, Similarity: similarity})
+			}
+		}
+	}
+	return pairs
+}
+
+// daysApart counts the calendar days between two dates in the configured timezone, ignoring
+// the time of day. The days are compared in UTC, where every one of them is 24 hours long.
+func daysApart(a, b time.Time) int {
+	a, b = a.In(timezone), b.In(timezone)
+	dayA := time.Date(a.Year(), a.Month(), a.Day(), 0, 0, 0, 0, time.UTC)
+	dayB := time.Date(b.Year(), b.Month(), b.Day(), 0, 0, 0, 0, time.UTC)
+	days := int(dayA.Sub(dayB).Hours() / 24)
+	if days < 0 {
+		return -days
+	}
+	return days
+}
+
+// DescriptionSimilarity compares two descriptions by edit distance, ignoring case and
+// spacing: 1 for the same text, 0 for nothing in common
+func DescriptionSimilarity(a, b string) float64 {
+	ra := []rune(strings.Join(strings.Fields(strings.ToLower(a)), " "))
+	rb := []rune(strings.Join(strings.Fields(strings.ToLower(b)), " "))
+	longest := max(len(ra), len(rb))
+	if longest == 0 {
+		return 1
+	}
+	return 1 - float64(editDistance(ra, rb))/float64(longest)
+}
+
+// editDistance is the Levenshtein distance: how many single character insertions,
+// deletions and substitutions turn a into b
+func editDistance(a, b []rune) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
+
+// MergeDuplicate returns keep with what only the duplicate knows filled in: its tags are
+// added, and its category and payee are used where keep has none
+func MergeDuplicate(keep, duplicate *Transaction) *Transaction {
+	merged := keep.Clone()
+	merged.Tags = NormalizeTags(append(merged.Tags, duplicate.Tags...))
+	if merged.Category == nil && !merged.IsSplit() && duplicate.Category != nil {
+		category := *duplicate.Category
+		merged.Category = &category
+	}
+	if merged.Payee == nil && duplicate.Payee != nil {
+		payee := *duplicate.Payee
+		merged.Payee = &payee
+	}
+	return merged
+}
@@ -0,0 +1,151 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type DuplicateTestSuite struct {
+	suite.Suite
+	day      time.Time
+	detector *DuplicateDetector
+}
+
+func TestDuplicateSuite(t *testing.T) {
+	suite.Run(t, new(DuplicateTestSuite))
+}
+
+func (suite *DuplicateTestSuite) SetupTest() {
+	suite.day = time.Date(2026, 10, 14, 9, 30, 0, 0, time.UTC)
+	suite.detector = NewDuplicateDetector(DefaultDuplicateWindowDays, nil)
+}
+
+func (suite *DuplicateTestSuite) transaction(id int, description string, amount float64, days int) *Transaction {
+	return &Transaction{ID: id, Description: description, Amount: amount, Type: "expense", Date: suite.day.AddDate(0, 0, days)}
+}
+
+func (suite *DuplicateTestSuite) TestMatch() {
+	assert := assert.New(suite.T())
+
+	receipt := suite.transaction(1, "Lidl Berlin", 23.5, 0)
+
+	testCases := []struct {
+		name     string
+		other    *Transaction
+		expected bool
+	}{
+		{"statement booking two days later", suite.transaction(2, "LIDL  Berlin 0412", 23.5, 2), true},
+		{"same entry typed twice", suite.transaction(2, "Lidl Berlin", 23.5, 0), true},
+		{"earlier date", suite.transaction(2, "lidl berlin", 23.5, -3), true},
+		{"too far apart", suite.transaction(2, "Lidl Berlin", 23.5, 4), false},
+		{"different amount", suite.transaction(2, "Lidl Berlin", 23.51, 0), false},
+		{"different description", suite.transaction(2, "Rewe Markt", 23.5, 0), false},
+		{"itself", suite.transaction(1, "Lidl Berlin", 23.5, 0), false},
+	}
+	for _, tc := range testCases {
+		_, ok := suite.detector.Match(receipt, tc.other)
+		assert.Equal(tc.expected, ok, tc.name)
+	}
+
+	income := suite.transaction(2, "Lidl Berlin", 23.5, 0)
+	income.Type = "income"
+	_, ok := suite.detector.Match(receipt, income)
+	assert.False(ok, "income never duplicates an expense")
+
+	// A time late in the day still counts as the same calendar day
+	late := suite.transaction(2, "Lidl Berlin", 23.5, 3)
+	late.Date = late.Date.Add(14 * time.Hour)
+	_, ok = suite.detector.Match(receipt, late)
+	assert.True(ok)
+}
+
+func (suite *DuplicateTestSuite) TestMatch_Dismissed() {
+	detector := NewDuplicateDetector(DefaultDuplicateWindowDays, []DuplicateKey{NewDuplicateKey(7, 3)})
+
+	_, ok := detector.Match(suite.transaction(3, "Coffee", 3, 0), suite.transaction(7, "Coffee", 3, 0))
+	assert.False(suite.T(), ok, "a pair marked as not duplicate is never flagged again")
+	_, ok = detector.Match(suite.transaction(3, "Coffee", 3, 0), suite.transaction(8, "Coffee", 3, 0))
+	assert.True(suite.T(), ok)
+}
+
+func (suite *DuplicateTestSuite) TestMatch_Reconciled() {
+	a, b := suite.transaction(1, "Coffee", 3, 0), suite.transaction(2, "Coffee", 3, 0)
+	a.Status = StatusReconciled
+
+	_, ok := suite.detector.Match(a, b)
+	assert.True(suite.T(), ok)
+
+	b.Status = StatusReconciled
+	_, ok = suite.detector.Match(a, b)
+	assert.False(suite.T(), ok, "two reconciled entries were both on the statement")
+}
+
+func (suite *DuplicateTestSuite) TestFindFor() {
+	assert := assert.New(suite.T())
+
+	candidates := []*Transaction{
+		suite.transaction(1, "Amazon.de order", 35, -1),
+		suite.transaction(2, "Amazon order", 35, -2),
+		suite.transaction(3, "Amazon order", 20, 0),
+	}
+	pairs := suite.detector.FindFor(suite.transaction(0, "amazon order", 35, 0), candidates)
+	suite.Require().Len(pairs, 2)
+	assert.Equal(2, pairs[0].Original.ID, "the most similar comes first")
+	assert.Equal(1.0, pairs[0].Similarity)
+	assert.Equal(1, pairs[1].Original.ID)
+	assert.Empty(suite.detector.FindFor(suite.transaction(0, "Bakery", 35, 0), candidates))
+}
+
+func (suite *DuplicateTestSuite) TestFindAmong() {
+	assert := assert.New(suite.T())
+
+	pairs := suite.detector.FindAmong([]*Transaction{
+		suite.transaction(5, "Netflix", 12.99, 1),
+		suite.transaction(4, "NETFLIX.COM", 12.99, 0),
+		suite.transaction(6, "Netflix", 12.99, 31),
+		suite.transaction(9, "Rent", 900, 0),
+		suite.transaction(8, "Rent", 900, 0),
+	})
+
+	suite.Require().Len(pairs, 2)
+	assert.Equal(NewDuplicateKey(4, 5), pairs[0].Key())
+	assert.Equal(4, pairs[0].Original.ID, "the earlier date is the original")
+	assert.Equal(8, pairs[1].Original.ID, "on the same day the lower ID is the original")
+	assert.Equal(9, pairs[1].Duplicate.ID)
+}
+
+func (suite *DuplicateTestSuite) TestDescriptionSimilarity() {
+	assert := assert.New(suite.T())
+
+	assert.Equal(1.0, DescriptionSimilarity("Lidl  Berlin", "lidl berlin"))
+	assert.Equal(0.0, DescriptionSimilarity("Lidl", "Rewe"))
+	assert.InDelta(0.75, DescriptionSimilarity("Café", "Cafe"), 0.001)
+	assert.Equal(1.0, DescriptionSimilarity("", ""))
+	assert.Equal(0.0, DescriptionSimilarity("", "Lidl"))
+}
+
+func (suite *DuplicateTestSuite) TestMergeDuplicate() {
+	assert := assert.New(suite.T())
+
+	keep := suite.transaction(1, "Lidl Berlin", 23.5, 0)
+	keep.Tags = []string{"weekly"}
+	duplicate := suite.transaction(2, "LIDL BERLIN 0412", 23.5, 2)
+	duplicate.Tags = []string{"card", "weekly"}
+	duplicate.Category = &Category{ID: 1, Name: "Groceries"}
+	duplicate.Payee = &Payee{ID: 4, Name: "Lidl"}
+
+	merged := MergeDuplicate(keep, duplicate)
+	assert.Equal(1, merged.ID)
+	assert.Equal("Lidl Berlin", merged.Description)
+	assert.Equal(suite.day, merged.Date)
+	assert.Equal([]string{"card", "weekly"}, merged.Tags)
+	assert.Equal("Groceries", merged.Category.Name)
+	assert.Equal("Lidl", merged.Payee.Name)
+	assert.Equal([]string{"weekly"}, keep.Tags, "keep itself is left as it was")
+
+	keep.Category = &Category{ID: 3, Name: "Shopping"}
+	assert.Equal("Shopping", MergeDuplicate(keep, duplicate).Category.Name, "what keep has wins")
+}
@@ -3,6 +3,7 @@ package domain
 import (
 	"fmt"
 	"math"
+	"sort"
 	"strings"
 	"time"
 )
@@ -26,6 +27,20 @@ func (p PeriodType) IsValid() bool {
 	}
 }
 
+// Next cycles through the navigable period types: week, month, quarter, year and back to week
+func (p PeriodType) Next() PeriodType {
+	switch p {
+	case PeriodTypeWeek:
+		return PeriodTypeMonth
+	case PeriodTypeMonth:
+		return PeriodTypeQuarter
+	case PeriodTypeQuarter:
+		return PeriodTypeYear
+	default:
+		return PeriodTypeWeek
+	}
+}
+
 type DateRange struct {
 	Start time.Time `json:"start"`
 	End   time.Time `json:"end"`
@@ -98,6 +113,8 @@ type Summary struct {
 	DateRange               *DateRange           `json:"date_range"`
 	IncomeBreakdown         []*CategoryBreakdown `json:"income_breakdown,omitempty"`
 	ExpenseBreakdown        []*CategoryBreakdown `json:"expense_breakdown,omitempty"`
+	IncomePayees            []*PayeeBreakdown    `json:"income_payees,omitempty"`
+	ExpensePayees           []*PayeeBreakdown    `json:"expense_payees,omitempty"`
 	TransactionCount        int                  `json:"transaction_count"`
 	IncomeTransactionCount  int                  `json:"income_transaction_count"`
 	ExpenseTransactionCount int                  `json:"expense_transaction_count"`
@@ -169,13 +186,66 @@ func (s *Summary) GetTopIncomeCategories(limit int) []*CategoryBreakdown {
 	return s.IncomeBreakdown[:limit]
 }
 
+// OtherCategoryName labels the bucket that collects categories beyond the top ones
+const OtherCategoryName = "Other"
+
+// CollapseBreakdown keeps the limit largest categories and folds the rest into a single
+// "Other" bucket. A real category called "Other" is always folded into that bucket.
+func CollapseBreakdown(breakdowns []*CategoryBreakdown, limit int) []*CategoryBreakdown {
+	sorted := make([]*CategoryBreakdown, 0, len(breakdowns))
+	var other *CategoryBreakdown
+	for _, breakdown := range breakdowns {
+		if breakdown.Category != nil && strings.EqualFold(breakdown.Category.Name, OtherCategoryName) {
+			other = mergeIntoOther(other, breakdown)
+			continue
+		}
+		sorted = append(sorted, breakdown)
+	}
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].TotalAmount > sorted[j].TotalAmount
+	})
+
+	if limit < 0 {
+		limit = 0
+	}
+	result := sorted
+	if len(sorted) > limit {
+		result = sorted[:limit]
+		for _, breakdown := range sorted[limit:] {
+			other = mergeIntoOther(other, breakdown)
+		}
+	}
+
+	if other != nil {
+		result = append(result[:len(result):len(result)], other)
+	}
+	return result
+}
+
+func mergeIntoOther(other, breakdown *CategoryBreakdown) *CategoryBreakdown {
+	if other == nil {
+		other = &CategoryBreakdown{Category: &Category{Name: OtherCategoryName}}
+	}
+	other.TotalAmount += breakdown.TotalAmount
+	other.TransactionCount += breakdown.TransactionCount
+	other.Percentage = math.Round((other.Percentage+breakdown.Percentage)*100) / 100
+	return other
+}
+
 type Transaction struct {
-	ID          int       `json:"id"`
-	Description string    `json:"description"`
-	Amount      float64   `json:"amount"`
-	Date        time.Time `json:"date"`
-	Type        string    `json:"type"` // "income" or "expense"
-	Category    *Category `json:"category,omitempty"`
+	ID          int               `json:"id"`
+	Description string            `json:"description"`
+	Amount      float64           `json:"amount"`
+	Date        time.Time         `json:"date"`
+	Type        string            `json:"type"` // "income" or "expense"
+	Category    *Category         `json:"category,omitempty"`
+	Payee       *Payee            `json:"payee,omitempty"`
+	Tags        []string          `json:"tags,omitempty"`
+	Splits      []*Split          `json:"splits,omitempty"`
+	Transfer    bool              `json:"transfer,omitempty"` // money moved between own accounts, left out of summaries
+	Status      TransactionStatus `json:"status,omitempty"`
+	DeletedAt   *time.Time        `json:"deleted_at,omitempty"` // set while the transaction is in the trash
 }
 
 func (t *Transaction) Validate() error {
@@ -205,6 +275,18 @@ func (t *Transaction) Validate() error {
 		}
 	}
 
+	if err := ValidateTags(t.Tags); err != nil {
+		return err
+	}
+
+	if err := t.ValidateSplits(); err != nil {
+		return err
+	}
+
+	if err := t.Status.Validate(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -216,64 +298,142 @@ func (t *Transaction) IsExpense() bool {
 	return t.Type == "expense"
 }
 
-func GetCurrentWeekRange() *DateRange {
-	now := time.Now()
-	weekday := int(now.Weekday())
-	if weekday == 0 {
-		weekday = 7
+// CategoryName is the category shown for the transaction in lists
+func (t *Transaction) CategoryName() string {
+	switch {
+	case t.IsSplit():
+		return fmt.Sprintf("Split (%d)", len(t.Splits))
+	case t.Category != nil:
+		return t.Category.Name
+	default:
+		return "Uncategorized"
 	}
-	start := now.AddDate(0, 0, -weekday+1).Truncate(24 * time.Hour)
-	end := start.AddDate(0, 0, 7).Add(-time.Nanosecond)
-	return NewDateRange(start, end)
 }
 
-func GetCurrentMonthRange() *DateRange {
-	now := time.Now()
-	start := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
-	end := start.AddDate(0, 1, 0).Add(-time.Nanosecond)
-	return NewDateRange(start, end)
+// NormalizeTags trims, lowercases and de-duplicates tags, dropping empty ones, and returns them sorted
+func NormalizeTags(tags []string) []string {
+	seen := make(map[string]bool, len(tags))
+	var normalized []string
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		normalized = append(normalized, tag)
+	}
+	sort.Strings(normalized)
+	return normalized
 }
 
-func GetCurrentQuarterRange() *DateRange {
-	now := time.Now()
-	quarter := (int(now.Month()) - 1) / 3
-	startMonth := time.Month(quarter*3 + 1)
-	start := time.Date(now.Year(), startMonth, 1, 0, 0, 0, 0, now.Location())
-	end := start.AddDate(0, 3, 0).Add(-time.Nanosecond)
-	return NewDateRange(start, end)
+// ParseTags splits a comma separated tag list as typed by the user
+func ParseTags(input string) []string {
+	return NormalizeTags(strings.Split(input, ","))
 }
 
-func GetCurrentYearRange() *DateRange {
-	now := time.Now()
-	start := time.Date(now.Year(), 1, 1, 0, 0, 0, 0, now.Location())
-	end := start.AddDate(1, 0, 0).Add(-time.Nanosecond)
-	return NewDateRange(start, end)
+func ValidateTags(tags []string) error {
+	for _, tag := range tags {
+		if strings.Contains(tag, ",") {
+			return fmt.Errorf("tag %q cannot contain a comma", tag)
+		}
+		if len(tag) > 30 {
+			return fmt.Errorf("tag %q cannot exceed 30 characters", tag)
+		}
+	}
+	return nil
 }
 
-func GetWeekRange(year, week int) *DateRange {
-	jan1 := time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC)
+// Cursor returns the keyset position of the transaction in the listing order
+func (t *Transaction) Cursor() *PageCursor {
+	return &PageCursor{Date: t.Date, ID: t.ID}
+}
+
+// PageCursor marks a position in the transaction listing, which is ordered by (date, id) descending
+type PageCursor struct {
+	Date time.Time `json:"date"`
+	ID   int       `json:"id"`
+}
 
-	_, w := jan1.ISOWeek()
+// PageRequest describes one page of the transaction listing.
+// With no cursor the page starts at the newest transaction, or at the oldest when FromEnd is set.
+type PageRequest struct {
+	Query   string      `json:"query,omitempty"`
+	Payee   string      `json:"payee,omitempty"` // only transactions whose payee name starts with this
+	After   *PageCursor `json:"after,omitempty"`
+	Before  *PageCursor `json:"before,omitempty"`
+	FromEnd bool        `json:"from_end,omitempty"`
+	Limit   int         `json:"limit"`
+}
 
-	var start time.Time
-	if w == 1 {
-		start = jan1.AddDate(0, 0, (week-1)*7)
-	} else {
-		mondayOfWeek1 := jan1.AddDate(0, 0, -int(jan1.Weekday())+1)
-		if jan1.Weekday() == time.Sunday {
-			mondayOfWeek1 = mondayOfWeek1.AddDate(0, 0, 1)
-		}
-		start = mondayOfWeek1.AddDate(0, 0, (week-1)*7)
+func (r *PageRequest) Validate() error {
+	if r.Limit <= 0 {
+		return fmt.Errorf("page limit must be positive")
 	}
+	if r.After != nil && r.Before != nil {
+		return fmt.Errorf("page request cannot have both an after and a before cursor")
+	}
+	if r.FromEnd && (r.After != nil || r.Before != nil) {
+		return fmt.Errorf("page request cannot combine a cursor with from end")
+	}
+	return nil
+}
 
-	end := start.AddDate(0, 0, 7).Add(-time.Nanosecond)
-	return NewDateRange(start, end)
+// TransactionPage holds one page of transactions in listing order together with the
+// total number of transactions matching the request's query
+type TransactionPage struct {
+	Transactions []*Transaction `json:"transactions"`
+	TotalCount   int            `json:"total_count"`
+}
+
+// PageCount returns how many pages of pageSize are needed to show total items
+func PageCount(total, pageSize int) int {
+	if total <= 0 || pageSize <= 0 {
+		return 0
+	}
+	return (total + pageSize - 1) / pageSize
+}
+
+// LastPageSize returns the number of items on the final page, so that a page
+// anchored at the end of the listing lines up with the pages counted from the start
+func LastPageSize(total, pageSize int) int {
+	if total <= 0 || pageSize <= 0 {
+		return 0
+	}
+	if remainder := total % pageSize; remainder != 0 {
+		return remainder
+	}
+	return pageSize
+}
+
+// GetCurrentWeekRange returns the week, Monday to Sunday, that today falls in
+func GetCurrentWeekRange() *DateRange {
+	return GetPeriodRange(PeriodTypeWeek, Now())
+}
+
+// GetCurrentMonthRange returns the month today falls in
+func GetCurrentMonthRange() *DateRange {
+	return GetPeriodRange(PeriodTypeMonth, Now())
+}
+
+// GetCurrentQuarterRange returns the quarter today falls in
+func GetCurrentQuarterRange() *DateRange {
+	return GetPeriodRange(PeriodTypeQuarter, Now())
+}
+
+// GetCurrentYearRange returns the year today falls in
+func GetCurrentYearRange() *DateRange {
+	return GetPeriodRange(PeriodTypeYear, Now())
+}
+
+// GetWeekRange returns ISO week number week of year, which starts on a Monday. Week 1 is
+// the one with January 4th in it.
+func GetWeekRange(year, week int) *DateRange {
+	jan4 := time.Date(year, 1, 4, 0, 0, 0, 0, timezone)
+	return GetPeriodRange(PeriodTypeWeek, jan4.AddDate(0, 0, (week-1)*7))
 }
 
 func GetMonthRange(year int, month time.Month) *DateRange {
-	start := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
-	end := start.AddDate(0, 1, 0).Add(-time.Nanosecond)
-	return NewDateRange(start, end)
+	return GetPeriodRange(PeriodTypeMonth, time.Date(year, month, 1, 0, 0, 0, 0, timezone))
 }
 
 func GetQuarterRange(year, quarter int) *DateRange {
@@ -281,42 +441,189 @@ func GetQuarterRange(year, quarter int) *DateRange {
 		return nil
 	}
 	startMonth := time.Month((quarter-1)*3 + 1)
-	start := time.Date(year, startMonth, 1, 0, 0, 0, 0, time.UTC)
-	end := start.AddDate(0, 3, 0).Add(-time.Nanosecond)
-	return NewDateRange(start, end)
+	return GetPeriodRange(PeriodTypeQuarter, time.Date(year, startMonth, 1, 0, 0, 0, 0, timezone))
 }
 
 func GetYearRange(year int) *DateRange {
-	start := time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC)
-	end := start.AddDate(1, 0, 0).Add(-time.Nanosecond)
-	return NewDateRange(start, end)
+	return GetPeriodRange(PeriodTypeYear, time.Date(year, 1, 1, 0, 0, 0, 0, timezone))
 }
 
+// GetPeriodRange returns the period reference falls in. Periods start and end at midnight
+// in the configured timezone, whatever the location of reference.
 func GetPeriodRange(periodType PeriodType, reference time.Time) *DateRange {
+	reference = reference.In(timezone)
+	var start time.Time
 	switch periodType {
 	case PeriodTypeWeek:
 		weekday := int(reference.Weekday())
 		if weekday == 0 {
 			weekday = 7
 		}
-		start := reference.AddDate(0, 0, -weekday+1).Truncate(24 * time.Hour)
-		end := start.AddDate(0, 0, 7).Add(-time.Nanosecond)
-		return NewDateRange(start, end)
+		start = StartOfDay(reference).AddDate(0, 0, -weekday+1)
+		return NewDateRange(start, start.AddDate(0, 0, 7).Add(-time.Nanosecond))
 	case PeriodTypeMonth:
-		start := time.Date(reference.Year(), reference.Month(), 1, 0, 0, 0, 0, reference.Location())
-		end := start.AddDate(0, 1, 0).Add(-time.Nanosecond)
-		return NewDateRange(start, end)
+		start = time.Date(reference.Year(), reference.Month(), 1, 0, 0, 0, 0, timezone)
+		return NewDateRange(start, start.AddDate(0, 1, 0).Add(-time.Nanosecond))
 	case PeriodTypeQuarter:
 		quarter := (int(reference.Month()) - 1) / 3
-		startMonth := time.Month(quarter*3 + 1)
-		start := time.Date(reference.Year(), startMonth, 1, 0, 0, 0, 0, reference.Location())
-		end := start.AddDate(0, 3, 0).Add(-time.Nanosecond)
-		return NewDateRange(start, end)
+		start = time.Date(reference.Year(), time.Month(quarter*3+1), 1, 0, 0, 0, 0, timezone)
+		return NewDateRange(start, start.AddDate(0, 3, 0).Add(-time.Nanosecond))
 	case PeriodTypeYear:
-		start := time.Date(reference.Year(), 1, 1, 0, 0, 0, 0, reference.Location())
-		end := start.AddDate(1, 0, 0).Add(-time.Nanosecond)
-		return NewDateRange(start, end)
+		start = time.Date(reference.Year(), 1, 1, 0, 0, 0, 0, timezone)
+		return NewDateRange(start, start.AddDate(1, 0, 0).Add(-time.Nanosecond))
 	default:
 		return nil
 	}
 }
+
+type BulkAction string
+
+const (
+	BulkActionDelete       BulkAction = "delete"
+	BulkActionRecategorize BulkAction = "recategorize"
+	BulkActionRetag        BulkAction = "retag"
+	BulkActionShiftDate    BulkAction = "shift_date"
+)
+
+// BulkResult reports the outcome of a bulk action on several transactions
+type BulkResult struct {
+	Action   BulkAction `json:"action"`
+	Affected int        `json:"affected"`
+}
+
+// Summary describes the result for the status line, e.g. "Deleted 5 transactions"
+func (r *BulkResult) Summary() string {
+	noun := "transactions"
+	if r.Affected == 1 {
+		noun = "transaction"
+	}
+
+	switch r.Action {
+	case BulkActionDelete:
+		return fmt.Sprintf("Moved %d %s to the trash", r.Affected, noun)
+	case BulkActionRecategorize:
+		return fmt.Sprintf("Re-categorized %d %s", r.Affected, noun)
+	case BulkActionRetag:
+		return fmt.Sprintf("Retagged %d %s", r.Affected, noun)
+	case BulkActionShiftDate:
+		return fmt.Sprintf("Shifted the date of %d %s", r.Affected, noun)
+	default:
+		return fmt.Sprintf("Updated %d %s", r.Affected, noun)
+	}
+}
+
+// ShiftPeriod moves reference by steps whole periods; negative steps go back in time.
+// Month, quarter and year shifts start from the first day of the period so that
+// e.g. stepping forward from January 31st lands in February.
+func ShiftPeriod(periodType PeriodType, reference time.Time, steps int) time.Time {
+	switch periodType {
+	case PeriodTypeWeek:
+		return reference.AddDate(0, 0, 7*steps)
+	case PeriodTypeMonth:
+		start := GetPeriodRange(PeriodTypeMonth, reference).Start
+		return start.AddDate(0, steps, 0)
+	case PeriodTypeQuarter:
+		start := GetPeriodRange(PeriodTypeQuarter, reference).Start
+		return start.AddDate(0, 3*steps, 0)
+	case PeriodTypeYear:
+		start := GetPeriodRange(PeriodTypeYear, reference).Start
+		return start.AddDate(steps, 0, 0)
+	default:
+		return reference
+	}
+}
+
+// ShiftDateRange moves a custom date range by steps times its own length in calendar days,
+// so that it still starts and ends at midnight when the shift crosses a change of clocks
+func ShiftDateRange(dateRange *DateRange, steps int) *DateRange {
+	days := steps * (daysApart(dateRange.End, dateRange.Start) + 1)
+	start := StartOfDay(dateRange.Start).AddDate(0, 0, days)
+	end := StartOfDay(dateRange.End).AddDate(0, 0, days+1).Add(-time.Nanosecond)
+	return NewDateRange(start, end)
+}
+
+// PeriodLabel returns a short human readable name for a period, e.g. "October 2026" or "Q4 2026"
+func PeriodLabel(periodType PeriodType, dateRange *DateRange, locale *Locale) string {
+	if dateRange == nil {
+		return ""
+	}
+	start := dateRange.Start
+	switch periodType {
+	case PeriodTypeWeek:
+		_, week := start.ISOWeek()
+		return fmt.Sprintf("Week %d, %s – %s", week, locale.FormatShortDate(start), locale.FormatDate(dateRange.End))
+	case PeriodTypeMonth:
+		return fmt.Sprintf("%s %d", start.Month().String(), start.Year())
+	case PeriodTypeQuarter:
+		return fmt.Sprintf("Q%d %d", (int(start.Month())-1)/3+1, start.Year())
+	case PeriodTypeYear:
+		return fmt.Sprintf("%d", start.Year())
+	default:
+		return fmt.Sprintf("%s – %s", locale.FormatDate(start), locale.FormatDate(dateRange.End))
+	}
+}
+
+// ParseDateRange parses a custom range typed as "2026-01-01..2026-03-31" (also accepting
+// "to", "-" surrounded by spaces, or a plain space between the dates). Dates may use the
+// locale's input format. Both days are inclusive.
+func ParseDateRange(input string, locale *Locale) (*DateRange, error) {
+	input = strings.TrimSpace(input)
+	var parts []string
+	for _, separator := range []string{"..", " to ", " - "} {
+		if strings.Contains(input, separator) {
+			parts = strings.SplitN(input, separator, 2)
+			break
+		}
+	}
+	if parts == nil {
+		parts = strings.Fields(input)
+	}
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("enter a range as %s..%s", locale.InputDateFormat, locale.InputDateFormat)
+	}
+
+	start, err := locale.ParseDate(parts[0], timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid start date (use %s)", locale.InputDateFormat)
+	}
+	end, err := locale.ParseDate(parts[1], timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid end date (use %s)", locale.InputDateFormat)
+	}
+
+	dateRange := NewDateRange(start, end.AddDate(0, 0, 1).Add(-time.Nanosecond))
+	if err := dateRange.Validate(); err != nil {
+		return nil, err
+	}
+	return dateRange, nil
+}
+
+// DescriptionSuggestion is a description used before, with what to prefill when it is picked again
+type DescriptionSuggestion struct {
+	Description string    `json:"description"`
+	UseCount    int       `json:"use_count"`
+	LastUsed    time.Time `json:"last_used"`
+	LastAmount  float64   `json:"last_amount"`
+	Category    *Category `json:"category,omitempty"` // the category used most often with it
+}
+
+// suggestionHalfLife is the age at which a past use counts half as much as one from today
+const suggestionHalfLife = 90 * 24 * time.Hour
+
+// Score weighs how often a description was used by how recently: each halving of
+// the weight takes suggestionHalfLife, so a weekly habit beats a one-off from last year
+func (s *DescriptionSuggestion) Score(now time.Time) float64 {
+	age := max(now.Sub(s.LastUsed), 0)
+	return float64(s.UseCount) * math.Pow(0.5, float64(age)/float64(suggestionHalfLife))
+}
+
+// RankSuggestions orders suggestions by score, most recently used first on ties
+func RankSuggestions(suggestions []*DescriptionSuggestion, now time.Time) {
+	sort.SliceStable(suggestions, func(i, j int) bool {
+		si, sj := suggestions[i].Score(now), suggestions[j].Score(now)
+		if si != sj {
+			return si > sj
+		}
+		return suggestions[i].LastUsed.After(suggestions[j].LastUsed)
+	})
+}
@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"math"
 	"testing"
 	"time"
 
@@ -13,8 +14,12 @@ type EntityTestSuite struct {
 }
 
 func (suite *EntityTestSuite) SetupTest() {
-	// SetupTest runs before each test method
-	// Currently no setup is needed for entity tests
+	// Periods are taken in UTC unless a test sets another timezone
+	UseTimezone(time.UTC)
+}
+
+func (suite *EntityTestSuite) TearDownTest() {
+	UseTimezone(nil)
 }
 
 func TestEntitySuite(t *testing.T) {
@@ -312,3 +317,210 @@ func (suite *EntityTestSuite) TestCategoryEdgeCases() {
 		})
 	}
 }
+
+func (suite *EntityTestSuite) TestPageRequestValidation() {
+	assert := assert.New(suite.T())
+
+	cursor := &PageCursor{Date: time.Now(), ID: 1}
+
+	testCases := []struct {
+		name        string
+		request     PageRequest
+		expectError bool
+		errorMsg    string
+	}{
+		{"first page", PageRequest{Limit: 20}, false, ""},
+		{"after cursor", PageRequest{After: cursor, Limit: 20}, false, ""},
+		{"before cursor", PageRequest{Before: cursor, Limit: 20}, false, ""},
+		{"from end", PageRequest{FromEnd: true, Limit: 5}, false, ""},
+		{"zero limit", PageRequest{Limit: 0}, true, "page limit must be positive"},
+		{"both cursors", PageRequest{After: cursor, Before: cursor, Limit: 20}, true, "both an after and a before cursor"},
+		{"cursor from end", PageRequest{After: cursor, FromEnd: true, Limit: 20}, true, "cannot combine a cursor with from end"},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.request.Validate()
+			if tc.expectError {
+				assert.Error(err)
+				assert.Contains(err.Error(), tc.errorMsg)
+			} else {
+				assert.NoError(err)
+			}
+		})
+	}
+}
+
+func (suite *EntityTestSuite) TestPageCounts() {
+	assert := assert.New(suite.T())
+
+	testCases := []struct {
+		name             string
+		total            int
+		pageSize         int
+		expectedPages    int
+		expectedLastSize int
+	}{
+		{"empty", 0, 20, 0, 0},
+		{"single partial page", 7, 20, 1, 7},
+		{"exact pages", 40, 20, 2, 20},
+		{"partial last page", 331, 20, 17, 11},
+		{"invalid page size", 10, 0, 0, 0},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			assert.Equal(tc.expectedPages, PageCount(tc.total, tc.pageSize))
+			assert.Equal(tc.expectedLastSize, LastPageSize(tc.total, tc.pageSize))
+		})
+	}
+}
+
+func (suite *EntityTestSuite) TestNormalizeTags() {
+	assert := assert.New(suite.T())
+
+	assert.Equal([]string{"groceries", "shared"}, NormalizeTags([]string{" Shared", "groceries", "", "SHARED"}))
+	assert.Equal([]string{"a", "b"}, ParseTags("b, a,,b "))
+	assert.Nil(ParseTags(" "))
+
+	assert.NoError(ValidateTags([]string{"travel"}))
+	assert.Error(ValidateTags([]string{"a,b"}))
+	assert.Error(ValidateTags([]string{"1234567890123456789012345678901"}))
+}
+
+func (suite *EntityTestSuite) TestBulkResultSummary() {
+	assert := assert.New(suite.T())
+
+	assert.Equal("Moved 5 transactions to the trash", (&BulkResult{Action: BulkActionDelete, Affected: 5}).Summary())
+	assert.Equal("Re-categorized 1 transaction", (&BulkResult{Action: BulkActionRecategorize, Affected: 1}).Summary())
+	assert.Equal("Retagged 2 transactions", (&BulkResult{Action: BulkActionRetag, Affected: 2}).Summary())
+	assert.Equal("Shifted the date of 3 transactions", (&BulkResult{Action: BulkActionShiftDate, Affected: 3}).Summary())
+}
+
+func (suite *EntityTestSuite) TestCollapseBreakdown() {
+	assert := assert.New(suite.T())
+
+	breakdowns := []*CategoryBreakdown{
+		{Category: &Category{ID: 1, Name: "Food"}, TotalAmount: 400, TransactionCount: 8, Percentage: 40},
+		{Category: &Category{ID: 2, Name: "Other"}, TotalAmount: 50, TransactionCount: 1, Percentage: 5},
+		{Category: &Category{ID: 3, Name: "Transport"}, TotalAmount: 300, TransactionCount: 6, Percentage: 30},
+		{Category: &Category{ID: 4, Name: "Bills"}, TotalAmount: 150, TransactionCount: 2, Percentage: 15},
+		{Category: &Category{ID: 5, Name: "Healthcare"}, TotalAmount: 100, TransactionCount: 3, Percentage: 10},
+	}
+
+	collapsed := CollapseBreakdown(breakdowns, 2)
+	suite.Require().Len(collapsed, 3)
+	assert.Equal("Food", collapsed[0].Category.Name)
+	assert.Equal("Transport", collapsed[1].Category.Name)
+	assert.Equal(OtherCategoryName, collapsed[2].Category.Name)
+	assert.Equal(0, collapsed[2].Category.ID)
+	assert.Equal(300.0, collapsed[2].TotalAmount)
+	assert.Equal(6, collapsed[2].TransactionCount)
+	assert.Equal(30.0, collapsed[2].Percentage)
+
+	// Inputs are left untouched and nothing is folded when everything fits
+	collapsed = CollapseBreakdown(breakdowns[2:], 5)
+	suite.Require().Len(collapsed, 3)
+	assert.Equal("Transport", collapsed[0].Category.Name)
+	assert.Equal(300.0, breakdowns[2].TotalAmount)
+
+	assert.Empty(CollapseBreakdown(nil, 5))
+}
+
+func (suite *EntityTestSuite) TestPeriodTypeNext() {
+	assert := assert.New(suite.T())
+
+	assert.Equal(PeriodTypeMonth, PeriodTypeWeek.Next())
+	assert.Equal(PeriodTypeQuarter, PeriodTypeMonth.Next())
+	assert.Equal(PeriodTypeYear, PeriodTypeQuarter.Next())
+	assert.Equal(PeriodTypeWeek, PeriodTypeYear.Next())
+	assert.Equal(PeriodTypeWeek, PeriodTypeCustom.Next())
+}
+
+func (suite *EntityTestSuite) TestShiftPeriod() {
+	assert := assert.New(suite.T())
+
+	reference := time.Date(2026, 1, 31, 15, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name       string
+		periodType PeriodType
+		steps      int
+		expected   time.Time
+	}{
+		{"next week", PeriodTypeWeek, 1, time.Date(2026, 2, 7, 15, 0, 0, 0, time.UTC)},
+		{"previous week", PeriodTypeWeek, -1, time.Date(2026, 1, 24, 15, 0, 0, 0, time.UTC)},
+		{"next month from month end", PeriodTypeMonth, 1, time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)},
+		{"previous month across year", PeriodTypeMonth, -1, time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC)},
+		{"previous quarter", PeriodTypeQuarter, -1, time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC)},
+		{"next year", PeriodTypeYear, 1, time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"custom is unchanged", PeriodTypeCustom, 3, reference},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			assert.True(tc.expected.Equal(ShiftPeriod(tc.periodType, reference, tc.steps)), "got %s", ShiftPeriod(tc.periodType, reference, tc.steps))
+		})
+	}
+}
+
+func (suite *EntityTestSuite) TestPeriodLabel() {
+	assert := assert.New(suite.T())
+	locale := DefaultLocale()
+
+	assert.Equal("October 2026", PeriodLabel(PeriodTypeMonth, GetMonthRange(2026, time.October), locale))
+	assert.Equal("Q4 2026", PeriodLabel(PeriodTypeQuarter, GetQuarterRange(2026, 4), locale))
+	assert.Equal("2026", PeriodLabel(PeriodTypeYear, GetYearRange(2026), locale))
+	assert.Equal("Week 42, Oct 12 – Oct 18, 2026", PeriodLabel(PeriodTypeWeek, NewDateRange(
+		time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC),
+		time.Date(2026, 10, 18, 23, 59, 59, 0, time.UTC),
+	), locale))
+	assert.Equal("Oct 01, 2026 – Oct 15, 2026", PeriodLabel(PeriodTypeCustom, NewDateRange(
+		time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2026, 10, 15, 0, 0, 0, 0, time.UTC),
+	), locale))
+	assert.Equal("", PeriodLabel(PeriodTypeMonth, nil, locale))
+}
+
+func (suite *EntityTestSuite) TestParseDateRange() {
+	assert := assert.New(suite.T())
+	locale := DefaultLocale()
+
+	for _, input := range []string{"2026-01-01..2026-03-31", "2026-01-01 to 2026-03-31", "2026-01-01 - 2026-03-31", " 2026-01-01 2026-03-31 "} {
+		dateRange, err := ParseDateRange(input, locale)
+		suite.Require().NoError(err, input)
+		assert.Equal(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), dateRange.Start)
+		assert.Equal(time.Date(2026, 3, 31, 23, 59, 59, 999999999, time.UTC), dateRange.End)
+	}
+
+	_, err := ParseDateRange("2026-01-01", locale)
+	assert.Error(err)
+	_, err = ParseDateRange("2026-13-01..2026-12-31", locale)
+	assert.Error(err)
+	_, err = ParseDateRange("2026-03-01..2026-01-01", locale)
+	assert.Error(err)
+	assert.Contains(err.Error(), "start date cannot be after end date")
+
+	german, err := NewLocale("de-DE", nil)
+	suite.Require().NoError(err)
+	dateRange, err := ParseDateRange("01.01.2026..31.03.2026", german)
+	suite.Require().NoError(err)
+	assert.Equal(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), dateRange.Start)
+}
+
+func (suite *EntityTestSuite) TestRankSuggestions() {
+	assert := assert.New(suite.T())
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+
+	weekly := &DescriptionSuggestion{Description: "Lidl", UseCount: 20, LastUsed: now.AddDate(0, 0, -3)}
+	oneOff := &DescriptionSuggestion{Description: "Lisbon trip", UseCount: 1, LastUsed: now.AddDate(0, 0, -1)}
+	oldHabit := &DescriptionSuggestion{Description: "Library fee", UseCount: 30, LastUsed: now.AddDate(-2, 0, 0)}
+	usedToday := &DescriptionSuggestion{Description: "Lime scooter", UseCount: 1, LastUsed: now}
+
+	suggestions := []*DescriptionSuggestion{oldHabit, oneOff, weekly, usedToday}
+	RankSuggestions(suggestions, now)
+
+	assert.Equal([]*DescriptionSuggestion{weekly, usedToday, oneOff, oldHabit}, suggestions)
+	assert.InDelta(20*math.Pow(0.5, 3.0/90), weekly.Score(now), 0.0001)
+	assert.Equal(1.0, usedToday.Score(now.Add(-time.Hour)), "future uses count fully")
+}
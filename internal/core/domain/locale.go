@@ -0,0 +1,354 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// NegativeStyle is how a locale writes negative amounts
+type NegativeStyle string
+
+const (
+	NegativeMinus       NegativeStyle = "minus"       // -$1,234.56
+	NegativeParentheses NegativeStyle = "parentheses" // ($1,234.56)
+)
+
+// ISODateFormat is always accepted as date input, whatever the locale
+const ISODateFormat = "YYYY-MM-DD"
+
+// Locale describes how numbers, amounts and dates are written and read.
+// Date formats use the tokens YYYY, YY, MMM (Jan), MM, DD and D.
+type Locale struct {
+	Name               string
+	DecimalSeparator   string
+	ThousandsSeparator string
+	CurrencySymbol     string
+	SymbolAfter        bool // 1.234,56 € instead of €1.234,56
+	SymbolSpace        bool // a space between amount and symbol
+	NegativeStyle      NegativeStyle
+	DateFormat         string // full dates, e.g. in the transaction list
+	ShortDateFormat    string // dates within the current year
+	InputDateFormat    string // what the user types in forms
+}
+
+// builtinLocales are the locales selectable by name
+var builtinLocales = map[string]Locale{
+	"en-US": {
+		Name: "en-US", DecimalSeparator: ".", ThousandsSeparator: ",", CurrencySymbol: "$",
+		NegativeStyle: NegativeMinus, DateFormat: "MMM DD, YYYY", ShortDateFormat: "MMM DD", InputDateFormat: ISODateFormat,
+	},
+	"en-GB": {
+		Name: "en-GB", DecimalSeparator: ".", ThousandsSeparator: ",", CurrencySymbol: "£",
+		NegativeStyle: NegativeMinus, DateFormat: "DD MMM YYYY", ShortDateFormat: "DD MMM", InputDateFormat: "DD/MM/YYYY",
+	},
+	"de-DE": {
+		Name: "de-DE", DecimalSeparator: ",", ThousandsSeparator: ".", CurrencySymbol: "€", SymbolAfter: true, SymbolSpace: true,
+		NegativeStyle: NegativeMinus, DateFormat: "DD.MM.YYYY", ShortDateFormat: "DD.MM.", InputDateFormat: "DD.MM.YYYY",
+	},
+	"fr-FR": {
+		Name: "fr-FR", DecimalSeparator: ",", ThousandsSeparator: " ", CurrencySymbol: "€", SymbolAfter: true, SymbolSpace: true,
+		NegativeStyle: NegativeMinus, DateFormat: "DD/MM/YYYY", ShortDateFormat: "DD/MM", InputDateFormat: "DD/MM/YYYY",
+	},
+}
+
+// DefaultLocale returns the en-US locale the application used before locales existed
+func DefaultLocale() *Locale {
+	locale := builtinLocales["en-US"]
+	return &locale
+}
+
+// LocaleNames returns the names of the built-in locales, sorted
+func LocaleNames() []string {
+	names := make([]string, 0, len(builtinLocales))
+	for name := range builtinLocales {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// NewLocale returns the named built-in locale (en-US when name is empty) with overrides
+// applied. Override keys are decimal_separator, thousands_separator, currency_symbol,
+// symbol_position (before/after), negative_style, date_format, short_date_format and
+// input_date_format.
+func NewLocale(name string, overrides map[string]string) (*Locale, error) {
+	if name == "" {
+		name = "en-US"
+	}
+	base, ok := builtinLocales[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown locale %q (available: %s)", name, strings.Join(LocaleNames(), ", "))
+	}
+	locale := &base
+
+	keys := make([]string, 0, len(overrides))
+	for key := range overrides {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		value := overrides[key]
+		switch key {
+		case "decimal_separator":
+			locale.DecimalSeparator = value
+		case "thousands_separator":
+			locale.ThousandsSeparator = value
+		case "currency_symbol":
+			locale.CurrencySymbol = value
+		case "symbol_position":
+			switch value {
+			case "before":
+				locale.SymbolAfter = false
+			case "after":
+				locale.SymbolAfter = true
+			default:
+				return nil, fmt.Errorf("symbol_position must be before or after, got %q", value)
+			}
+		case "negative_style":
+			locale.NegativeStyle = NegativeStyle(value)
+		case "date_format":
+			locale.DateFormat = value
+		case "short_date_format":
+			locale.ShortDateFormat = value
+		case "input_date_format":
+			locale.InputDateFormat = value
+		default:
+			return nil, fmt.Errorf("unknown format setting %q", key)
+		}
+	}
+
+	if err := locale.Validate(); err != nil {
+		return nil, err
+	}
+	return locale, nil
+}
+
+// Validate checks that numbers and dates written with the locale can be read back
+func (l *Locale) Validate() error {
+	if len([]rune(l.DecimalSeparator)) != 1 {
+		return fmt.Errorf("decimal separator must be a single character")
+	}
+	if len([]rune(l.ThousandsSeparator)) > 1 {
+		return fmt.Errorf("thousands separator must be a single character or empty")
+	}
+	if l.DecimalSeparator == l.ThousandsSeparator {
+		return fmt.Errorf("decimal and thousands separators must differ")
+	}
+	if strings.ContainsAny(l.DecimalSeparator+l.ThousandsSeparator, "0123456789+-*/()") {
+		return fmt.Errorf("separators cannot be digits, operators or parentheses")
+	}
+	if l.NegativeStyle != NegativeMinus && l.NegativeStyle != NegativeParentheses {
+		return fmt.Errorf("negative style must be %q or %q", NegativeMinus, NegativeParentheses)
+	}
+	for _, format := range []string{l.DateFormat, l.ShortDateFormat} {
+		if strings.TrimSpace(format) == "" {
+			return fmt.Errorf("date formats cannot be empty")
+		}
+	}
+	if err := validateInputDateFormat(l.InputDateFormat); err != nil {
+		return err
+	}
+	return nil
+}
+
+// validateInputDateFormat makes sure a typed date identifies a single day
+func validateInputDateFormat(format string) error {
+	layout := dateLayout(format)
+	if !strings.Contains(layout, "2006") || !strings.Contains(layout, "02") ||
+		!(strings.Contains(layout, "01") || strings.Contains(layout, "Jan")) {
+		return fmt.Errorf("input date format %q needs YYYY, MM (or MMM) and DD", format)
+	}
+	return nil
+}
+
+// dateTokens maps locale date tokens to Go layout elements, longest first
+var dateTokens = []struct{ token, layout string }{
+	{"YYYY", "2006"},
+	{"YY", "06"},
+	{"MMM", "Jan"},
+	{"MM", "01"},
+	{"DD", "02"},
+	{"D", "2"},
+}
+
+// dateLayout converts a locale date format such as "DD.MM.YYYY" to a Go time layout
+func dateLayout(format string) string {
+	var b strings.Builder
+	for i := 0; i < len(format); {
+		matched := false
+		for _, t := range dateTokens {
+			if strings.HasPrefix(format[i:], t.token) {
+				b.WriteString(t.layout)
+				i += len(t.token)
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			b.WriteByte(format[i])
+			i++
+		}
+	}
+	return b.String()
+}
+
+// FormatNumber writes value with the locale's separators and the given number of decimals
+func (l *Locale) FormatNumber(value float64, decimals int) string {
+	negative := value < 0
+	text := strconv.FormatFloat(math.Abs(value), 'f', decimals, 64)
+
+	integer, fraction, _ := strings.Cut(text, ".")
+	if l.ThousandsSeparator != "" && len(integer) > 3 {
+		var b strings.Builder
+		lead := len(integer) % 3
+		if lead > 0 {
+			b.WriteString(integer[:lead])
+		}
+		for i := lead; i < len(integer); i += 3 {
+			if b.Len() > 0 {
+				b.WriteString(l.ThousandsSeparator)
+			}
+			b.WriteString(integer[i : i+3])
+		}
+		integer = b.String()
+	}
+
+	result := integer
+	if fraction != "" {
+		result += l.DecimalSeparator + fraction
+	}
+	if negative && strings.Trim(text, "0.") != "" {
+		result = "-" + result
+	}
+	return result
+}
+
+// FormatAmount writes a currency amount, e.g. "$1,234.56", "1.234,56 €" or "($5.00)"
+func (l *Locale) FormatAmount(amount float64) string {
+	amount = math.Round(amount*100) / 100
+	text := l.withSymbol(l.FormatNumber(math.Abs(amount), 2))
+	if amount < 0 {
+		if l.NegativeStyle == NegativeParentheses {
+			return "(" + text + ")"
+		}
+		return "-" + text
+	}
+	return text
+}
+
+// FormatSignedAmount writes an amount with an explicit sign, "+$5.00" for positive amounts
+func (l *Locale) FormatSignedAmount(amount float64) string {
+	if math.Round(amount*100) > 0 {
+		return "+" + l.FormatAmount(amount)
+	}
+	return l.FormatAmount(amount)
+}
+
+func (l *Locale) withSymbol(number string) string {
+	space := ""
+	if l.SymbolSpace {
+		space = " "
+	}
+	if l.SymbolAfter {
+		return number + space + l.CurrencySymbol
+	}
+	return l.CurrencySymbol + space + number
+}
+
+// digitsOnly matches a plain decimal number after separators have been normalized
+var digitsOnly = regexp.MustCompile(`^\d+(\.\d+)?$`)
+
+// ParseAmount reads an amount typed by the user. It accepts the locale's separators and
+// currency symbol, a leading sign or parentheses, and always a plain "12.50" as well.
+func (l *Locale) ParseAmount(input string) (float64, error) {
+	invalid := fmt.Errorf("invalid amount %q", strings.TrimSpace(input))
+
+	text := l.stripSpaces(strings.ReplaceAll(input, l.CurrencySymbol, ""))
+	negative := false
+	switch {
+	case strings.HasPrefix(text, "(") && strings.HasSuffix(text, ")"):
+		negative = true
+		text = strings.TrimSpace(text[1 : len(text)-1])
+	case strings.HasPrefix(text, "-"):
+		negative = true
+		text = strings.TrimSpace(text[1:])
+	case strings.HasPrefix(text, "+"):
+		text = strings.TrimSpace(text[1:])
+	}
+
+	number, ok := l.canonicalNumber(text)
+	if !ok {
+		return 0, invalid
+	}
+	value, err := strconv.ParseFloat(number, 64)
+	if err != nil {
+		return 0, invalid
+	}
+	if negative {
+		value = -value
+	}
+	return value, nil
+}
+
+// stripSpaces treats non-breaking spaces like spaces and drops the spaces that cannot
+// group thousands in this locale
+func (l *Locale) stripSpaces(text string) string {
+	text = normalizeSpaces(text)
+	if normalizeSpaces(l.ThousandsSeparator) == " " {
+		return strings.TrimSpace(text)
+	}
+	return strings.ReplaceAll(text, " ", "")
+}
+
+// canonicalNumber rewrites an unsigned number written with the locale's separators as
+// "1234.56"; ok is false when text is not a number
+func (l *Locale) canonicalNumber(text string) (string, bool) {
+	thousands := normalizeSpaces(l.ThousandsSeparator)
+	if thousands != "" {
+		grouped := regexp.MustCompile(`^\d{1,3}(` + regexp.QuoteMeta(thousands) + `\d{3})+(` + regexp.QuoteMeta(l.DecimalSeparator) + `\d+)?$`)
+		if grouped.MatchString(text) {
+			text = strings.ReplaceAll(text, thousands, "")
+		}
+	}
+	if l.DecimalSeparator != "." && strings.Count(text, l.DecimalSeparator) == 1 {
+		text = strings.Replace(text, l.DecimalSeparator, ".", 1)
+	}
+	return text, digitsOnly.MatchString(text)
+}
+
+// normalizeSpaces replaces non-breaking and narrow spaces with plain spaces
+func normalizeSpaces(text string) string {
+	return strings.NewReplacer("\u00a0", " ", "\u202f", " ").Replace(text)
+}
+
+// FormatDate writes a full date
+func (l *Locale) FormatDate(date time.Time) string {
+	return date.Format(dateLayout(l.DateFormat))
+}
+
+// FormatShortDate writes a date without the year
+func (l *Locale) FormatShortDate(date time.Time) string {
+	return date.Format(dateLayout(l.ShortDateFormat))
+}
+
+// FormatInputDate writes a date the way ParseDate reads it back
+func (l *Locale) FormatInputDate(date time.Time) string {
+	return date.Format(dateLayout(l.InputDateFormat))
+}
+
+// ParseDate reads a date typed by the user in the locale's input format or as YYYY-MM-DD
+func (l *Locale) ParseDate(input string, location *time.Location) (time.Time, error) {
+	input = strings.TrimSpace(input)
+	for _, format := range []string{l.InputDateFormat, ISODateFormat} {
+		if date, err := time.ParseInLocation(dateLayout(format), input, location); err == nil {
+			return date, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid date %q (use %s)", input, l.InputDateFormat)
+}
@@ -0,0 +1,147 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type LocaleTestSuite struct {
+	suite.Suite
+}
+
+func TestLocaleSuite(t *testing.T) {
+	suite.Run(t, new(LocaleTestSuite))
+}
+
+func (suite *LocaleTestSuite) locale(name string, overrides map[string]string) *Locale {
+	locale, err := NewLocale(name, overrides)
+	suite.Require().NoError(err)
+	return locale
+}
+
+func (suite *LocaleTestSuite) TestFormatAmount() {
+	testCases := []struct {
+		name     string
+		locale   *Locale
+		amount   float64
+		expected string
+		signed   string
+	}{
+		{"en-US", suite.locale("en-US", nil), 1234567.891, "$1,234,567.89", "+$1,234,567.89"},
+		{"en-US small", suite.locale("", nil), 5, "$5.00", "+$5.00"},
+		{"en-US negative", suite.locale("en-US", nil), -42.5, "-$42.50", "-$42.50"},
+		{"de-DE", suite.locale("de-DE", nil), 1234.5, "1.234,50 €", "+1.234,50 €"},
+		{"fr-FR", suite.locale("fr-FR", nil), -1234.5, "-1 234,50 €", "-1 234,50 €"},
+		{"parentheses", suite.locale("en-US", map[string]string{"negative_style": "parentheses"}), -5, "($5.00)", "($5.00)"},
+		{"zero", suite.locale("en-US", nil), 0, "$0.00", "$0.00"},
+		{"rounds to zero", suite.locale("en-US", nil), -0.001, "$0.00", "$0.00"},
+		{"custom symbol after", suite.locale("en-US", map[string]string{"currency_symbol": "CHF", "symbol_position": "after"}), 10, "10.00CHF", "+10.00CHF"},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			assert.Equal(suite.T(), tc.expected, tc.locale.FormatAmount(tc.amount))
+			assert.Equal(suite.T(), tc.signed, tc.locale.FormatSignedAmount(tc.amount))
+		})
+	}
+}
+
+func (suite *LocaleTestSuite) TestFormatNumber() {
+	assert := assert.New(suite.T())
+
+	assert.Equal("12.5", suite.locale("en-US", nil).FormatNumber(12.5, 1))
+	assert.Equal("-1.000", suite.locale("de-DE", nil).FormatNumber(-1000, 0))
+	assert.Equal("999,9", suite.locale("de-DE", nil).FormatNumber(999.94, 1))
+	assert.Equal("1234.00", suite.locale("en-US", map[string]string{"thousands_separator": ""}).FormatNumber(1234, 2))
+}
+
+func (suite *LocaleTestSuite) TestParseAmount() {
+	testCases := []struct {
+		locale   string
+		input    string
+		expected float64
+	}{
+		{"en-US", "12.50", 12.5},
+		{"en-US", "$1,234.56", 1234.56},
+		{"en-US", " 1234 ", 1234},
+		{"en-US", "-5", -5},
+		{"en-US", "($5.00)", -5},
+		{"de-DE", "12,50", 12.5},
+		{"de-DE", "1.234,56 €", 1234.56},
+		{"de-DE", "1.234", 1234},
+		{"de-DE", "12.50", 12.5},
+		{"fr-FR", "1 234,56", 1234.56},
+		{"fr-FR", "1 234,56 €", 1234.56},
+		{"fr-FR", "0,99", 0.99},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.locale+" "+tc.input, func() {
+			value, err := suite.locale(tc.locale, nil).ParseAmount(tc.input)
+			suite.Require().NoError(err)
+			assert.InDelta(suite.T(), tc.expected, value, 0.0001)
+		})
+	}
+
+	for _, input := range []string{"", "abc", "1,2,3", "12.5.1", "inf", "NaN", "1e5", "--5"} {
+		_, err := suite.locale("en-US", nil).ParseAmount(input)
+		assert.Error(suite.T(), err, input)
+	}
+}
+
+func (suite *LocaleTestSuite) TestDates() {
+	assert := assert.New(suite.T())
+	date := time.Date(2026, 3, 7, 0, 0, 0, 0, time.UTC)
+
+	us := suite.locale("en-US", nil)
+	assert.Equal("Mar 07, 2026", us.FormatDate(date))
+	assert.Equal("Mar 07", us.FormatShortDate(date))
+
+	german := suite.locale("de-DE", nil)
+	assert.Equal("07.03.2026", german.FormatDate(date))
+	assert.Equal("07.03.", german.FormatShortDate(date))
+
+	assert.Equal("07.03.2026", german.FormatInputDate(date))
+	assert.Equal("2026-03-07", us.FormatInputDate(date))
+
+	parsed, err := german.ParseDate("07.03.2026", time.UTC)
+	suite.Require().NoError(err)
+	assert.Equal(date, parsed)
+
+	parsed, err = german.ParseDate("2026-03-07", time.UTC)
+	suite.Require().NoError(err, "ISO dates are always accepted")
+	assert.Equal(date, parsed)
+
+	_, err = german.ParseDate("03/07/2026", time.UTC)
+	assert.Error(err)
+	assert.Contains(err.Error(), "DD.MM.YYYY")
+}
+
+func (suite *LocaleTestSuite) TestNewLocale_Errors() {
+	testCases := []struct {
+		name      string
+		locale    string
+		overrides map[string]string
+		message   string
+	}{
+		{"unknown locale", "xx-XX", nil, "unknown locale"},
+		{"unknown setting", "en-US", map[string]string{"currency": "€"}, "unknown format setting"},
+		{"same separators", "en-US", map[string]string{"thousands_separator": "."}, "must differ"},
+		{"long separator", "en-US", map[string]string{"decimal_separator": ".."}, "single character"},
+		{"digit separator", "en-US", map[string]string{"thousands_separator": "1"}, "cannot be digits"},
+		{"negative style", "en-US", map[string]string{"negative_style": "red"}, "negative style"},
+		{"symbol position", "en-US", map[string]string{"symbol_position": "left"}, "before or after"},
+		{"input date without day", "en-US", map[string]string{"input_date_format": "MM/YYYY"}, "needs YYYY"},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := NewLocale(tc.locale, tc.overrides)
+			suite.Require().Error(err)
+			assert.Contains(suite.T(), err.Error(), tc.message)
+		})
+	}
+}
@@ -0,0 +1,165 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+)
+
+// Payee is the merchant or person on the other side of a transaction. Aliases are
+// patterns for the raw descriptions banks use for it, such as "amzn mktp*" or
+// "amazon eu"; * stands for any text.
+type Payee struct {
+	ID      int      `json:"id"`
+	Name    string   `json:"name"`
+	Aliases []string `json:"aliases,omitempty"`
+}
+
+func (p *Payee) Validate() error {
+	if strings.TrimSpace(p.Name) == "" {
+		return fmt.Errorf("payee name cannot be empty")
+	}
+	if len(p.Name) > 100 {
+		return fmt.Errorf("payee name cannot exceed 100 characters")
+	}
+	for _, alias := range p.Aliases {
+		if strings.Trim(alias, "* ") == "" {
+			return fmt.Errorf("payee alias %q must contain more than wildcards", alias)
+		}
+	}
+	return nil
+}
+
+// NormalizeAliases lowercases aliases, collapses their spaces and drops empty ones and duplicates
+func NormalizeAliases(aliases []string) []string {
+	seen := make(map[string]bool, len(aliases))
+	var normalized []string
+	for _, alias := range aliases {
+		alias = NormalizeDescription(alias)
+		if alias == "" || seen[alias] {
+			continue
+		}
+		seen[alias] = true
+		normalized = append(normalized, alias)
+	}
+	return normalized
+}
+
+// NormalizeDescription is the form of a description that payee aliases are matched against:
+// lower case with single spaces
+func NormalizeDescription(description string) string {
+	return strings.Join(strings.Fields(strings.ToLower(description)), " ")
+}
+
+type PayeeBreakdown struct {
+	Payee            *Payee  `json:"payee,omitempty"` // nil for transactions without a payee
+	TotalAmount      float64 `json:"total_amount"`
+	TransactionCount int     `json:"transaction_count"`
+	Percentage       float64 `json:"percentage"`
+}
+
+// PayeeMatcher maps raw descriptions to payees using their names and alias patterns
+type PayeeMatcher struct {
+	patterns []payeePattern
+}
+
+type payeePattern struct {
+	payee    *Payee
+	pattern  *regexp.Regexp
+	literals int // characters other than wildcards; more means more specific
+}
+
+// NewPayeeMatcher compiles the names and aliases of payees for matching
+func NewPayeeMatcher(payees []*Payee) *PayeeMatcher {
+	matcher := &PayeeMatcher{}
+	for _, payee := range payees {
+		for _, alias := range NormalizeAliases(append([]string{payee.Name}, payee.Aliases...)) {
+			quoted := strings.ReplaceAll(regexp.QuoteMeta(alias), `\*`, `.*`)
+			matcher.patterns = append(matcher.patterns, payeePattern{
+				payee:    payee,
+				pattern:  regexp.MustCompile("^" + quoted + "$"),
+				literals: len(strings.ReplaceAll(alias, "*", "")),
+			})
+		}
+	}
+	return matcher
+}
+
+// Match returns the payee whose name or alias matches description, preferring the most
+// specific pattern, or nil when none does
+func (m *PayeeMatcher) Match(description string) *Payee {
+	normalized := NormalizeDescription(description)
+	var best *payeePattern
+	for i, candidate := range m.patterns {
+		if candidate.pattern.MatchString(normalized) && (best == nil || candidate.literals > best.literals) {
+			best = &m.patterns[i]
+		}
+	}
+	if best == nil {
+		return nil
+	}
+	return best.payee
+}
+
+// SetPayeeBreakdowns stores the payee breakdowns and works out each payee's share of the
+// total of its type
+func (s *Summary) SetPayeeBreakdowns(incomePayees, expensePayees []*PayeeBreakdown) {
+	s.IncomePayees = incomePayees
+	s.ExpensePayees = expensePayees
+
+	for _, breakdown := range s.IncomePayees {
+		if s.TotalIncome > 0 {
+			breakdown.Percentage = math.Round(breakdown.TotalAmount/s.TotalIncome*10000) / 100
+		}
+	}
+	for _, breakdown := range s.ExpensePayees {
+		if s.TotalExpense > 0 {
+			breakdown.Percentage = math.Round(breakdown.TotalAmount/s.TotalExpense*10000) / 100
+		}
+	}
+}
+
+// payeeFilterPattern finds a payee: term at the start of a query or after a space
+var payeeFilterPattern = regexp.MustCompile(`(?i)(?:^|\s)payee:`)
+
+// ParsePayeeFilter splits a `payee:<name>` term off a search query and returns the rest of
+// the query and the payee name prefix; a query without the term comes back unchanged.
+// Names with spaces are quoted: payee:"corner shop".
+func ParsePayeeFilter(input string) (query, payee string) {
+	match := payeeFilterPattern.FindStringIndex(input)
+	if match == nil {
+		return input, ""
+	}
+
+	start := match[1] - len("payee:")
+	rest := input[match[1]:]
+	var end int
+	if strings.HasPrefix(rest, `"`) {
+		if closing := strings.Index(rest[1:], `"`); closing >= 0 {
+			payee, end = rest[1:closing+1], closing+2
+		} else {
+			payee, end = rest[1:], len(rest)
+		}
+	} else {
+		end = strings.IndexByte(rest, ' ')
+		if end < 0 {
+			end = len(rest)
+		}
+		payee = rest[:end]
+	}
+
+	query = strings.Join(strings.Fields(input[:start]+" "+rest[end:]), " ")
+	return query, strings.TrimSpace(payee)
+}
+
+// FindPayee returns the payee named name, ignoring case and surrounding spaces
+func FindPayee(name string, payees []*Payee) *Payee {
+	name = strings.TrimSpace(name)
+	for _, payee := range payees {
+		if strings.EqualFold(payee.Name, name) {
+			return payee
+		}
+	}
+	return nil
+}
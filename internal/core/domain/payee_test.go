@@ -0,0 +1,101 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type PayeeTestSuite struct {
+	suite.Suite
+	amazon   *Payee
+	amazonDE *Payee
+	lidl     *Payee
+	matcher  *PayeeMatcher
+}
+
+func TestPayeeSuite(t *testing.T) {
+	suite.Run(t, new(PayeeTestSuite))
+}
+
+func (suite *PayeeTestSuite) SetupTest() {
+	suite.amazon = &Payee{ID: 1, Name: "Amazon", Aliases: []string{"AMZN Mktp*", "amazon*"}}
+	suite.amazonDE = &Payee{ID: 2, Name: "Amazon Germany", Aliases: []string{"amzn mktp de*"}}
+	suite.lidl = &Payee{ID: 3, Name: "Lidl", Aliases: []string{"lidl dienstleistung*", "*lidl sagt danke"}}
+	suite.matcher = NewPayeeMatcher([]*Payee{suite.amazon, suite.amazonDE, suite.lidl})
+}
+
+func (suite *PayeeTestSuite) TestMatch() {
+	testCases := []struct {
+		description string
+		expected    *Payee
+	}{
+		{"AMZN Mktp DE*2K4", suite.amazonDE},
+		{"AMZN Mktp FR*77Q", suite.amazon},
+		{"Amazon.de", suite.amazon},
+		{"AMAZON EU", suite.amazon},
+		{"  amazon  ", suite.amazon},
+		{"Amazon Germany", suite.amazonDE},
+		{"LIDL", suite.lidl},
+		{"Lidl Dienstleistung GmbH", suite.lidl},
+		{"1234 Lidl sagt Danke", suite.lidl},
+		{"Lidlx", nil},
+		{"Aldi", nil},
+		{"My amazon order", nil},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.description, func() {
+			assert.Equal(suite.T(), tc.expected, suite.matcher.Match(tc.description))
+		})
+	}
+}
+
+func (suite *PayeeTestSuite) TestMatch_RegexCharactersAreLiteral() {
+	matcher := NewPayeeMatcher([]*Payee{{ID: 1, Name: "Shop", Aliases: []string{"shop (online)+"}}})
+
+	assert.NotNil(suite.T(), matcher.Match("Shop (Online)+"))
+	assert.Nil(suite.T(), matcher.Match("shop online"))
+}
+
+func (suite *PayeeTestSuite) TestNormalizeAliases() {
+	assert.Equal(suite.T(), []string{"amzn mktp*", "amazon"}, NormalizeAliases([]string{" AMZN  Mktp*", "amazon", "", "Amazon"}))
+}
+
+func (suite *PayeeTestSuite) TestValidate() {
+	assert.NoError(suite.T(), suite.amazon.Validate())
+	assert.EqualError(suite.T(), (&Payee{Name: " "}).Validate(), "payee name cannot be empty")
+	assert.EqualError(suite.T(), (&Payee{Name: "Any", Aliases: []string{"**"}}).Validate(), `payee alias "**" must contain more than wildcards`)
+}
+
+func (suite *PayeeTestSuite) TestSetPayeeBreakdowns() {
+	summary := NewSummary(1000, 200)
+	expense := []*PayeeBreakdown{{Payee: suite.amazon, TotalAmount: 150}, {TotalAmount: 50}}
+
+	summary.SetPayeeBreakdowns(nil, expense)
+
+	assert.Equal(suite.T(), 75.0, summary.ExpensePayees[0].Percentage)
+	assert.Equal(suite.T(), 25.0, summary.ExpensePayees[1].Percentage)
+}
+
+func (suite *PayeeTestSuite) TestParsePayeeFilter() {
+	cases := []struct {
+		input, query, payee string
+	}{
+		{"coffee", "coffee", ""},
+		{"payee:amaz", "", "amaz"},
+		{"books payee:Amazon prime", "books prime", "Amazon"},
+		{`PAYEE:"corner shop" milk`, "milk", "corner shop"},
+		{`payee:"corner shop`, "", "corner shop"},
+		{"prepayee:x", "prepayee:x", ""},
+		{"prepayee:x payee:y", "prepayee:x", "y"},
+		{"İstanbul payee:migros", "İstanbul", "migros"},
+		{"café PAYEE:Bäckerei Ünal", "café Ünal", "Bäckerei"},
+	}
+	for _, c := range cases {
+		query, payee := ParsePayeeFilter(c.input)
+		suite.Equal(c.query, query, c.input)
+		suite.Equal(c.payee, payee, c.input)
+	}
+}
@@ -0,0 +1,107 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// QuickEntry is a transaction typed on one line, e.g. "42.50 food Lidl yesterday".
+// Category is still the text the user typed; it is matched against the real categories later.
+type QuickEntry struct {
+	Type        string
+	Amount      float64
+	Category    string
+	Description string
+	Date        time.Time
+}
+
+// quickAddExample shows the quick add format in error messages
+const quickAddExample = `"42.50 food Lidl yesterday"`
+
+// ParseQuickAdd reads "<amount> <category> <description> [date]". A leading "+" on the
+// amount records income instead of an expense, the amount may be arithmetic such as
+// "120/3", and a date of up to three words at the end is recognized with dates.
+func ParseQuickAdd(input string, locale *Locale, dates *DateParser) (*QuickEntry, error) {
+	fields := strings.Fields(input)
+	if len(fields) < 3 {
+		return nil, fmt.Errorf("enter an amount, a category and a description, e.g. %s", quickAddExample)
+	}
+
+	entry := &QuickEntry{Type: "expense", Category: fields[1], Date: dates.now()}
+
+	amountText := fields[0]
+	if rest, ok := strings.CutPrefix(amountText, "+"); ok {
+		entry.Type = "income"
+		amountText = rest
+	}
+	amount, err := locale.EvaluateAmount(amountText)
+	if err != nil {
+		return nil, fmt.Errorf("start with the amount, e.g. %s: %w", quickAddExample, err)
+	}
+	if amount <= 0 {
+		return nil, fmt.Errorf("amount must be greater than zero")
+	}
+	entry.Amount = amount
+
+	words := fields[2:]
+	for n := min(3, len(words)-1); n >= 1; n-- {
+		date, err := dates.Resolve(strings.Join(words[len(words)-n:], " "))
+		if err != nil {
+			continue
+		}
+		if err := dates.CheckHorizon(date); err != nil {
+			return nil, err
+		}
+		entry.Date = date
+		words = words[:len(words)-n]
+		break
+	}
+	entry.Description = strings.Join(words, " ")
+
+	return entry, nil
+}
+
+// MatchCategory finds the category a user means by query: an exact name, otherwise the only
+// category whose name or one of its words starts with query, ignoring case
+func MatchCategory(query string, categories []*Category) (*Category, error) {
+	query = strings.ToLower(strings.TrimSpace(query))
+	if query == "" {
+		return nil, fmt.Errorf("category is required")
+	}
+
+	var matches []*Category
+	for _, category := range categories {
+		name := strings.ToLower(category.Name)
+		if name == query {
+			return category, nil
+		}
+		if strings.HasPrefix(name, query) {
+			matches = append(matches, category)
+			continue
+		}
+		for _, word := range strings.FieldsFunc(name, isWordSeparator) {
+			if strings.HasPrefix(word, query) {
+				matches = append(matches, category)
+				break
+			}
+		}
+	}
+
+	switch len(matches) {
+	case 1:
+		return matches[0], nil
+	case 0:
+		return nil, fmt.Errorf("no category matches %q (categories: %s)", query, categoryNames(categories))
+	default:
+		return nil, fmt.Errorf("%q matches several categories: %s", query, categoryNames(matches))
+	}
+}
+
+func categoryNames(categories []*Category) string {
+	names := make([]string, len(categories))
+	for i, category := range categories {
+		names[i] = category.Name
+	}
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,110 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type QuickAddTestSuite struct {
+	suite.Suite
+	now   time.Time
+	dates *DateParser
+}
+
+func TestQuickAddSuite(t *testing.T) {
+	suite.Run(t, new(QuickAddTestSuite))
+}
+
+func (suite *QuickAddTestSuite) SetupTest() {
+	suite.now = time.Date(2026, 10, 14, 15, 30, 0, 0, time.UTC)
+	suite.dates = NewDateParser(DefaultLocale(), func() time.Time { return suite.now }, 0)
+}
+
+func (suite *QuickAddTestSuite) TestParseQuickAdd() {
+	testCases := []struct {
+		input    string
+		expected QuickEntry
+	}{
+		{"42.50 groceries Lidl yesterday", QuickEntry{"expense", 42.5, "groceries", "Lidl", time.Date(2026, 10, 13, 0, 0, 0, 0, time.UTC)}},
+		{"42.50 food Lidl", QuickEntry{"expense", 42.5, "food", "Lidl", suite.now}},
+		{"+2000 salary October pay", QuickEntry{"income", 2000, "salary", "October pay", suite.now}},
+		{"120/3 food Pizza with friends last fri", QuickEntry{"expense", 40, "food", "Pizza with friends", time.Date(2026, 10, 9, 0, 0, 0, 0, time.UTC)}},
+		{"2.80 trans Bus ticket 3 days ago", QuickEntry{"expense", 2.8, "trans", "Bus ticket", time.Date(2026, 10, 11, 0, 0, 0, 0, time.UTC)}},
+		{"5 food Bus 42", QuickEntry{"expense", 5, "food", "Bus 42", suite.now}},
+		{"9 other yesterday", QuickEntry{"expense", 9, "other", "yesterday", suite.now}},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.input, func() {
+			entry, err := ParseQuickAdd(tc.input, DefaultLocale(), suite.dates)
+			suite.Require().NoError(err)
+			assert.Equal(suite.T(), tc.expected, *entry)
+		})
+	}
+}
+
+func (suite *QuickAddTestSuite) TestParseQuickAdd_Errors() {
+	testCases := []struct {
+		input   string
+		message string
+	}{
+		{"", "enter an amount, a category and a description"},
+		{"42.50 food", "enter an amount, a category and a description"},
+		{"Lidl 42.50 food", "start with the amount"},
+		{"0 food Lidl", "greater than zero"},
+		{"10/0 food Lidl", "division by zero"},
+		{"5 food Lidl tomorrow", "is in the future"},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.input, func() {
+			_, err := ParseQuickAdd(tc.input, DefaultLocale(), suite.dates)
+			suite.Require().Error(err)
+			assert.Contains(suite.T(), err.Error(), tc.message)
+		})
+	}
+}
+
+func (suite *QuickAddTestSuite) TestMatchCategory() {
+	categories := []*Category{
+		{ID: 1, Name: "Food & Dining"},
+		{ID: 2, Name: "Transportation"},
+		{ID: 3, Name: "Bills & Utilities"},
+		{ID: 4, Name: "Other"},
+		{ID: 5, Name: "Groceries"},
+		{ID: 6, Name: "Gifts"},
+	}
+
+	testCases := []struct {
+		query      string
+		expectedID int
+		message    string
+	}{
+		{"Food & Dining", 1, ""},
+		{"food", 1, ""},
+		{"DINING", 1, ""},
+		{"trans", 2, ""},
+		{"util", 3, ""},
+		{"groceries", 5, ""},
+		{"gro", 5, ""},
+		{"g", 0, `"g" matches several categories: Groceries, Gifts`},
+		{"rent", 0, `no category matches "rent"`},
+		{" ", 0, "category is required"},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.query, func() {
+			category, err := MatchCategory(tc.query, categories)
+			if tc.message != "" {
+				suite.Require().Error(err)
+				assert.Contains(suite.T(), err.Error(), tc.message)
+				return
+			}
+			suite.Require().NoError(err)
+			assert.Equal(suite.T(), tc.expectedID, category.ID)
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// TransactionStatus tracks how far a transaction has been matched against the bank
+type TransactionStatus string
+
+const (
+	StatusUncleared  TransactionStatus = ""
+	StatusCleared    TransactionStatus = "cleared"    // ticked off against a statement
+	StatusReconciled TransactionStatus = "reconciled" // part of a finished reconciliation, locked
+)
+
+// ErrReconciled is returned when a change would touch a reconciled transaction. Reconciled
+// transactions have to be unlocked first, which sets them back to cleared.
+var ErrReconciled = errors.New("transaction is reconciled")
+
+func (s TransactionStatus) Validate() error {
+	switch s {
+	case StatusUncleared, StatusCleared, StatusReconciled:
+		return nil
+	default:
+		return fmt.Errorf("invalid transaction status %q", string(s))
+	}
+}
+
+// IsCleared reports whether the transaction has shown up on a statement, reconciled or not
+func (t *Transaction) IsCleared() bool {
+	return t.Status == StatusCleared || t.Status == StatusReconciled
+}
+
+func (t *Transaction) IsReconciled() bool {
+	return t.Status == StatusReconciled
+}
+
+// SignedAmount is the effect of the transaction on the balance: positive for income,
+// negative for expenses. Transfers count, since the money did leave or reach the account.
+func (t *Transaction) SignedAmount() float64 {
+	if t.IsExpense() {
+		return -t.Amount
+	}
+	return t.Amount
+}
+
+// Statement is a finished reconciliation against a bank statement
+type Statement struct {
+	ID             int       `json:"id"`
+	Date           time.Time `json:"date"` // the statement end date
+	OpeningBalance float64   `json:"opening_balance"`
+	ClosingBalance float64   `json:"closing_balance"`
+	ReconciledAt   time.Time `json:"reconciled_at"`
+}
+
+func (s *Statement) Validate() error {
+	if s.Date.IsZero() {
+		return fmt.Errorf("statement date cannot be zero")
+	}
+	return nil
+}
+
+// Reconciliation is a statement being worked through: the transactions that are not
+// reconciled yet and dated up to the statement date, some of them ticked off as cleared
+type Reconciliation struct {
+	Statement    *Statement     `json:"statement"`
+	Transactions []*Transaction `json:"transactions"`
+}
+
+// Clone returns a copy of the reconciliation whose transactions can be ticked off without
+// affecting the original
+func (r *Reconciliation) Clone() *Reconciliation {
+	statement := *r.Statement
+	clone := &Reconciliation{Statement: &statement, Transactions: make([]*Transaction, len(r.Transactions))}
+	for i, transaction := range r.Transactions {
+		clone.Transactions[i] = transaction.Clone()
+	}
+	return clone
+}
+
+// ClearedBalance is the opening balance plus every cleared transaction
+func (r *Reconciliation) ClearedBalance() float64 {
+	cents := toCents(r.Statement.OpeningBalance)
+	for _, transaction := range r.Transactions {
+		if transaction.IsCleared() {
+			cents += toCents(transaction.SignedAmount())
+		}
+	}
+	return float64(cents) / 100
+}
+
+// Difference is what the cleared transactions still lack to reach the closing balance
+func (r *Reconciliation) Difference() float64 {
+	return float64(toCents(r.Statement.ClosingBalance)-toCents(r.ClearedBalance())) / 100
+}
+
+// IsBalanced reports whether the cleared transactions add up to the closing balance
+func (r *Reconciliation) IsBalanced() bool {
+	return toCents(r.Difference()) == 0
+}
+
+// ClearedIDs returns the IDs of the transactions that will be locked when the reconciliation is finished
+func (r *Reconciliation) ClearedIDs() []int {
+	var ids []int
+	for _, transaction := range r.Transactions {
+		if transaction.IsCleared() {
+			ids = append(ids, transaction.ID)
+		}
+	}
+	return ids
+}
@@ -0,0 +1,80 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type ReconciliationTestSuite struct {
+	suite.Suite
+	reconciliation *Reconciliation
+}
+
+func TestReconciliationSuite(t *testing.T) {
+	suite.Run(t, new(ReconciliationTestSuite))
+}
+
+func (suite *ReconciliationTestSuite) SetupTest() {
+	date := time.Date(2026, 9, 30, 0, 0, 0, 0, time.UTC)
+	suite.reconciliation = &Reconciliation{
+		Statement: &Statement{Date: date, OpeningBalance: 100.10, ClosingBalance: 1699.90},
+		Transactions: []*Transaction{
+			{ID: 1, Description: "Salary", Amount: 2500, Type: "income", Date: date.AddDate(0, 0, -29)},
+			{ID: 2, Description: "Rent", Amount: 900.20, Type: "expense", Date: date.AddDate(0, 0, -28)},
+			{ID: 3, Description: "Savings", Amount: 300, Type: "expense", Date: date.AddDate(0, 0, -20), Transfer: true},
+			{ID: 4, Description: "Cheque", Amount: 40, Type: "expense", Date: date.AddDate(0, 0, -1)},
+		},
+	}
+}
+
+func (suite *ReconciliationTestSuite) clear(ids ...int) {
+	for _, id := range ids {
+		suite.reconciliation.Transactions[id-1].Status = StatusCleared
+	}
+}
+
+func (suite *ReconciliationTestSuite) TestBalance() {
+	assert := assert.New(suite.T())
+
+	assert.Equal(100.10, suite.reconciliation.ClearedBalance())
+	assert.Equal(1599.80, suite.reconciliation.Difference())
+	assert.False(suite.reconciliation.IsBalanced())
+	assert.Empty(suite.reconciliation.ClearedIDs())
+
+	suite.clear(1, 2)
+	assert.Equal(1699.90, suite.reconciliation.ClearedBalance())
+	assert.True(suite.reconciliation.IsBalanced())
+	assert.Equal([]int{1, 2}, suite.reconciliation.ClearedIDs())
+
+	// Transfers still move money in or out of the account
+	suite.clear(3)
+	assert.Equal(300.0, suite.reconciliation.Difference())
+}
+
+func (suite *ReconciliationTestSuite) TestStatus() {
+	assert := assert.New(suite.T())
+
+	transaction := suite.reconciliation.Transactions[1]
+	assert.False(transaction.IsCleared())
+	assert.Equal(-900.20, transaction.SignedAmount())
+
+	transaction.Status = StatusReconciled
+	assert.True(transaction.IsCleared())
+	assert.True(transaction.IsReconciled())
+	assert.NoError(transaction.Validate())
+
+	transaction.Status = "pending"
+	assert.Error(transaction.Validate())
+}
+
+func (suite *ReconciliationTestSuite) TestClone() {
+	clone := suite.reconciliation.Clone()
+	clone.Transactions[0].Status = StatusCleared
+	clone.Statement.ClosingBalance = 0
+
+	assert.Equal(suite.T(), StatusUncleared, suite.reconciliation.Transactions[0].Status)
+	assert.Equal(suite.T(), 1699.90, suite.reconciliation.Statement.ClosingBalance)
+}
@@ -0,0 +1,245 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Rule changes the transactions that meet all of its conditions. Rules run in Position
+// order and each one sees the changes made by the rules before it.
+type Rule struct {
+	ID         int            `json:"id"`
+	Name       string         `json:"name,omitempty"`
+	Position   int            `json:"position"`
+	Conditions RuleConditions `json:"conditions"`
+	Actions    RuleActions    `json:"actions"`
+
+	pattern *regexp.Regexp // compiled Conditions.DescriptionPattern
+}
+
+// RuleConditions must all hold for a rule to apply; conditions left empty always hold
+type RuleConditions struct {
+	Type                string         `json:"type,omitempty"`                 // "income" or "expense"
+	DescriptionContains string         `json:"description_contains,omitempty"` // ignoring case
+	DescriptionPattern  string         `json:"description_pattern,omitempty"`  // regular expression, ignoring case
+	MinAmount           *float64       `json:"min_amount,omitempty"`
+	MaxAmount           *float64       `json:"max_amount,omitempty"`
+	Payee               *Payee         `json:"payee,omitempty"`
+	Weekdays            []time.Weekday `json:"weekdays,omitempty"`
+}
+
+// RuleActions are the changes a rule makes; actions left empty change nothing
+type RuleActions struct {
+	Category    *Category `json:"category,omitempty"` // ignored for split transactions
+	Tags        []string  `json:"tags,omitempty"`     // added to the existing tags
+	Payee       *Payee    `json:"payee,omitempty"`
+	Description string    `json:"description,omitempty"` // replaces the description
+	Transfer    bool      `json:"transfer,omitempty"`
+}
+
+func (c *RuleConditions) isEmpty() bool {
+	return c.Type == "" && c.DescriptionContains == "" && c.DescriptionPattern == "" &&
+		c.MinAmount == nil && c.MaxAmount == nil && c.Payee == nil && len(c.Weekdays) == 0
+}
+
+func (a *RuleActions) isEmpty() bool {
+	return a.Category == nil && len(a.Tags) == 0 && a.Payee == nil && a.Description == "" && !a.Transfer
+}
+
+func (r *Rule) Validate() error {
+	if len(r.Name) > 100 {
+		return fmt.Errorf("rule name cannot exceed 100 characters")
+	}
+
+	conditions := &r.Conditions
+	if conditions.isEmpty() {
+		return fmt.Errorf("a rule needs at least one condition")
+	}
+	if conditions.Type != "" && conditions.Type != "income" && conditions.Type != "expense" {
+		return fmt.Errorf("rule type must be 'income' or 'expense'")
+	}
+	if conditions.DescriptionPattern != "" {
+		if _, err := regexp.Compile("(?i)" + conditions.DescriptionPattern); err != nil {
+			return fmt.Errorf("invalid description pattern: %w", err)
+		}
+	}
+	if conditions.MinAmount != nil && conditions.MaxAmount != nil && *conditions.MinAmount > *conditions.MaxAmount {
+		return fmt.Errorf("minimum amount cannot be larger than maximum amount")
+	}
+
+	actions := &r.Actions
+	if actions.isEmpty() {
+		return fmt.Errorf("a rule needs at least one action")
+	}
+	if actions.Category != nil && conditions.Type == "" {
+		return fmt.Errorf("a rule that sets a category needs a transaction type")
+	}
+	if err := ValidateTags(actions.Tags); err != nil {
+		return err
+	}
+	if len(actions.Description) > 200 {
+		return fmt.Errorf("new description cannot exceed 200 characters")
+	}
+	return nil
+}
+
+// Matches reports whether the transaction meets every condition of the rule
+func (r *Rule) Matches(t *Transaction) bool {
+	conditions := &r.Conditions
+	if conditions.Type != "" && t.Type != conditions.Type {
+		return false
+	}
+	if conditions.DescriptionContains != "" &&
+		!strings.Contains(strings.ToLower(t.Description), strings.ToLower(conditions.DescriptionContains)) {
+		return false
+	}
+	if conditions.DescriptionPattern != "" {
+		if r.pattern == nil || r.pattern.String() != "(?i)"+conditions.DescriptionPattern {
+			pattern, err := regexp.Compile("(?i)" + conditions.DescriptionPattern)
+			if err != nil {
+				return false
+			}
+			r.pattern = pattern
+		}
+		if !r.pattern.MatchString(t.Description) {
+			return false
+		}
+	}
+	if conditions.MinAmount != nil && t.Amount < *conditions.MinAmount {
+		return false
+	}
+	if conditions.MaxAmount != nil && t.Amount > *conditions.MaxAmount {
+		return false
+	}
+	if conditions.Payee != nil && (t.Payee == nil || t.Payee.ID != conditions.Payee.ID) {
+		return false
+	}
+	if len(conditions.Weekdays) > 0 && !slices.Contains(conditions.Weekdays, t.Date.Weekday()) {
+		return false
+	}
+	return true
+}
+
+// Apply makes the rule's changes to the transaction and reports whether anything changed.
+// It does not check the conditions; see Matches.
+func (r *Rule) Apply(t *Transaction) bool {
+	actions := &r.Actions
+	changed := false
+
+	if actions.Category != nil && !t.IsSplit() && (t.Category == nil || t.Category.ID != actions.Category.ID) {
+		category := *actions.Category
+		t.Category = &category
+		changed = true
+	}
+	if len(actions.Tags) > 0 {
+		tags := NormalizeTags(append(slices.Clone(t.Tags), actions.Tags...))
+		if !slices.Equal(tags, t.Tags) {
+			t.Tags = tags
+			changed = true
+		}
+	}
+	if actions.Payee != nil && (t.Payee == nil || t.Payee.ID != actions.Payee.ID) {
+		payee := *actions.Payee
+		t.Payee = &payee
+		changed = true
+	}
+	if actions.Description != "" && t.Description != actions.Description {
+		t.Description = actions.Description
+		changed = true
+	}
+	if actions.Transfer && !t.Transfer {
+		t.Transfer = true
+		changed = true
+	}
+	return changed
+}
+
+// ApplyRules runs the matching rules over the transaction in order and returns the rules
+// that changed it
+func ApplyRules(rules []*Rule, t *Transaction) []*Rule {
+	var applied []*Rule
+	for _, rule := range rules {
+		if rule.Matches(t) && rule.Apply(t) {
+			applied = append(applied, rule)
+		}
+	}
+	return applied
+}
+
+// RuleChange is what re-applying the rules would do to one stored transaction
+type RuleChange struct {
+	Before *Transaction `json:"before"`
+	After  *Transaction `json:"after"`
+	Rules  []*Rule      `json:"rules"`
+}
+
+// PreviewRules returns the changes the rules would make to the transactions, leaving the
+// transactions themselves untouched
+func PreviewRules(rules []*Rule, transactions []*Transaction) []*RuleChange {
+	var changes []*RuleChange
+	for _, transaction := range transactions {
+		after := transaction.Clone()
+		if applied := ApplyRules(rules, after); len(applied) > 0 {
+			changes = append(changes, &RuleChange{Before: transaction, After: after, Rules: applied})
+		}
+	}
+	return changes
+}
+
+// Differences describes each changed field as "field: old → new"
+func (c *RuleChange) Differences() []string {
+	var differences []string
+	describe := func(field, before, after string) {
+		if before != after {
+			differences = append(differences, fmt.Sprintf("%s: %s → %s", field, before, after))
+		}
+	}
+
+	describe("description", c.Before.Description, c.After.Description)
+	describe("category", c.Before.CategoryName(), c.After.CategoryName())
+	describe("payee", payeeName(c.Before.Payee), payeeName(c.After.Payee))
+	describe("tags", tagList(c.Before.Tags), tagList(c.After.Tags))
+	if c.After.Transfer && !c.Before.Transfer {
+		differences = append(differences, "marked as transfer")
+	}
+	return differences
+}
+
+func payeeName(payee *Payee) string {
+	if payee == nil {
+		return "none"
+	}
+	return payee.Name
+}
+
+func tagList(tags []string) string {
+	if len(tags) == 0 {
+		return "none"
+	}
+	return strings.Join(tags, ", ")
+}
+
+// Clone returns a copy of the transaction that can be changed without affecting the original
+func (t *Transaction) Clone() *Transaction {
+	clone := *t
+	if t.Category != nil {
+		category := *t.Category
+		clone.Category = &category
+	}
+	if t.Payee != nil {
+		payee := *t.Payee
+		clone.Payee = &payee
+	}
+	clone.Tags = slices.Clone(t.Tags)
+	if t.Splits != nil {
+		clone.Splits = make([]*Split, len(t.Splits))
+		for i, split := range t.Splits {
+			line := *split
+			clone.Splits[i] = &line
+		}
+	}
+	return &clone
+}
@@ -0,0 +1,325 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// ruleExample shows the rule syntax in error messages
+const ruleExample = `contains:amzn then category:shopping payee:Amazon`
+
+// RuleLookup holds the categories and payees that rule text refers to by name
+type RuleLookup struct {
+	Locale            *Locale
+	ExpenseCategories []*Category
+	IncomeCategories  []*Category
+	Payees            []*Payee
+}
+
+// ParseRule reads a rule typed on one line: conditions, the word "then" and actions, e.g.
+// `contains:"amzn mktp" amount:..100 weekday:sat,sun then category:shopping tags:online`.
+//
+// Conditions are type:, contains:, matches: (a regular expression), amount: (10..50, 10..,
+// ..50 or an exact amount), payee: and weekday: (mon,wed or mon-fri). Actions are
+// category:, tags:, payee:, rename: and transfer. Values with spaces are quoted and an
+// optional name: may go anywhere before "then". A category without type: picks the type
+// the category belongs to.
+func ParseRule(input string, lookup *RuleLookup) (*Rule, error) {
+	terms, err := splitRuleTerms(input)
+	if err != nil {
+		return nil, err
+	}
+
+	rule := &Rule{}
+	inActions := false
+	var categoryName string
+	for _, term := range terms {
+		name, value, hasValue := strings.Cut(term, ":")
+		name = strings.ToLower(name)
+
+		if !hasValue {
+			switch {
+			case name == "then" && !inActions:
+				inActions = true
+			case name == "transfer" && inActions:
+				rule.Actions.Transfer = true
+			default:
+				return nil, fmt.Errorf("unexpected %q, write rules like %s", term, ruleExample)
+			}
+			continue
+		}
+
+		value = strings.TrimSpace(value)
+		if value == "" {
+			return nil, fmt.Errorf("%s: needs a value", name)
+		}
+
+		if inActions {
+			switch name {
+			case "category":
+				categoryName = value
+			case "tag", "tags":
+				rule.Actions.Tags = ParseTags(value)
+			case "payee":
+				if rule.Actions.Payee, err = findRulePayee(value, lookup.Payees); err != nil {
+					return nil, err
+				}
+			case "rename":
+				rule.Actions.Description = value
+			default:
+				return nil, fmt.Errorf("unknown action %q; actions are category:, tags:, payee:, rename: and transfer", name)
+			}
+			continue
+		}
+
+		switch name {
+		case "name":
+			rule.Name = value
+		case "type":
+			rule.Conditions.Type = strings.ToLower(value)
+		case "contains":
+			rule.Conditions.DescriptionContains = value
+		case "matches":
+			rule.Conditions.DescriptionPattern = value
+		case "amount":
+			if err := parseAmountRange(value, lookup.Locale, &rule.Conditions); err != nil {
+				return nil, err
+			}
+		case "payee":
+			if rule.Conditions.Payee, err = findRulePayee(value, lookup.Payees); err != nil {
+				return nil, err
+			}
+		case "weekday", "weekdays":
+			if rule.Conditions.Weekdays, err = parseWeekdays(value); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, fmt.Errorf("unknown condition %q; conditions are type:, contains:, matches:, amount:, payee: and weekday:", name)
+		}
+	}
+
+	if !inActions {
+		return nil, fmt.Errorf(`add "then" and at least one action, e.g. %s`, ruleExample)
+	}
+	if categoryName != "" {
+		if err := resolveRuleCategory(rule, categoryName, lookup); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := rule.Validate(); err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+// FormatRule writes a rule in the syntax ParseRule reads
+func FormatRule(rule *Rule, locale *Locale) string {
+	var terms []string
+	add := func(name, value string) {
+		if value != "" {
+			terms = append(terms, name+":"+quoteRuleValue(value))
+		}
+	}
+
+	conditions := &rule.Conditions
+	add("name", rule.Name)
+	add("type", conditions.Type)
+	add("contains", conditions.DescriptionContains)
+	add("matches", conditions.DescriptionPattern)
+	if conditions.MinAmount != nil || conditions.MaxAmount != nil {
+		var lower, upper string
+		if conditions.MinAmount != nil {
+			lower = locale.FormatNumber(*conditions.MinAmount, 2)
+		}
+		if conditions.MaxAmount != nil {
+			upper = locale.FormatNumber(*conditions.MaxAmount, 2)
+		}
+		if conditions.MinAmount != nil && conditions.MaxAmount != nil && *conditions.MinAmount == *conditions.MaxAmount {
+			add("amount", lower)
+		} else {
+			add("amount", lower+".."+upper)
+		}
+	}
+	if conditions.Payee != nil {
+		add("payee", conditions.Payee.Name)
+	}
+	if len(conditions.Weekdays) > 0 {
+		days := make([]string, len(conditions.Weekdays))
+		for i, day := range conditions.Weekdays {
+			days[i] = strings.ToLower(day.String()[:3])
+		}
+		add("weekday", strings.Join(days, ","))
+	}
+
+	terms = append(terms, "then")
+
+	actions := &rule.Actions
+	if actions.Category != nil {
+		add("category", actions.Category.Name)
+	}
+	add("tags", strings.Join(actions.Tags, ","))
+	if actions.Payee != nil {
+		add("payee", actions.Payee.Name)
+	}
+	add("rename", actions.Description)
+	if actions.Transfer {
+		terms = append(terms, "transfer")
+	}
+
+	return strings.Join(terms, " ")
+}
+
+// splitRuleTerms splits rule text at spaces outside double quotes and drops the quotes
+func splitRuleTerms(input string) ([]string, error) {
+	var terms []string
+	var term strings.Builder
+	quoted, inTerm := false, false
+	for _, r := range input {
+		switch {
+		case r == '"':
+			quoted = !quoted
+			inTerm = true
+		case unicode.IsSpace(r) && !quoted:
+			if inTerm {
+				terms = append(terms, term.String())
+				term.Reset()
+				inTerm = false
+			}
+		default:
+			term.WriteRune(r)
+			inTerm = true
+		}
+	}
+	if quoted {
+		return nil, fmt.Errorf("missing closing quote")
+	}
+	if inTerm {
+		terms = append(terms, term.String())
+	}
+	return terms, nil
+}
+
+func quoteRuleValue(value string) string {
+	if strings.IndexFunc(value, unicode.IsSpace) >= 0 {
+		return `"` + value + `"`
+	}
+	return value
+}
+
+// parseAmountRange reads "10..50", "10..", "..50" or an exact amount
+func parseAmountRange(value string, locale *Locale, conditions *RuleConditions) error {
+	lower, upper, isRange := strings.Cut(value, "..")
+	if !isRange {
+		upper = lower
+	}
+
+	parse := func(text string) (*float64, error) {
+		if text == "" {
+			return nil, nil
+		}
+		amount, err := locale.ParseAmount(text)
+		if err != nil {
+			return nil, fmt.Errorf("amount: %w", err)
+		}
+		return &amount, nil
+	}
+
+	var err error
+	if conditions.MinAmount, err = parse(lower); err != nil {
+		return err
+	}
+	if conditions.MaxAmount, err = parse(upper); err != nil {
+		return err
+	}
+	if conditions.MinAmount == nil && conditions.MaxAmount == nil {
+		return fmt.Errorf("amount: write a range such as 10..50, 10.. or ..50")
+	}
+	return nil
+}
+
+// parseWeekdays reads weekday lists such as "sat,sun" and ranges such as "mon-fri"
+func parseWeekdays(value string) ([]time.Weekday, error) {
+	var days []time.Weekday
+	seen := map[time.Weekday]bool{}
+	addDay := func(day time.Weekday) {
+		if !seen[day] {
+			seen[day] = true
+			days = append(days, day)
+		}
+	}
+
+	for _, part := range strings.Split(value, ",") {
+		first, last, isRange := strings.Cut(strings.TrimSpace(part), "-")
+		from, err := parseWeekday(first)
+		if err != nil {
+			return nil, err
+		}
+		if !isRange {
+			addDay(from)
+			continue
+		}
+		to, err := parseWeekday(last)
+		if err != nil {
+			return nil, err
+		}
+		for day := from; ; day = (day + 1) % 7 {
+			addDay(day)
+			if day == to {
+				break
+			}
+		}
+	}
+	return days, nil
+}
+
+func parseWeekday(name string) (time.Weekday, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if day, ok := weekdayNames[name]; ok {
+		return day, nil
+	}
+	return 0, fmt.Errorf("weekday: unknown day %q, use mon, tue, wed, thu, fri, sat or sun", name)
+}
+
+func findRulePayee(name string, payees []*Payee) (*Payee, error) {
+	if payee := FindPayee(name, payees); payee != nil {
+		return payee, nil
+	}
+	return nil, fmt.Errorf("unknown payee %q", name)
+}
+
+// resolveRuleCategory finds the category a rule sets among the categories of its type, or
+// works out the type when the category name only exists for one of them
+func resolveRuleCategory(rule *Rule, name string, lookup *RuleLookup) error {
+	switch rule.Conditions.Type {
+	case "expense":
+		category, err := MatchCategory(name, lookup.ExpenseCategories)
+		rule.Actions.Category = category
+		return err
+	case "income":
+		category, err := MatchCategory(name, lookup.IncomeCategories)
+		rule.Actions.Category = category
+		return err
+	case "":
+	default:
+		return fmt.Errorf("rule type must be 'income' or 'expense'")
+	}
+
+	expense, expenseErr := MatchCategory(name, lookup.ExpenseCategories)
+	income, incomeErr := MatchCategory(name, lookup.IncomeCategories)
+	switch {
+	case expenseErr == nil && incomeErr == nil:
+		return fmt.Errorf("category %q exists for income and expenses, add type:income or type:expense", name)
+	case expenseErr == nil:
+		rule.Conditions.Type = "expense"
+		rule.Actions.Category = expense
+	case incomeErr == nil:
+		rule.Conditions.Type = "income"
+		rule.Actions.Category = income
+	default:
+		return expenseErr
+	}
+	return nil
+}
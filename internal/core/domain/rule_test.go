@@ -0,0 +1,276 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type RuleTestSuite struct {
+	suite.Suite
+	shopping *Category
+	amazon   *Payee
+	lookup   *RuleLookup
+}
+
+func TestRuleSuite(t *testing.T) {
+	suite.Run(t, new(RuleTestSuite))
+}
+
+func (suite *RuleTestSuite) SetupTest() {
+	suite.shopping = &Category{ID: 3, Name: "Shopping"}
+	suite.amazon = &Payee{ID: 7, Name: "Amazon"}
+	suite.lookup = &RuleLookup{
+		Locale:            DefaultLocale(),
+		ExpenseCategories: []*Category{{ID: 1, Name: "Food & Dining"}, suite.shopping, {ID: 5, Name: "Other"}},
+		IncomeCategories:  []*Category{{ID: 8, Name: "Salary"}, {ID: 9, Name: "Other"}},
+		Payees:            []*Payee{suite.amazon, {ID: 8, Name: "Corner Shop"}},
+	}
+}
+
+func amountPtr(amount float64) *float64 {
+	return &amount
+}
+
+// saturday is 2024-06-15, a Saturday
+var saturday = time.Date(2024, 6, 15, 10, 0, 0, 0, time.UTC)
+
+func (suite *RuleTestSuite) TestMatches() {
+	transaction := &Transaction{Description: "AMZN Mktp DE*2K4", Amount: 25, Type: "expense", Date: saturday, Payee: suite.amazon}
+
+	testCases := []struct {
+		name       string
+		conditions RuleConditions
+		expected   bool
+	}{
+		{"contains ignores case", RuleConditions{DescriptionContains: "amzn mktp"}, true},
+		{"contains misses", RuleConditions{DescriptionContains: "paypal"}, false},
+		{"pattern", RuleConditions{DescriptionPattern: `^amzn mktp (de|fr)\*`}, true},
+		{"pattern misses", RuleConditions{DescriptionPattern: `^amazon`}, false},
+		{"amount in range", RuleConditions{MinAmount: amountPtr(10), MaxAmount: amountPtr(25)}, true},
+		{"amount below range", RuleConditions{MinAmount: amountPtr(30)}, false},
+		{"amount above range", RuleConditions{MaxAmount: amountPtr(24.99)}, false},
+		{"type", RuleConditions{Type: "income", DescriptionContains: "amzn"}, false},
+		{"payee", RuleConditions{Payee: &Payee{ID: 7}}, true},
+		{"other payee", RuleConditions{Payee: &Payee{ID: 8}}, false},
+		{"weekend", RuleConditions{Weekdays: []time.Weekday{time.Saturday, time.Sunday}}, true},
+		{"weekdays only", RuleConditions{Weekdays: []time.Weekday{time.Monday, time.Friday}}, false},
+		{"all conditions must hold", RuleConditions{DescriptionContains: "amzn", MaxAmount: amountPtr(20)}, false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			rule := &Rule{Conditions: tc.conditions}
+			assert.Equal(suite.T(), tc.expected, rule.Matches(transaction))
+		})
+	}
+}
+
+func (suite *RuleTestSuite) TestMatches_TransactionWithoutPayee() {
+	rule := &Rule{Conditions: RuleConditions{Payee: suite.amazon}}
+
+	assert.False(suite.T(), rule.Matches(&Transaction{Description: "Amazon", Amount: 5, Type: "expense", Date: saturday}))
+}
+
+func (suite *RuleTestSuite) TestApply() {
+	assert := assert.New(suite.T())
+
+	transaction := &Transaction{Description: "AMZN Mktp DE*2K4", Amount: 25, Type: "expense", Date: saturday, Tags: []string{"books"}}
+	rule := &Rule{Actions: RuleActions{
+		Category:    suite.shopping,
+		Tags:        []string{"online"},
+		Payee:       suite.amazon,
+		Description: "Amazon",
+		Transfer:    true,
+	}}
+
+	assert.True(rule.Apply(transaction))
+	assert.Equal(suite.shopping, transaction.Category)
+	assert.NotSame(suite.shopping, transaction.Category, "the rule's category is copied")
+	assert.Equal([]string{"books", "online"}, transaction.Tags)
+	assert.Equal(suite.amazon, transaction.Payee)
+	assert.Equal("Amazon", transaction.Description)
+	assert.True(transaction.Transfer)
+
+	assert.False(rule.Apply(transaction), "applying again changes nothing")
+}
+
+func (suite *RuleTestSuite) TestApply_KeepsCategoryOfSplits() {
+	assert := assert.New(suite.T())
+
+	food := &Category{ID: 1, Name: "Food & Dining"}
+	transaction := &Transaction{Description: "Supermarket", Amount: 20, Type: "expense", Date: saturday, Category: food,
+		Splits: []*Split{{Category: food, Amount: 15}, {Category: suite.shopping, Amount: 5}}}
+	rule := &Rule{Actions: RuleActions{Category: &Category{ID: 5, Name: "Other"}}}
+
+	assert.False(rule.Apply(transaction))
+	assert.Equal(food, transaction.Category)
+}
+
+func (suite *RuleTestSuite) TestApplyRules_LaterRulesSeeEarlierChanges() {
+	assert := assert.New(suite.T())
+
+	rename := &Rule{ID: 1, Conditions: RuleConditions{DescriptionContains: "amzn"}, Actions: RuleActions{Description: "Amazon"}}
+	tag := &Rule{ID: 2, Conditions: RuleConditions{DescriptionContains: "amazon"}, Actions: RuleActions{Tags: []string{"online"}}}
+	unrelated := &Rule{ID: 3, Conditions: RuleConditions{DescriptionContains: "lidl"}, Actions: RuleActions{Transfer: true}}
+
+	transaction := &Transaction{Description: "AMZN Mktp", Amount: 25, Type: "expense", Date: saturday}
+	applied := ApplyRules([]*Rule{rename, tag, unrelated}, transaction)
+
+	assert.Equal([]*Rule{rename, tag}, applied)
+	assert.Equal("Amazon", transaction.Description)
+	assert.Equal([]string{"online"}, transaction.Tags)
+}
+
+func (suite *RuleTestSuite) TestPreviewRules() {
+	assert := assert.New(suite.T())
+
+	rule := &Rule{
+		Conditions: RuleConditions{Type: "expense", DescriptionContains: "amzn"},
+		Actions:    RuleActions{Category: suite.shopping, Payee: suite.amazon, Tags: []string{"online"}},
+	}
+	matching := &Transaction{ID: 1, Description: "AMZN Mktp", Amount: 25, Type: "expense", Date: saturday, Category: &Category{ID: 5, Name: "Other"}}
+	done := &Transaction{ID: 2, Description: "AMZN Mktp", Amount: 5, Type: "expense", Date: saturday,
+		Category: suite.shopping, Payee: suite.amazon, Tags: []string{"online"}}
+	other := &Transaction{ID: 3, Description: "Lidl", Amount: 5, Type: "expense", Date: saturday}
+
+	changes := PreviewRules([]*Rule{rule}, []*Transaction{matching, done, other})
+
+	suite.Require().Len(changes, 1)
+	change := changes[0]
+	assert.Same(matching, change.Before)
+	assert.Equal("Other", matching.Category.Name, "the stored transaction is left alone")
+	assert.Equal("Shopping", change.After.Category.Name)
+	assert.Equal([]*Rule{rule}, change.Rules)
+	assert.Equal([]string{
+		"category: Other → Shopping",
+		"payee: none → Amazon",
+		"tags: none → online",
+	}, change.Differences())
+}
+
+func (suite *RuleTestSuite) TestValidate() {
+	testCases := []struct {
+		name     string
+		rule     Rule
+		expected string
+	}{
+		{"no condition", Rule{Actions: RuleActions{Transfer: true}}, "a rule needs at least one condition"},
+		{"no action", Rule{Conditions: RuleConditions{DescriptionContains: "x"}}, "a rule needs at least one action"},
+		{"bad pattern", Rule{Conditions: RuleConditions{DescriptionPattern: "("}, Actions: RuleActions{Transfer: true}}, "invalid description pattern: error parsing regexp: missing closing ): `(?i)(`"},
+		{"empty range", Rule{Conditions: RuleConditions{MinAmount: amountPtr(50), MaxAmount: amountPtr(10)}, Actions: RuleActions{Transfer: true}}, "minimum amount cannot be larger than maximum amount"},
+		{"category without type", Rule{Conditions: RuleConditions{DescriptionContains: "x"}, Actions: RuleActions{Category: suite.shopping}}, "a rule that sets a category needs a transaction type"},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			assert.EqualError(suite.T(), tc.rule.Validate(), tc.expected)
+		})
+	}
+}
+
+func (suite *RuleTestSuite) TestParseRule() {
+	assert := assert.New(suite.T())
+
+	rule, err := ParseRule(`name:"Online shopping" contains:"amzn mktp" amount:..100 weekday:sat,sun then category:shop tags:Online,Books payee:amazon rename:Amazon transfer`, suite.lookup)
+
+	suite.Require().NoError(err)
+	assert.Equal("Online shopping", rule.Name)
+	assert.Equal("expense", rule.Conditions.Type, "the type follows from the category")
+	assert.Equal("amzn mktp", rule.Conditions.DescriptionContains)
+	assert.Nil(rule.Conditions.MinAmount)
+	assert.Equal(amountPtr(100), rule.Conditions.MaxAmount)
+	assert.Equal([]time.Weekday{time.Saturday, time.Sunday}, rule.Conditions.Weekdays)
+	assert.Equal(suite.shopping, rule.Actions.Category)
+	assert.Equal([]string{"books", "online"}, rule.Actions.Tags)
+	assert.Equal(suite.amazon, rule.Actions.Payee)
+	assert.Equal("Amazon", rule.Actions.Description)
+	assert.True(rule.Actions.Transfer)
+}
+
+func (suite *RuleTestSuite) TestParseRule_Conditions() {
+	assert := assert.New(suite.T())
+
+	rule, err := ParseRule(`matches:^sepa\s+ amount:10..50 payee:"corner shop" weekday:mon-wed,fri then transfer`, suite.lookup)
+
+	suite.Require().NoError(err)
+	assert.Equal(`^sepa\s+`, rule.Conditions.DescriptionPattern)
+	assert.Equal(amountPtr(10), rule.Conditions.MinAmount)
+	assert.Equal(amountPtr(50), rule.Conditions.MaxAmount)
+	assert.Equal("Corner Shop", rule.Conditions.Payee.Name)
+	assert.Equal([]time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Friday}, rule.Conditions.Weekdays)
+
+	rule, err = ParseRule("amount:12.5 weekday:fri-mon then transfer", suite.lookup)
+
+	suite.Require().NoError(err)
+	assert.Equal(amountPtr(12.5), rule.Conditions.MinAmount)
+	assert.Equal(amountPtr(12.5), rule.Conditions.MaxAmount)
+	assert.Equal([]time.Weekday{time.Friday, time.Saturday, time.Sunday, time.Monday}, rule.Conditions.Weekdays)
+}
+
+func (suite *RuleTestSuite) TestParseRule_Errors() {
+	testCases := []struct {
+		input    string
+		expected string
+	}{
+		{"contains:amzn", `add "then" and at least one action, e.g. contains:amzn then category:shopping payee:Amazon`},
+		{"then transfer", "a rule needs at least one condition"},
+		{"contains:amzn then", "a rule needs at least one action"},
+		{`contains:"amzn then transfer`, "missing closing quote"},
+		{"amzn then transfer", `unexpected "amzn", write rules like contains:amzn then category:shopping payee:Amazon`},
+		{"color:red then transfer", `unknown condition "color"; conditions are type:, contains:, matches:, amount:, payee: and weekday:`},
+		{"contains:x then color:red", `unknown action "color"; actions are category:, tags:, payee:, rename: and transfer`},
+		{"contains: then transfer", "contains: needs a value"},
+		{"amount:.. then transfer", "amount: write a range such as 10..50, 10.. or ..50"},
+		{"amount:ten then transfer", `amount: invalid amount "ten"`},
+		{"weekday:someday then transfer", `weekday: unknown day "someday", use mon, tue, wed, thu, fri, sat or sun`},
+		{"payee:ebay then transfer", `unknown payee "ebay"`},
+		{"contains:x then category:other", `category "other" exists for income and expenses, add type:income or type:expense`},
+		{"type:income contains:x then category:shop", `no category matches "shop" (categories: Salary, Other)`},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.input, func() {
+			_, err := ParseRule(tc.input, suite.lookup)
+			assert.EqualError(suite.T(), err, tc.expected)
+		})
+	}
+}
+
+func (suite *RuleTestSuite) TestFormatRule_RoundTrip() {
+	assert := assert.New(suite.T())
+
+	inputs := []string{
+		`name:"Online shopping" type:expense contains:"amzn mktp" amount:..100.00 weekday:sat,sun then category:Shopping tags:books,online payee:Amazon rename:Amazon transfer`,
+		`type:income matches:^salary amount:1,000.00..5,000.00 then category:Salary`,
+		`amount:4.50 payee:"Corner Shop" then tags:snacks`,
+	}
+
+	for _, input := range inputs {
+		rule, err := ParseRule(input, suite.lookup)
+		suite.Require().NoError(err, input)
+		assert.Equal(input, FormatRule(rule, suite.lookup.Locale))
+	}
+}
+
+func (suite *RuleTestSuite) TestClone() {
+	assert := assert.New(suite.T())
+
+	original := &Transaction{ID: 1, Description: "Lidl", Amount: 20, Type: "expense", Date: saturday,
+		Category: suite.shopping, Payee: suite.amazon, Tags: []string{"food"},
+		Splits: []*Split{{Category: suite.shopping, Amount: 10}, {Category: suite.shopping, Amount: 10}}}
+
+	clone := original.Clone()
+	assert.Equal(original, clone)
+
+	clone.Category.Name = "Changed"
+	clone.Payee.Name = "Changed"
+	clone.Tags[0] = "changed"
+	clone.Splits[0].Amount = 1
+	assert.Equal("Shopping", original.Category.Name)
+	assert.Equal("Amazon", original.Payee.Name)
+	assert.Equal("food", original.Tags[0])
+	assert.Equal(10.0, original.Splits[0].Amount)
+}
@@ -0,0 +1,110 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// Split is one line of a transaction divided across several categories, such as the
+// household part of a supermarket receipt
+type Split struct {
+	ID       int       `json:"id"`
+	Category *Category `json:"category"`
+	Amount   float64   `json:"amount"`
+	Memo     string    `json:"memo,omitempty"`
+}
+
+// IsSplit reports whether the transaction is divided into lines
+func (t *Transaction) IsSplit() bool {
+	return len(t.Splits) > 0
+}
+
+// Unallocated is the part of the transaction amount not yet assigned to a split line;
+// it is negative when the lines add up to more than the amount
+func (t *Transaction) Unallocated() float64 {
+	remainder := toCents(t.Amount)
+	for _, split := range t.Splits {
+		remainder -= toCents(split.Amount)
+	}
+	return float64(remainder) / 100
+}
+
+// ValidateSplits checks that every line has a category and a positive amount and that the
+// lines add up to the transaction amount to the cent
+func (t *Transaction) ValidateSplits() error {
+	if !t.IsSplit() {
+		return nil
+	}
+	if len(t.Splits) < 2 {
+		return fmt.Errorf("a split needs at least two lines")
+	}
+
+	for i, split := range t.Splits {
+		if split.Category == nil || split.Category.ID <= 0 {
+			return fmt.Errorf("split line %d needs a category", i+1)
+		}
+		if split.Amount <= 0 {
+			return fmt.Errorf("split line %d amount must be positive", i+1)
+		}
+		if len(split.Memo) > 200 {
+			return fmt.Errorf("split line %d memo cannot exceed 200 characters", i+1)
+		}
+	}
+
+	if remainder := t.Unallocated(); remainder != 0 {
+		return fmt.Errorf("split lines must add up to %.2f (%.2f unallocated)", t.Amount, remainder)
+	}
+	return nil
+}
+
+// MainSplit returns the largest line, whose category stands for the whole transaction
+// where only one category can be shown; the first of equal lines wins
+func (t *Transaction) MainSplit() *Split {
+	var main *Split
+	for _, split := range t.Splits {
+		if main == nil || split.Amount > main.Amount {
+			main = split
+		}
+	}
+	return main
+}
+
+// ParseSplitLine reads a split line typed as "[amount] <category> [memo]", e.g.
+// "12.40 household" or "3.20 pharmacy aspirin". Without an amount the line takes
+// remainder, the part of the transaction still unallocated. The category is a full
+// name, which may span several words, or a unique prefix of one word.
+func ParseSplitLine(input string, locale *Locale, categories []*Category, remainder float64) (*Split, error) {
+	words := strings.Fields(input)
+	if len(words) == 0 {
+		return nil, fmt.Errorf("enter an amount and a category, e.g. %s household", locale.FormatNumber(12.4, 2))
+	}
+
+	amount := remainder
+	if value, err := locale.EvaluateAmount(words[0]); err == nil {
+		amount = value
+		words = words[1:]
+	}
+	if len(words) == 0 {
+		return nil, fmt.Errorf("category is required")
+	}
+	if amount <= 0 {
+		return nil, fmt.Errorf("amount must be greater than zero")
+	}
+
+	for n := len(words); n > 1; n-- {
+		if category := FindCategory(strings.Join(words[:n], " "), categories); category != nil {
+			return &Split{Category: category, Amount: amount, Memo: strings.Join(words[n:], " ")}, nil
+		}
+	}
+	category, err := MatchCategory(words[0], categories)
+	if err != nil {
+		return nil, err
+	}
+	return &Split{Category: category, Amount: amount, Memo: strings.Join(words[1:], " ")}, nil
+}
+
+// toCents converts an amount to whole cents, avoiding float drift when summing lines
+func toCents(amount float64) int64 {
+	return int64(math.Round(amount * 100))
+}
@@ -0,0 +1,124 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type SplitTestSuite struct {
+	suite.Suite
+	groceries *Category
+	household *Category
+	pharmacy  *Category
+}
+
+func TestSplitSuite(t *testing.T) {
+	suite.Run(t, new(SplitTestSuite))
+}
+
+func (suite *SplitTestSuite) SetupTest() {
+	suite.groceries = &Category{ID: 1, Name: "Groceries"}
+	suite.household = &Category{ID: 2, Name: "Household"}
+	suite.pharmacy = &Category{ID: 3, Name: "Pharmacy"}
+}
+
+func (suite *SplitTestSuite) receipt(splits ...*Split) *Transaction {
+	return &Transaction{
+		Description: "Supermarket",
+		Amount:      58.3,
+		Date:        time.Date(2026, 10, 14, 0, 0, 0, 0, time.UTC),
+		Type:        "expense",
+		Splits:      splits,
+	}
+}
+
+func (suite *SplitTestSuite) TestValidate_Balanced() {
+	transaction := suite.receipt(
+		&Split{Category: suite.groceries, Amount: 41.1},
+		&Split{Category: suite.household, Amount: 12.4},
+		&Split{Category: suite.pharmacy, Amount: 4.8, Memo: "aspirin"},
+	)
+
+	assert.NoError(suite.T(), transaction.Validate())
+	assert.Zero(suite.T(), transaction.Unallocated())
+	assert.True(suite.T(), transaction.IsSplit())
+	assert.Equal(suite.T(), "Split (3)", transaction.CategoryName())
+	assert.Equal(suite.T(), suite.groceries, transaction.MainSplit().Category)
+}
+
+func (suite *SplitTestSuite) TestValidate_Unbalanced() {
+	transaction := suite.receipt(
+		&Split{Category: suite.groceries, Amount: 41.1},
+		&Split{Category: suite.household, Amount: 12.4},
+	)
+
+	assert.InDelta(suite.T(), 4.8, transaction.Unallocated(), 0.001)
+	assert.EqualError(suite.T(), transaction.Validate(), "split lines must add up to 58.30 (4.80 unallocated)")
+
+	transaction.Splits[1].Amount = 20
+	assert.InDelta(suite.T(), -2.8, transaction.Unallocated(), 0.001)
+	assert.Error(suite.T(), transaction.Validate())
+}
+
+func (suite *SplitTestSuite) TestValidate_InvalidLines() {
+	testCases := []struct {
+		name     string
+		splits   []*Split
+		expected string
+	}{
+		{"single line", []*Split{{Category: suite.groceries, Amount: 58.3}}, "a split needs at least two lines"},
+		{"missing category", []*Split{{Category: suite.groceries, Amount: 50}, {Amount: 8.3}}, "split line 2 needs a category"},
+		{"zero amount", []*Split{{Category: suite.groceries, Amount: 58.3}, {Category: suite.household, Amount: 0}}, "split line 2 amount must be positive"},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			assert.EqualError(suite.T(), suite.receipt(tc.splits...).Validate(), tc.expected)
+		})
+	}
+}
+
+func (suite *SplitTestSuite) TestUnsplitTransaction() {
+	transaction := suite.receipt()
+	transaction.Category = suite.groceries
+
+	assert.NoError(suite.T(), transaction.Validate())
+	assert.False(suite.T(), transaction.IsSplit())
+	assert.Nil(suite.T(), transaction.MainSplit())
+	assert.Equal(suite.T(), "Groceries", transaction.CategoryName())
+	assert.Equal(suite.T(), "Uncategorized", (&Transaction{}).CategoryName())
+}
+
+func (suite *SplitTestSuite) TestParseSplitLine() {
+	categories := []*Category{suite.groceries, suite.household, suite.pharmacy}
+	locale := DefaultLocale()
+
+	split, err := ParseSplitLine("12.40 house", locale, categories, 20)
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), &Split{Category: suite.household, Amount: 12.4}, split)
+
+	split, err = ParseSplitLine("3*1.60 pharm aspirin and plasters", locale, categories, 20)
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), &Split{Category: suite.pharmacy, Amount: 4.8, Memo: "aspirin and plasters"}, split)
+
+	split, err = ParseSplitLine("groceries", locale, categories, 41.1)
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), &Split{Category: suite.groceries, Amount: 41.1}, split)
+
+	split, err = ParseSplitLine("5 Food & Dining snacks", locale, append(categories, &Category{ID: 4, Name: "Food & Dining"}), 10)
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), "Food & Dining", split.Category.Name)
+	assert.Equal(suite.T(), "snacks", split.Memo)
+
+	_, err = ParseSplitLine("", locale, categories, 10)
+	assert.Error(suite.T(), err)
+	_, err = ParseSplitLine("12.40", locale, categories, 10)
+	assert.EqualError(suite.T(), err, "category is required")
+	_, err = ParseSplitLine("groceries", locale, categories, 0)
+	assert.EqualError(suite.T(), err, "amount must be greater than zero")
+	_, err = ParseSplitLine("5 toys", locale, categories, 10)
+	assert.Error(suite.T(), err)
+}
@@ -0,0 +1,54 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// timezone is where calendar days begin and end: the day a transaction is booked on and
+// the bounds of every period are taken in it
+var timezone = time.Local
+
+// UseTimezone sets the timezone the calendar helpers work in; nil means the system's
+func UseTimezone(location *time.Location) {
+	if location == nil {
+		location = time.Local
+	}
+	timezone = location
+}
+
+// Timezone returns the timezone set with UseTimezone, the system's by default
+func Timezone() *time.Location {
+	return timezone
+}
+
+// LoadTimezone looks up an IANA timezone name such as "Europe/Berlin". An empty name or
+// "local" is the system's timezone.
+func LoadTimezone(name string) (*time.Location, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || strings.EqualFold(name, "local") {
+		return time.Local, nil
+	}
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("unknown timezone %q", name)
+	}
+	return location, nil
+}
+
+// Now returns the current time in the configured timezone
+func Now() time.Time {
+	return time.Now().In(timezone)
+}
+
+// StartOfDay returns midnight at the start of t's calendar day in the configured timezone
+func StartOfDay(t time.Time) time.Time {
+	t = t.In(timezone)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, timezone)
+}
+
+// FormatDay returns t's calendar day in the configured timezone, e.g. "2026-10-18"
+func FormatDay(t time.Time) string {
+	return t.In(timezone).Format(time.DateOnly)
+}
@@ -0,0 +1,140 @@
+package domain
+
+import (
+	"testing"
+	"time"
+	_ "time/tzdata"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type TimezoneTestSuite struct {
+	suite.Suite
+	berlin *time.Location
+}
+
+func TestTimezoneSuite(t *testing.T) {
+	suite.Run(t, new(TimezoneTestSuite))
+}
+
+func (suite *TimezoneTestSuite) SetupTest() {
+	var err error
+	suite.berlin, err = LoadTimezone("Europe/Berlin")
+	suite.Require().NoError(err)
+	UseTimezone(suite.berlin)
+}
+
+func (suite *TimezoneTestSuite) TearDownTest() {
+	UseTimezone(nil)
+}
+
+// inBerlin returns a time on Berlin's wall clock
+func (suite *TimezoneTestSuite) inBerlin(year int, month time.Month, day, hour, minute int) time.Time {
+	return time.Date(year, month, day, hour, minute, 0, 0, suite.berlin)
+}
+
+func (suite *TimezoneTestSuite) TestLoadTimezone() {
+	assert := assert.New(suite.T())
+
+	for _, name := range []string{"", "local", " Local "} {
+		location, err := LoadTimezone(name)
+		suite.Require().NoError(err)
+		assert.Equal(time.Local, location, name)
+	}
+
+	_, err := LoadTimezone("Mars/Olympus_Mons")
+	assert.EqualError(err, `unknown timezone "Mars/Olympus_Mons"`)
+
+	UseTimezone(nil)
+	assert.Equal(time.Local, Timezone())
+}
+
+func (suite *TimezoneTestSuite) TestMonthRange_DST() {
+	assert := assert.New(suite.T())
+
+	// Clocks go forward on March 29th, 2026 and back on October 25th
+	march := GetMonthRange(2026, time.March)
+	assert.Equal(suite.inBerlin(2026, 3, 1, 0, 0), march.Start)
+	assert.Equal(time.Date(2026, 3, 31, 23, 59, 59, 999999999, suite.berlin), march.End)
+	assert.Equal(31*24*time.Hour-time.Hour-time.Nanosecond, march.End.Sub(march.Start))
+
+	october := GetMonthRange(2026, time.October)
+	assert.Equal(31*24*time.Hour+time.Hour-time.Nanosecond, october.End.Sub(october.Start))
+}
+
+func (suite *TimezoneTestSuite) TestWeekRange_DST() {
+	assert := assert.New(suite.T())
+
+	week := GetPeriodRange(PeriodTypeWeek, suite.inBerlin(2026, 3, 29, 12, 0))
+	assert.Equal(suite.inBerlin(2026, 3, 23, 0, 0), week.Start)
+	assert.Equal(time.Date(2026, 3, 29, 23, 59, 59, 999999999, suite.berlin), week.End)
+	assert.Equal(GetWeekRange(2026, 13), week)
+
+	// Late on Sunday in UTC is already Monday in Berlin
+	next := GetPeriodRange(PeriodTypeWeek, time.Date(2026, 3, 29, 22, 30, 0, 0, time.UTC))
+	assert.Equal(suite.inBerlin(2026, 3, 30, 0, 0), next.Start)
+}
+
+func (suite *TimezoneTestSuite) TestPeriodRange_MonthBoundary() {
+	assert := assert.New(suite.T())
+
+	// 23:30 UTC on October 31st is half past midnight on November 1st in Berlin
+	reference := time.Date(2026, 10, 31, 23, 30, 0, 0, time.UTC)
+	month := GetPeriodRange(PeriodTypeMonth, reference)
+	assert.Equal(suite.inBerlin(2026, 11, 1, 0, 0), month.Start)
+	assert.True(month.Contains(reference))
+	assert.False(GetMonthRange(2026, time.October).Contains(reference))
+	assert.Equal("2026-11-01", FormatDay(reference))
+}
+
+func (suite *TimezoneTestSuite) TestPeriodRange_YearBoundary() {
+	assert := assert.New(suite.T())
+
+	reference := time.Date(2026, 12, 31, 23, 30, 0, 0, time.UTC)
+	assert.Equal(suite.inBerlin(2027, 1, 1, 0, 0), GetPeriodRange(PeriodTypeYear, reference).Start)
+	assert.Equal(suite.inBerlin(2027, 1, 1, 0, 0), GetPeriodRange(PeriodTypeQuarter, reference).Start)
+	assert.Equal(suite.inBerlin(2027, 1, 1, 0, 0), GetQuarterRange(2027, 1).Start)
+	assert.Equal(suite.inBerlin(2026, 12, 1, 0, 0), ShiftPeriod(PeriodTypeMonth, reference, -1))
+	assert.Equal(suite.inBerlin(2025, 12, 29, 0, 0), GetWeekRange(2026, 1).Start, "ISO week 1 starts in the year before")
+	assert.Equal(suite.inBerlin(2021, 1, 4, 0, 0), GetWeekRange(2021, 1).Start, "January 1st fell in the last week of the year before")
+}
+
+func (suite *TimezoneTestSuite) TestShiftPeriod_DST() {
+	assert := assert.New(suite.T())
+
+	// Stepping across the change lands on midnight, not an hour before or after it
+	assert.Equal(suite.inBerlin(2026, 4, 1, 0, 0), ShiftPeriod(PeriodTypeMonth, suite.inBerlin(2026, 3, 15, 12, 0), 1))
+	assert.Equal(suite.inBerlin(2026, 10, 1, 0, 0), ShiftPeriod(PeriodTypeMonth, suite.inBerlin(2026, 11, 15, 12, 0), -1))
+}
+
+func (suite *TimezoneTestSuite) TestShiftDateRange_DST() {
+	assert := assert.New(suite.T())
+
+	// Ten days before the change to summer time, shifted across it and back again
+	dateRange, err := ParseDateRange("2026-03-20..2026-03-29", DefaultLocale())
+	suite.Require().NoError(err)
+	next := ShiftDateRange(dateRange, 1)
+	assert.Equal(suite.inBerlin(2026, 3, 30, 0, 0), next.Start)
+	assert.Equal(time.Date(2026, 4, 8, 23, 59, 59, 999999999, suite.berlin), next.End)
+	assert.Equal(dateRange, ShiftDateRange(next, -1))
+
+	october := ShiftDateRange(GetMonthRange(2026, time.October), 1)
+	assert.Equal(suite.inBerlin(2026, 11, 1, 0, 0), october.Start)
+	assert.Equal("2026-12-01", FormatDay(october.End), "a range of 31 days")
+}
+
+func (suite *TimezoneTestSuite) TestStartOfDay_DST() {
+	assert := assert.New(suite.T())
+
+	assert.Equal(suite.inBerlin(2026, 3, 29, 0, 0), StartOfDay(suite.inBerlin(2026, 3, 29, 12, 0)))
+	assert.Equal(suite.inBerlin(2026, 10, 25, 0, 0), StartOfDay(time.Date(2026, 10, 25, 22, 59, 0, 0, time.UTC)))
+	assert.Equal(1, daysApart(suite.inBerlin(2026, 3, 30, 0, 0), suite.inBerlin(2026, 3, 29, 0, 0)), "a day of 23 hours")
+	assert.Equal(1, daysApart(time.Date(2026, 10, 31, 23, 30, 0, 0, time.UTC), time.Date(2026, 10, 31, 22, 0, 0, 0, time.UTC)))
+}
+
+func (suite *TimezoneTestSuite) TestParseDateRange() {
+	dateRange, err := ParseDateRange("2026-03-01..2026-03-31", DefaultLocale())
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), GetMonthRange(2026, time.March), dateRange)
+}
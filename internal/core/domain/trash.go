@@ -0,0 +1,21 @@
+package domain
+
+import "time"
+
+// DefaultTrashRetentionDays is how many days deleted transactions stay in the trash before
+// they are purged for good
+const DefaultTrashRetentionDays = 30
+
+// InTrash reports whether the transaction was deleted and can still be restored
+func (t *Transaction) InTrash() bool {
+	return t.DeletedAt != nil
+}
+
+// PurgeDate is when a transaction in the trash is purged for good after retentionDays,
+// the zero time for one that is not in the trash
+func (t *Transaction) PurgeDate(retentionDays int) time.Time {
+	if t.DeletedAt == nil {
+		return time.Time{}
+	}
+	return t.DeletedAt.AddDate(0, 0, retentionDays)
+}
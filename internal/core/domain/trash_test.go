@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type TrashTestSuite struct {
+	suite.Suite
+}
+
+func TestTrashSuite(t *testing.T) {
+	suite.Run(t, new(TrashTestSuite))
+}
+
+func (suite *TrashTestSuite) TestPurgeDate() {
+	assert := assert.New(suite.T())
+
+	transaction := &Transaction{ID: 1, Description: "Coffee", Amount: 3.2, Type: "expense"}
+	assert.False(transaction.InTrash())
+	assert.True(transaction.PurgeDate(DefaultTrashRetentionDays).IsZero())
+
+	deleted := time.Date(2026, 10, 14, 18, 5, 0, 0, time.UTC)
+	transaction.DeletedAt = &deleted
+	assert.True(transaction.InTrash())
+	assert.Equal(time.Date(2026, 11, 13, 18, 5, 0, 0, time.UTC), transaction.PurgeDate(DefaultTrashRetentionDays))
+	assert.Equal(deleted, transaction.PurgeDate(0))
+}
+
+func (suite *TrashTestSuite) TestAuditSnapshotLeavesOutLiveDeletion() {
+	transaction := &Transaction{ID: 1, Description: "Coffee", Amount: 3.2, Type: "expense"}
+	entry, err := NewAuditEntry(AuditTransaction, 1, nil, transaction)
+	suite.Require().NoError(err)
+	assert.NotContains(suite.T(), string(entry.After), "deleted_at")
+}
@@ -0,0 +1,42 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"expense-tracker/internal/core/domain"
+)
+
+type AuditUseCase struct {
+	auditRepo AuditRepository
+}
+
+func NewAuditUseCase(auditRepo AuditRepository) *AuditUseCase {
+	return &AuditUseCase{auditRepo: auditRepo}
+}
+
+// TransactionHistory returns every recorded change of a transaction, newest first
+func (uc *AuditUseCase) TransactionHistory(ctx context.Context, id int) ([]*domain.AuditEntry, error) {
+	if id <= 0 {
+		return nil, fmt.Errorf("invalid transaction id %d", id)
+	}
+
+	entries, err := uc.auditRepo.GetEntityHistory(ctx, domain.AuditTransaction, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transaction history: %w", err)
+	}
+	return entries, nil
+}
+
+// RecentChanges returns the latest changes to transactions and categories, newest first
+func (uc *AuditUseCase) RecentChanges(ctx context.Context, limit int) ([]*domain.AuditEntry, error) {
+	if limit <= 0 {
+		return nil, fmt.Errorf("limit must be positive")
+	}
+
+	entries, err := uc.auditRepo.GetRecentChanges(ctx, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get recent changes: %w", err)
+	}
+	return entries, nil
+}
@@ -0,0 +1,68 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"expense-tracker/internal/core/domain"
+	"expense-tracker/test/mocks"
+)
+
+type AuditUseCaseTestSuite struct {
+	suite.Suite
+	useCase   *AuditUseCase
+	auditRepo *mocks.MockAuditRepository
+	ctx       context.Context
+}
+
+func (suite *AuditUseCaseTestSuite) SetupTest() {
+	suite.auditRepo = mocks.NewMockAuditRepository(suite.T())
+	suite.useCase = NewAuditUseCase(suite.auditRepo)
+	suite.ctx = context.Background()
+}
+
+func TestAuditUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(AuditUseCaseTestSuite))
+}
+
+func (suite *AuditUseCaseTestSuite) TestTransactionHistory() {
+	entries := []*domain.AuditEntry{
+		{ID: 2, Entity: domain.AuditTransaction, EntityID: 5, Action: domain.AuditUpdate},
+		{ID: 1, Entity: domain.AuditTransaction, EntityID: 5, Action: domain.AuditCreate},
+	}
+	suite.auditRepo.On("GetEntityHistory", suite.ctx, domain.AuditTransaction, 5).Return(entries, nil)
+
+	result, err := suite.useCase.TransactionHistory(suite.ctx, 5)
+
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), entries, result)
+}
+
+func (suite *AuditUseCaseTestSuite) TestTransactionHistory_InvalidID() {
+	_, err := suite.useCase.TransactionHistory(suite.ctx, 0)
+
+	assert.EqualError(suite.T(), err, "invalid transaction id 0")
+}
+
+func (suite *AuditUseCaseTestSuite) TestRecentChanges() {
+	entries := []*domain.AuditEntry{{ID: 3, Entity: domain.AuditCategory, EntityID: 12, Action: domain.AuditCreate}}
+	suite.auditRepo.On("GetRecentChanges", suite.ctx, 50).Return(entries, nil)
+
+	result, err := suite.useCase.RecentChanges(suite.ctx, 50)
+
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), entries, result)
+}
+
+func (suite *AuditUseCaseTestSuite) TestRecentChanges_Errors() {
+	_, err := suite.useCase.RecentChanges(suite.ctx, 0)
+	assert.EqualError(suite.T(), err, "limit must be positive")
+
+	suite.auditRepo.On("GetRecentChanges", suite.ctx, 10).Return(nil, errors.New("disk I/O error"))
+	_, err = suite.useCase.RecentChanges(suite.ctx, 10)
+	assert.EqualError(suite.T(), err, "failed to get recent changes: disk I/O error")
+}
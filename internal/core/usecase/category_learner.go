@@ -0,0 +1,65 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"expense-tracker/internal/core/domain"
+)
+
+// CategoryLearner suggests categories with a classifier trained on the categorized
+// transactions in the repository. It trains on first use and afterwards follows the
+// changes the use cases report, so the history is read only once.
+type CategoryLearner struct {
+	transactionRepo TransactionRepository
+	mu              sync.Mutex
+	classifier      *domain.CategoryClassifier // nil until trained
+}
+
+func NewCategoryLearner(transactionRepo TransactionRepository) *CategoryLearner {
+	return &CategoryLearner{transactionRepo: transactionRepo}
+}
+
+// Suggest returns the category the user's history points to, or nil when it has no clear answer
+func (l *CategoryLearner) Suggest(ctx context.Context, description string, amount float64, transactionType string) (*domain.CategorySuggestion, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.classifier == nil {
+		history, err := l.transactionRepo.GetCategoryHistory(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to train category suggestions: %w", err)
+		}
+		l.classifier = domain.NewCategoryClassifier()
+		l.classifier.Learn(history...)
+	}
+	return l.classifier.Suggest(description, amount, transactionType), nil
+}
+
+// Learn adds saved transactions. Before the first suggestion there is nothing to update,
+// since training reads them from the repository anyway.
+func (l *CategoryLearner) Learn(transactions ...*domain.Transaction) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.classifier != nil {
+		l.classifier.Learn(transactions...)
+	}
+}
+
+// Forget removes transactions in the state they were learned in
+func (l *CategoryLearner) Forget(transactions ...*domain.Transaction) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.classifier != nil {
+		l.classifier.Forget(transactions...)
+	}
+}
+
+// Reset drops what was learned so the next suggestion trains again, for changes whose
+// previous state is unknown
+func (l *CategoryLearner) Reset() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.classifier = nil
+}
@@ -0,0 +1,123 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"expense-tracker/internal/core/domain"
+)
+
+type DuplicateUseCase struct {
+	transactionRepo TransactionRepository
+	learner         *CategoryLearner
+	history         *UndoHistory
+	windowDays      int
+}
+
+// NewDuplicateUseCase takes how many days apart duplicates may be dated; 0 or less uses
+// domain.DefaultDuplicateWindowDays
+func NewDuplicateUseCase(transactionRepo TransactionRepository, learner *CategoryLearner, history *UndoHistory, windowDays int) *DuplicateUseCase {
+	if windowDays <= 0 {
+		windowDays = domain.DefaultDuplicateWindowDays
+	}
+	return &DuplicateUseCase{
+		transactionRepo: transactionRepo,
+		learner:         learner,
+		history:         history,
+		windowDays:      windowDays,
+	}
+}
+
+// WindowDays is how many days apart two entries may be dated to be flagged
+func (uc *DuplicateUseCase) WindowDays() int {
+	return uc.windowDays
+}
+
+// FindDuplicatesOf returns the saved transactions a new entry likely duplicates, the most similar first
+func (uc *DuplicateUseCase) FindDuplicatesOf(ctx context.Context, transaction *domain.Transaction) ([]*domain.DuplicatePair, error) {
+	date := transaction.Date
+	if date.IsZero() {
+		date = domain.Now()
+	}
+	candidates, err := uc.transactionRepo.GetByDateRange(ctx, date.AddDate(0, 0, -uc.windowDays-1), date.AddDate(0, 0, uc.windowDays+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transactions: %w", err)
+	}
+	detector, err := uc.detector(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	entry := *transaction
+	entry.Date = date
+	return detector.FindFor(&entry, candidates), nil
+}
+
+// FindDuplicates returns the likely duplicate pairs with at least one transaction in the range, oldest first
+func (uc *DuplicateUseCase) FindDuplicates(ctx context.Context, start, end time.Time) ([]*domain.DuplicatePair, error) {
+	dateRange := domain.NewDateRange(start, end)
+	if err := dateRange.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Pairs can reach over the edges of the range by up to the window
+	transactions, err := uc.transactionRepo.GetByDateRange(ctx, start.AddDate(0, 0, -uc.windowDays-1), end.AddDate(0, 0, uc.windowDays+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transactions: %w", err)
+	}
+	detector, err := uc.detector(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var pairs []*domain.DuplicatePair
+	for _, pair := range detector.FindAmong(transactions) {
+		if dateRange.Contains(pair.Original.Date) || dateRange.Contains(pair.Duplicate.Date) {
+			pairs = append(pairs, pair)
+		}
+	}
+	return pairs, nil
+}
+
+func (uc *DuplicateUseCase) detector(ctx context.Context) (*domain.DuplicateDetector, error) {
+	dismissed, err := uc.transactionRepo.GetDismissedDuplicates(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get dismissed duplicates: %w", err)
+	}
+	return domain.NewDuplicateDetector(uc.windowDays, dismissed), nil
+}
+
+// Merge keeps one transaction of the pair, filled in with what only the other one has,
+// and deletes the other. keepDuplicate keeps the later entry instead of the original.
+func (uc *DuplicateUseCase) Merge(ctx context.Context, pair *domain.DuplicatePair, keepDuplicate bool) (*domain.Transaction, error) {
+	keep, drop := pair.Original, pair.Duplicate
+	if keepDuplicate {
+		keep, drop = drop, keep
+	}
+
+	for _, transaction := range []*domain.Transaction{keep, drop} {
+		if transaction.IsReconciled() {
+			return nil, fmt.Errorf("cannot merge %q: %w", transaction.Description, domain.ErrReconciled)
+		}
+	}
+
+	ids := []int{keep.ID, drop.ID}
+	before, err := uc.history.snapshot(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to merge duplicates: %w", err)
+	}
+	merged := domain.MergeDuplicate(keep, drop)
+	if err := uc.transactionRepo.MergeDuplicate(ctx, merged, drop.ID); err != nil {
+		return nil, fmt.Errorf("failed to merge duplicates: %w", err)
+	}
+	uc.learner.Forget(keep, drop)
+	uc.learner.Learn(merged)
+	uc.history.record(ctx, fmt.Sprintf("Merged %q with its duplicate", merged.Description), before, ids)
+	return merged, nil
+}
+
+// Dismiss marks the pair as not duplicates so it is never flagged again
+func (uc *DuplicateUseCase) Dismiss(ctx context.Context, pair *domain.DuplicatePair) error {
+	return uc.transactionRepo.DismissDuplicate(ctx, pair.Key())
+}
@@ -0,0 +1,136 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"expense-tracker/internal/core/domain"
+	"expense-tracker/test/mocks"
+)
+
+type DuplicateUseCaseTestSuite struct {
+	suite.Suite
+	useCase         *DuplicateUseCase
+	transactionRepo *mocks.MockTransactionRepository
+	ctx             context.Context
+	day             time.Time
+}
+
+func (suite *DuplicateUseCaseTestSuite) SetupTest() {
+	suite.transactionRepo = mocks.NewMockTransactionRepository(suite.T())
+	suite.useCase = NewDuplicateUseCase(suite.transactionRepo, NewCategoryLearner(suite.transactionRepo), nil, 0)
+	suite.ctx = context.Background()
+	suite.day = time.Date(2026, 10, 14, 12, 0, 0, 0, time.UTC)
+}
+
+func TestDuplicateUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(DuplicateUseCaseTestSuite))
+}
+
+func (suite *DuplicateUseCaseTestSuite) expense(id int, description string, amount float64, days int) *domain.Transaction {
+	return &domain.Transaction{ID: id, Description: description, Amount: amount, Type: "expense", Date: suite.day.AddDate(0, 0, days)}
+}
+
+func (suite *DuplicateUseCaseTestSuite) TestWindowDays() {
+	assert.Equal(suite.T(), domain.DefaultDuplicateWindowDays, suite.useCase.WindowDays())
+	assert.Equal(suite.T(), 7, NewDuplicateUseCase(suite.transactionRepo, nil, nil, 7).WindowDays())
+}
+
+func (suite *DuplicateUseCaseTestSuite) TestFindDuplicatesOf() {
+	assert := assert.New(suite.T())
+
+	saved := suite.expense(4, "Lidl Berlin", 23.5, -1)
+	suite.transactionRepo.On("GetByDateRange", suite.ctx, suite.day.AddDate(0, 0, -4), suite.day.AddDate(0, 0, 4)).
+		Return([]*domain.Transaction{saved, suite.expense(5, "Lidl Berlin", 9.99, 0)}, nil)
+	suite.transactionRepo.On("GetDismissedDuplicates", suite.ctx).Return(nil, nil)
+
+	pairs, err := suite.useCase.FindDuplicatesOf(suite.ctx, suite.expense(0, "LIDL Berlin", 23.5, 0))
+
+	suite.Require().NoError(err)
+	suite.Require().Len(pairs, 1)
+	assert.Equal(saved, pairs[0].Original)
+	assert.Equal("LIDL Berlin", pairs[0].Duplicate.Description)
+}
+
+func (suite *DuplicateUseCaseTestSuite) TestFindDuplicates() {
+	assert := assert.New(suite.T())
+
+	start, end := suite.day, suite.day.AddDate(0, 0, 10)
+	transactions := []*domain.Transaction{
+		suite.expense(1, "Netflix", 12.99, -2), // before the range, duplicated inside it
+		suite.expense(2, "Netflix", 12.99, 0),
+		suite.expense(3, "Rent", 900, -4), // both outside the range
+		suite.expense(4, "Rent", 900, -3),
+		suite.expense(5, "Coffee", 3, 5),
+		suite.expense(6, "Coffee", 3, 5),
+	}
+	suite.transactionRepo.On("GetByDateRange", suite.ctx, start.AddDate(0, 0, -4), end.AddDate(0, 0, 4)).Return(transactions, nil)
+	suite.transactionRepo.On("GetDismissedDuplicates", suite.ctx).Return([]domain.DuplicateKey{{First: 5, Second: 6}}, nil)
+
+	pairs, err := suite.useCase.FindDuplicates(suite.ctx, start, end)
+
+	suite.Require().NoError(err)
+	suite.Require().Len(pairs, 1)
+	assert.Equal(domain.NewDuplicateKey(1, 2), pairs[0].Key())
+}
+
+func (suite *DuplicateUseCaseTestSuite) TestFindDuplicates_InvalidRange() {
+	_, err := suite.useCase.FindDuplicates(suite.ctx, suite.day, suite.day.AddDate(0, 0, -1))
+
+	assert.Error(suite.T(), err)
+	suite.transactionRepo.AssertNotCalled(suite.T(), "GetByDateRange", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *DuplicateUseCaseTestSuite) TestFindDuplicates_RepositoryError() {
+	suite.transactionRepo.On("GetByDateRange", suite.ctx, mock.Anything, mock.Anything).Return(nil, errors.New("database error"))
+
+	_, err := suite.useCase.FindDuplicates(suite.ctx, suite.day, suite.day)
+
+	assert.ErrorContains(suite.T(), err, "failed to get transactions")
+}
+
+func (suite *DuplicateUseCaseTestSuite) TestMerge() {
+	assert := assert.New(suite.T())
+
+	original := suite.expense(1, "Lidl Berlin", 23.5, 0)
+	duplicate := suite.expense(2, "LIDL BERLIN 0412", 23.5, 2)
+	duplicate.Tags = []string{"card"}
+	pair := &domain.DuplicatePair{Original: original, Duplicate: duplicate}
+
+	suite.transactionRepo.On("MergeDuplicate", suite.ctx, mock.MatchedBy(func(merged *domain.Transaction) bool {
+		return merged.ID == 1 && len(merged.Tags) == 1
+	}), 2).Return(nil).Once()
+	merged, err := suite.useCase.Merge(suite.ctx, pair, false)
+	suite.Require().NoError(err)
+	assert.Equal("Lidl Berlin", merged.Description)
+
+	suite.transactionRepo.On("MergeDuplicate", suite.ctx, mock.MatchedBy(func(merged *domain.Transaction) bool {
+		return merged.ID == 2
+	}), 1).Return(errors.New("database error")).Once()
+	_, err = suite.useCase.Merge(suite.ctx, pair, true)
+	assert.ErrorContains(err, "failed to merge duplicates")
+}
+
+func (suite *DuplicateUseCaseTestSuite) TestMerge_Reconciled() {
+	original := suite.expense(1, "Lidl Berlin", 23.5, 0)
+	original.Status = domain.StatusReconciled
+	pair := &domain.DuplicatePair{Original: original, Duplicate: suite.expense(2, "LIDL BERLIN 0412", 23.5, 2)}
+
+	_, err := suite.useCase.Merge(suite.ctx, pair, true)
+
+	assert.ErrorIs(suite.T(), err, domain.ErrReconciled)
+	suite.transactionRepo.AssertNotCalled(suite.T(), "MergeDuplicate", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *DuplicateUseCaseTestSuite) TestDismiss() {
+	pair := &domain.DuplicatePair{Original: suite.expense(9, "Coffee", 3, 0), Duplicate: suite.expense(4, "Coffee", 3, 0)}
+	suite.transactionRepo.On("DismissDuplicate", suite.ctx, domain.DuplicateKey{First: 4, Second: 9}).Return(nil)
+
+	assert.NoError(suite.T(), suite.useCase.Dismiss(suite.ctx, pair))
+}
@@ -16,13 +16,51 @@ type TransactionRepository interface {
 	GetTotalByDateRange(ctx context.Context, start, end time.Time, transactionType string) (float64, error)
 	GetRecentTransactions(ctx context.Context, limit int) ([]*domain.Transaction, error)
 	SearchTransactions(ctx context.Context, query string, offset, limit int) ([]*domain.Transaction, error)
+	GetPage(ctx context.Context, request *domain.PageRequest) (*domain.TransactionPage, error)
 	Update(ctx context.Context, transaction *domain.Transaction) error
 	Delete(ctx context.Context, id int) error
+
+	// Batch methods, each executed inside a single SQL transaction
+	GetByIDs(ctx context.Context, ids []int) ([]*domain.Transaction, error)
+	BulkDelete(ctx context.Context, ids []int) (int, error)
+	BulkUpdateCategory(ctx context.Context, ids []int, categoryID int) (int, error)
+	BulkSetTags(ctx context.Context, ids []int, tags []string) (int, error)
+	BulkShiftDate(ctx context.Context, ids []int, days int) (int, error)
+	BulkRestore(ctx context.Context, transactions []*domain.Transaction) error
 	
-	// Enhanced analytics methods
+	// Enhanced analytics methods; totals and counts leave out transfers
 	GetCategoryTotalsByDateRange(ctx context.Context, start, end time.Time, transactionType string) ([]*domain.CategoryBreakdown, error)
 	GetTransactionCountByDateRange(ctx context.Context, start, end time.Time, transactionType string) (int, error)
 	GetCategoryTransactionCount(ctx context.Context, start, end time.Time, categoryID int, transactionType string) (int, error)
+
+	// History methods
+	GetDescriptionSuggestions(ctx context.Context, prefix, transactionType string, limit int) ([]*domain.DescriptionSuggestion, error)
+	GetRecentCategoryIDs(ctx context.Context, transactionType string, limit int) ([]int, error)
+	GetCategoryHistory(ctx context.Context) ([]*domain.Transaction, error)
+
+	// Payee methods
+	GetPayeeTotalsByDateRange(ctx context.Context, start, end time.Time, transactionType string) ([]*domain.PayeeBreakdown, error)
+	GetDescriptionsWithoutPayee(ctx context.Context) ([]string, error)
+	AssignPayee(ctx context.Context, description string, payeeID int) (int, error)
+
+	// Duplicate methods
+	GetDismissedDuplicates(ctx context.Context) ([]domain.DuplicateKey, error)
+	DismissDuplicate(ctx context.Context, key domain.DuplicateKey) error
+	MergeDuplicate(ctx context.Context, merged *domain.Transaction, duplicateID int) error
+
+	// Reconciliation methods; Update, Delete, MergeDuplicate and the batch methods other
+	// than BulkRestore refuse reconciled transactions with domain.ErrReconciled
+	GetUnreconciled(ctx context.Context, end time.Time) ([]*domain.Transaction, error)
+	SetStatus(ctx context.Context, ids []int, status domain.TransactionStatus) (int, error)
+	GetLastStatement(ctx context.Context) (*domain.Statement, error)
+	Reconcile(ctx context.Context, statement *domain.Statement, ids []int) error
+
+	// Trash methods; Delete, BulkDelete and MergeDuplicate move transactions to the trash
+	// and every other method leaves trashed transactions out
+	GetTrash(ctx context.Context) ([]*domain.Transaction, error)
+	RestoreDeleted(ctx context.Context, ids []int) (int, error)
+	Purge(ctx context.Context, ids []int) (int, error)
+	PurgeDeletedBefore(ctx context.Context, before time.Time) ([]int, error)
 }
 
 type CategoryRepository interface {
@@ -30,3 +68,37 @@ type CategoryRepository interface {
 	GetCategories(ctx context.Context, categoryType string) ([]*domain.Category, error)
 	GetCategoryByID(ctx context.Context, id int, categoryType string) (*domain.Category, error)
 }
+
+type PayeeRepository interface {
+	CreatePayee(ctx context.Context, payee *domain.Payee) error
+	GetPayees(ctx context.Context) ([]*domain.Payee, error)
+	UpdatePayee(ctx context.Context, payee *domain.Payee) error
+}
+
+type RuleRepository interface {
+	CreateRule(ctx context.Context, rule *domain.Rule) error
+	GetRules(ctx context.Context) ([]*domain.Rule, error)
+	UpdateRule(ctx context.Context, rule *domain.Rule) error
+	DeleteRule(ctx context.Context, id int) error
+	ReorderRules(ctx context.Context, ids []int) error
+}
+
+type AuditRepository interface {
+	GetEntityHistory(ctx context.Context, entity string, id int) ([]*domain.AuditEntry, error)
+	GetRecentChanges(ctx context.Context, limit int) ([]*domain.AuditEntry, error)
+}
+
+// Repositories are the repositories a unit of work hands to its function, all sharing its
+// SQL transaction
+type Repositories struct {
+	Transactions TransactionRepository
+	Categories   CategoryRepository
+	Payees       PayeeRepository
+	Rules        RuleRepository
+}
+
+// UnitOfWork makes the steps of a use case all-or-nothing. WithTx commits what fn did through
+// repos when it returns nil, and rolls everything back when it returns an error or panics.
+type UnitOfWork interface {
+	WithTx(ctx context.Context, fn func(repos Repositories) error) error
+}
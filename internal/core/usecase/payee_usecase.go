@@ -0,0 +1,114 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"expense-tracker/internal/core/domain"
+)
+
+type PayeeUseCase struct {
+	payeeRepo       PayeeRepository
+	transactionRepo TransactionRepository
+	uow             UnitOfWork
+}
+
+func NewPayeeUseCase(payeeRepo PayeeRepository, transactionRepo TransactionRepository, uow UnitOfWork) *PayeeUseCase {
+	return &PayeeUseCase{
+		payeeRepo:       payeeRepo,
+		transactionRepo: transactionRepo,
+		uow:             uow,
+	}
+}
+
+func (uc *PayeeUseCase) GetPayees(ctx context.Context) ([]*domain.Payee, error) {
+	return uc.payeeRepo.GetPayees(ctx)
+}
+
+// SavePayee creates a payee, or replaces the aliases of the payee that already has its name
+// in any letter case
+func (uc *PayeeUseCase) SavePayee(ctx context.Context, payee *domain.Payee) error {
+	return uc.uow.WithTx(ctx, func(repos Repositories) error {
+		return savePayee(ctx, repos.Payees, payee)
+	})
+}
+
+// savePayee saves a payee through the given repository, so it can run in a unit of work
+func savePayee(ctx context.Context, payeeRepo PayeeRepository, payee *domain.Payee) error {
+	payee.Name = strings.TrimSpace(payee.Name)
+	if err := payee.Validate(); err != nil {
+		return err
+	}
+
+	payees, err := payeeRepo.GetPayees(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get payees: %w", err)
+	}
+	for _, existing := range payees {
+		if strings.EqualFold(existing.Name, payee.Name) {
+			payee.ID = existing.ID
+			return payeeRepo.UpdatePayee(ctx, payee)
+		}
+	}
+	return payeeRepo.CreatePayee(ctx, payee)
+}
+
+// ImportPayees saves payees given as a map of names to alias patterns, in name order. Either
+// all of them are saved or, when one is invalid, none.
+func (uc *PayeeUseCase) ImportPayees(ctx context.Context, aliases map[string][]string) error {
+	names := make([]string, 0, len(aliases))
+	for name := range aliases {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return uc.uow.WithTx(ctx, func(repos Repositories) error {
+		for _, name := range names {
+			if err := savePayee(ctx, repos.Payees, &domain.Payee{Name: name, Aliases: aliases[name]}); err != nil {
+				return fmt.Errorf("invalid payee %q: %w", name, err)
+			}
+		}
+		return nil
+	})
+}
+
+// NormalizePayees attaches a payee to every transaction without one whose description matches
+// a payee name or alias, and returns how many transactions were updated. Nothing is updated
+// when one of the transactions fails.
+func (uc *PayeeUseCase) NormalizePayees(ctx context.Context) (int, error) {
+	updated := 0
+	err := uc.uow.WithTx(ctx, func(repos Repositories) error {
+		payees, err := repos.Payees.GetPayees(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get payees: %w", err)
+		}
+		if len(payees) == 0 {
+			return nil
+		}
+
+		descriptions, err := repos.Transactions.GetDescriptionsWithoutPayee(ctx)
+		if err != nil {
+			return err
+		}
+
+		matcher := domain.NewPayeeMatcher(payees)
+		for _, description := range descriptions {
+			payee := matcher.Match(description)
+			if payee == nil {
+				continue
+			}
+			count, err := repos.Transactions.AssignPayee(ctx, description, payee.ID)
+			if err != nil {
+				return err
+			}
+			updated += count
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return updated, nil
+}
@@ -0,0 +1,119 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"expense-tracker/internal/core/domain"
+	"expense-tracker/test/mocks"
+)
+
+type PayeeUseCaseTestSuite struct {
+	suite.Suite
+	useCase         *PayeeUseCase
+	payeeRepo       *mocks.MockPayeeRepository
+	transactionRepo *mocks.MockTransactionRepository
+	ctx             context.Context
+}
+
+func (suite *PayeeUseCaseTestSuite) SetupTest() {
+	suite.payeeRepo = mocks.NewMockPayeeRepository(suite.T())
+	suite.transactionRepo = mocks.NewMockTransactionRepository(suite.T())
+	suite.useCase = NewPayeeUseCase(suite.payeeRepo, suite.transactionRepo, passThroughUnitOfWork{Transactions: suite.transactionRepo, Payees: suite.payeeRepo})
+	suite.ctx = context.Background()
+}
+
+func TestPayeeUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(PayeeUseCaseTestSuite))
+}
+
+func (suite *PayeeUseCaseTestSuite) TestSavePayee_Creates() {
+	assert := assert.New(suite.T())
+
+	payee := &domain.Payee{Name: "  Amazon ", Aliases: []string{"amzn*"}}
+	suite.payeeRepo.On("GetPayees", suite.ctx).Return([]*domain.Payee{{ID: 1, Name: "Netflix"}}, nil)
+	suite.payeeRepo.On("CreatePayee", suite.ctx, payee).Return(nil)
+
+	err := suite.useCase.SavePayee(suite.ctx, payee)
+
+	assert.NoError(err)
+	assert.Equal("Amazon", payee.Name)
+}
+
+func (suite *PayeeUseCaseTestSuite) TestSavePayee_UpdatesExistingName() {
+	assert := assert.New(suite.T())
+
+	payee := &domain.Payee{Name: "amazon", Aliases: []string{"amzn*"}}
+	suite.payeeRepo.On("GetPayees", suite.ctx).Return([]*domain.Payee{{ID: 7, Name: "Amazon"}}, nil)
+	suite.payeeRepo.On("UpdatePayee", suite.ctx, payee).Return(nil)
+
+	err := suite.useCase.SavePayee(suite.ctx, payee)
+
+	assert.NoError(err)
+	assert.Equal(7, payee.ID)
+	suite.payeeRepo.AssertNotCalled(suite.T(), "CreatePayee", mock.Anything, mock.Anything)
+}
+
+func (suite *PayeeUseCaseTestSuite) TestSavePayee_WildcardOnlyAlias() {
+	assert := assert.New(suite.T())
+
+	err := suite.useCase.SavePayee(suite.ctx, &domain.Payee{Name: "Amazon", Aliases: []string{"*"}})
+
+	assert.EqualError(err, `payee alias "*" must contain more than wildcards`)
+}
+
+func (suite *PayeeUseCaseTestSuite) TestImportPayees_ReportsInvalidPayee() {
+	assert := assert.New(suite.T())
+
+	err := suite.useCase.ImportPayees(suite.ctx, map[string][]string{" ": {"shop"}})
+
+	assert.EqualError(err, `invalid payee " ": payee name cannot be empty`)
+}
+
+func (suite *PayeeUseCaseTestSuite) TestNormalizePayees() {
+	assert := assert.New(suite.T())
+
+	payees := []*domain.Payee{
+		{ID: 1, Name: "Amazon", Aliases: []string{"amzn mktp*"}},
+		{ID: 2, Name: "Netflix"},
+	}
+	suite.payeeRepo.On("GetPayees", suite.ctx).Return(payees, nil)
+	suite.transactionRepo.On("GetDescriptionsWithoutPayee", suite.ctx).Return([]string{"AMZN MKTP UK", "netflix", "Rent"}, nil)
+	suite.transactionRepo.On("AssignPayee", suite.ctx, "AMZN MKTP UK", 1).Return(3, nil)
+	suite.transactionRepo.On("AssignPayee", suite.ctx, "netflix", 2).Return(1, nil)
+
+	updated, err := suite.useCase.NormalizePayees(suite.ctx)
+
+	assert.NoError(err)
+	assert.Equal(4, updated)
+	suite.transactionRepo.AssertNotCalled(suite.T(), "AssignPayee", suite.ctx, "Rent", mock.Anything)
+}
+
+func (suite *PayeeUseCaseTestSuite) TestNormalizePayees_NoPayees() {
+	assert := assert.New(suite.T())
+
+	suite.payeeRepo.On("GetPayees", suite.ctx).Return(nil, nil)
+
+	updated, err := suite.useCase.NormalizePayees(suite.ctx)
+
+	assert.NoError(err)
+	assert.Zero(updated)
+	suite.transactionRepo.AssertNotCalled(suite.T(), "GetDescriptionsWithoutPayee", mock.Anything)
+}
+
+func (suite *PayeeUseCaseTestSuite) TestNormalizePayees_AssignError() {
+	assert := assert.New(suite.T())
+
+	suite.payeeRepo.On("GetPayees", suite.ctx).Return([]*domain.Payee{{ID: 2, Name: "Netflix"}}, nil)
+	suite.transactionRepo.On("GetDescriptionsWithoutPayee", suite.ctx).Return([]string{"Netflix"}, nil)
+	suite.transactionRepo.On("AssignPayee", suite.ctx, "Netflix", 2).Return(0, errors.New("database error"))
+
+	_, err := suite.useCase.NormalizePayees(suite.ctx)
+
+	assert.EqualError(err, "database error")
+}
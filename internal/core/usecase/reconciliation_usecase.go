@@ -0,0 +1,113 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"expense-tracker/internal/core/domain"
+)
+
+type ReconciliationUseCase struct {
+	transactionRepo TransactionRepository
+}
+
+func NewReconciliationUseCase(transactionRepo TransactionRepository) *ReconciliationUseCase {
+	return &ReconciliationUseCase{transactionRepo: transactionRepo}
+}
+
+// LastStatement returns the most recently reconciled statement, nil before the first reconciliation
+func (uc *ReconciliationUseCase) LastStatement(ctx context.Context) (*domain.Statement, error) {
+	statement, err := uc.transactionRepo.GetLastStatement(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get last statement: %w", err)
+	}
+	return statement, nil
+}
+
+// Start begins reconciling the statement ending on date. The opening balance is the closing
+// balance of the last reconciled statement; openingBalance is only used for the first one.
+func (uc *ReconciliationUseCase) Start(ctx context.Context, date time.Time, closingBalance, openingBalance float64) (*domain.Reconciliation, error) {
+	if date.IsZero() {
+		return nil, fmt.Errorf("statement date is required")
+	}
+	date = domain.StartOfDay(date)
+
+	last, err := uc.LastStatement(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if last != nil {
+		if !date.After(last.Date) {
+			return nil, fmt.Errorf("statement date must be after the last reconciled statement (%s)", last.Date.Format("2006-01-02"))
+		}
+		openingBalance = last.ClosingBalance
+	}
+
+	transactions, err := uc.transactionRepo.GetUnreconciled(ctx, date.AddDate(0, 0, 1).Add(-time.Nanosecond))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transactions: %w", err)
+	}
+
+	return &domain.Reconciliation{
+		Statement: &domain.Statement{
+			Date:           date,
+			OpeningBalance: openingBalance,
+			ClosingBalance: closingBalance,
+		},
+		Transactions: transactions,
+	}, nil
+}
+
+// SetCleared ticks transactions of the reconciliation off as cleared, or takes them back.
+// The status is saved right away, so leaving a reconciliation halfway loses nothing.
+func (uc *ReconciliationUseCase) SetCleared(ctx context.Context, reconciliation *domain.Reconciliation, ids []int, cleared bool) error {
+	status := domain.StatusUncleared
+	if cleared {
+		status = domain.StatusCleared
+	}
+
+	wanted := make(map[int]bool, len(ids))
+	for _, id := range ids {
+		wanted[id] = true
+	}
+	var changed []*domain.Transaction
+	for _, transaction := range reconciliation.Transactions {
+		if wanted[transaction.ID] && transaction.Status != status {
+			changed = append(changed, transaction)
+		}
+	}
+	if len(changed) == 0 {
+		return nil
+	}
+
+	changedIDs := make([]int, len(changed))
+	for i, transaction := range changed {
+		changedIDs[i] = transaction.ID
+	}
+	if _, err := uc.transactionRepo.SetStatus(ctx, changedIDs, status); err != nil {
+		return fmt.Errorf("failed to update cleared status: %w", err)
+	}
+	for _, transaction := range changed {
+		transaction.Status = status
+	}
+	return nil
+}
+
+// Finish locks the cleared transactions as reconciled once they add up to the closing balance
+func (uc *ReconciliationUseCase) Finish(ctx context.Context, reconciliation *domain.Reconciliation) error {
+	if !reconciliation.IsBalanced() {
+		return fmt.Errorf("cleared transactions are %.2f off the closing balance", reconciliation.Difference())
+	}
+
+	reconciliation.Statement.ReconciledAt = time.Now()
+	if err := uc.transactionRepo.Reconcile(ctx, reconciliation.Statement, reconciliation.ClearedIDs()); err != nil {
+		return fmt.Errorf("failed to reconcile: %w", err)
+	}
+	for _, transaction := range reconciliation.Transactions {
+		if transaction.IsCleared() {
+			transaction.Status = domain.StatusReconciled
+		}
+	}
+	return nil
+}
@@ -0,0 +1,132 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"expense-tracker/internal/core/domain"
+	"expense-tracker/test/mocks"
+)
+
+type ReconciliationUseCaseTestSuite struct {
+	suite.Suite
+	useCase         *ReconciliationUseCase
+	transactionRepo *mocks.MockTransactionRepository
+	ctx             context.Context
+	date            time.Time
+}
+
+func (suite *ReconciliationUseCaseTestSuite) SetupTest() {
+	suite.transactionRepo = mocks.NewMockTransactionRepository(suite.T())
+	suite.useCase = NewReconciliationUseCase(suite.transactionRepo)
+	suite.ctx = context.Background()
+	suite.date = time.Date(2026, 9, 30, 0, 0, 0, 0, time.UTC)
+	domain.UseTimezone(time.UTC)
+}
+
+func (suite *ReconciliationUseCaseTestSuite) TearDownTest() {
+	domain.UseTimezone(nil)
+}
+
+func TestReconciliationUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(ReconciliationUseCaseTestSuite))
+}
+
+func (suite *ReconciliationUseCaseTestSuite) transactions() []*domain.Transaction {
+	return []*domain.Transaction{
+		{ID: 1, Description: "Salary", Amount: 2500, Type: "income", Date: suite.date.AddDate(0, 0, -29)},
+		{ID: 2, Description: "Rent", Amount: 900, Type: "expense", Date: suite.date.AddDate(0, 0, -28), Status: domain.StatusCleared},
+	}
+}
+
+func (suite *ReconciliationUseCaseTestSuite) TestStart_First() {
+	assert := assert.New(suite.T())
+
+	suite.transactionRepo.On("GetLastStatement", suite.ctx).Return(nil, nil)
+	suite.transactionRepo.On("GetUnreconciled", suite.ctx, suite.date.AddDate(0, 0, 1).Add(-time.Nanosecond)).Return(suite.transactions(), nil)
+
+	reconciliation, err := suite.useCase.Start(suite.ctx, suite.date.Add(15*time.Hour), 1700, 100)
+
+	suite.Require().NoError(err)
+	assert.Equal(suite.date, reconciliation.Statement.Date, "the statement covers the whole day")
+	assert.Equal(100.0, reconciliation.Statement.OpeningBalance)
+	assert.Len(reconciliation.Transactions, 2)
+	assert.Equal(2500.0, reconciliation.Difference(), "ticked off earlier, the rent is already cleared")
+}
+
+func (suite *ReconciliationUseCaseTestSuite) TestStart_OpensAtLastClosingBalance() {
+	suite.transactionRepo.On("GetLastStatement", suite.ctx).Return(&domain.Statement{ID: 1, Date: suite.date.AddDate(0, -1, 0), ClosingBalance: 250}, nil)
+	suite.transactionRepo.On("GetUnreconciled", suite.ctx, mock.Anything).Return(nil, nil)
+
+	reconciliation, err := suite.useCase.Start(suite.ctx, suite.date, 1700, 100)
+
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), 250.0, reconciliation.Statement.OpeningBalance)
+}
+
+func (suite *ReconciliationUseCaseTestSuite) TestStart_NotAfterLastStatement() {
+	suite.transactionRepo.On("GetLastStatement", suite.ctx).Return(&domain.Statement{ID: 1, Date: suite.date, ClosingBalance: 250}, nil)
+
+	_, err := suite.useCase.Start(suite.ctx, suite.date, 1700, 0)
+
+	assert.EqualError(suite.T(), err, "statement date must be after the last reconciled statement (2026-09-30)")
+	suite.transactionRepo.AssertNotCalled(suite.T(), "GetUnreconciled", mock.Anything, mock.Anything)
+}
+
+func (suite *ReconciliationUseCaseTestSuite) TestStart_RepositoryError() {
+	suite.transactionRepo.On("GetLastStatement", suite.ctx).Return(nil, errors.New("database error"))
+
+	_, err := suite.useCase.Start(suite.ctx, suite.date, 1700, 0)
+
+	assert.ErrorContains(suite.T(), err, "failed to get last statement")
+}
+
+func (suite *ReconciliationUseCaseTestSuite) TestSetCleared() {
+	assert := assert.New(suite.T())
+
+	reconciliation := &domain.Reconciliation{Statement: &domain.Statement{Date: suite.date}, Transactions: suite.transactions()}
+	suite.transactionRepo.On("SetStatus", suite.ctx, []int{1}, domain.StatusCleared).Return(1, nil).Once()
+
+	suite.Require().NoError(suite.useCase.SetCleared(suite.ctx, reconciliation, []int{1, 2}, true), "only what changes is saved")
+	assert.Equal(domain.StatusCleared, reconciliation.Transactions[0].Status)
+
+	suite.Require().NoError(suite.useCase.SetCleared(suite.ctx, reconciliation, []int{7}, true), "unknown ids are ignored")
+
+	suite.transactionRepo.On("SetStatus", suite.ctx, []int{2}, domain.StatusUncleared).Return(0, errors.New("database error")).Once()
+	err := suite.useCase.SetCleared(suite.ctx, reconciliation, []int{2}, false)
+	assert.ErrorContains(err, "failed to update cleared status")
+	assert.Equal(domain.StatusCleared, reconciliation.Transactions[1].Status, "left as it was")
+}
+
+func (suite *ReconciliationUseCaseTestSuite) TestFinish() {
+	assert := assert.New(suite.T())
+
+	reconciliation := &domain.Reconciliation{
+		Statement:    &domain.Statement{Date: suite.date, OpeningBalance: 100, ClosingBalance: -800},
+		Transactions: suite.transactions(),
+	}
+	suite.transactionRepo.On("Reconcile", suite.ctx, reconciliation.Statement, []int{2}).Return(nil)
+
+	suite.Require().NoError(suite.useCase.Finish(suite.ctx, reconciliation))
+	assert.False(reconciliation.Statement.ReconciledAt.IsZero())
+	assert.Equal(domain.StatusReconciled, reconciliation.Transactions[1].Status)
+	assert.Equal(domain.StatusUncleared, reconciliation.Transactions[0].Status)
+}
+
+func (suite *ReconciliationUseCaseTestSuite) TestFinish_NotBalanced() {
+	reconciliation := &domain.Reconciliation{
+		Statement:    &domain.Statement{Date: suite.date, OpeningBalance: 100, ClosingBalance: 1700},
+		Transactions: suite.transactions(),
+	}
+
+	err := suite.useCase.Finish(suite.ctx, reconciliation)
+
+	assert.EqualError(suite.T(), err, "cleared transactions are 2500.00 off the closing balance")
+	suite.transactionRepo.AssertNotCalled(suite.T(), "Reconcile", mock.Anything, mock.Anything, mock.Anything)
+}
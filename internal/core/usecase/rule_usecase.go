@@ -0,0 +1,173 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"expense-tracker/internal/core/domain"
+)
+
+type RuleUseCase struct {
+	ruleRepo        RuleRepository
+	transactionRepo TransactionRepository
+	categoryRepo    CategoryRepository
+	payeeRepo       PayeeRepository
+	learner         *CategoryLearner
+	history         *UndoHistory
+}
+
+func NewRuleUseCase(ruleRepo RuleRepository, transactionRepo TransactionRepository, categoryRepo CategoryRepository, payeeRepo PayeeRepository, learner *CategoryLearner, history *UndoHistory) *RuleUseCase {
+	return &RuleUseCase{
+		ruleRepo:        ruleRepo,
+		transactionRepo: transactionRepo,
+		categoryRepo:    categoryRepo,
+		payeeRepo:       payeeRepo,
+		learner:         learner,
+		history:         history,
+	}
+}
+
+func (uc *RuleUseCase) GetRules(ctx context.Context) ([]*domain.Rule, error) {
+	return uc.ruleRepo.GetRules(ctx)
+}
+
+// ParseRule reads a rule typed in the rule syntax, resolving category and payee names
+func (uc *RuleUseCase) ParseRule(ctx context.Context, input string, locale *domain.Locale) (*domain.Rule, error) {
+	expense, err := uc.categoryRepo.GetCategories(ctx, "expense")
+	if err != nil {
+		return nil, err
+	}
+	income, err := uc.categoryRepo.GetCategories(ctx, "income")
+	if err != nil {
+		return nil, err
+	}
+	payees, err := uc.payeeRepo.GetPayees(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get payees: %w", err)
+	}
+
+	return domain.ParseRule(input, &domain.RuleLookup{
+		Locale:            locale,
+		ExpenseCategories: expense,
+		IncomeCategories:  income,
+		Payees:            payees,
+	})
+}
+
+// SaveRule creates a rule after the existing ones, or updates it when it has an ID
+func (uc *RuleUseCase) SaveRule(ctx context.Context, rule *domain.Rule) error {
+	if err := rule.Validate(); err != nil {
+		return err
+	}
+	if rule.ID > 0 {
+		return uc.ruleRepo.UpdateRule(ctx, rule)
+	}
+	return uc.ruleRepo.CreateRule(ctx, rule)
+}
+
+func (uc *RuleUseCase) DeleteRule(ctx context.Context, id int) error {
+	if id <= 0 {
+		return fmt.Errorf("invalid rule ID")
+	}
+	return uc.ruleRepo.DeleteRule(ctx, id)
+}
+
+// MoveRule moves a rule up (negative offset) or down the running order and returns the
+// rules in their new order. Moves past either end stop there.
+func (uc *RuleUseCase) MoveRule(ctx context.Context, id, offset int) ([]*domain.Rule, error) {
+	rules, err := uc.ruleRepo.GetRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	from := -1
+	for i, rule := range rules {
+		if rule.ID == id {
+			from = i
+			break
+		}
+	}
+	if from < 0 {
+		return nil, fmt.Errorf("rule with id %d not found", id)
+	}
+	to := min(max(from+offset, 0), len(rules)-1)
+	if to == from {
+		return rules, nil
+	}
+
+	moved := rules[from]
+	rules = append(rules[:from], rules[from+1:]...)
+	rules = append(rules[:to], append([]*domain.Rule{moved}, rules[to:]...)...)
+
+	ids := make([]int, len(rules))
+	for i, rule := range rules {
+		ids[i] = rule.ID
+		rule.Position = i
+	}
+	if err := uc.ruleRepo.ReorderRules(ctx, ids); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+// PreviewRules works out what re-applying the rules would change in the transactions of
+// the date range without saving anything
+func (uc *RuleUseCase) PreviewRules(ctx context.Context, start, end time.Time) ([]*domain.RuleChange, error) {
+	if start.After(end) {
+		return nil, fmt.Errorf("start date cannot be after end date")
+	}
+
+	rules, err := uc.ruleRepo.GetRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(rules) == 0 {
+		return nil, nil
+	}
+
+	transactions, err := uc.transactionRepo.GetByDateRange(ctx, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	// Reconciled transactions are locked, rules leave them as they are
+	var unlocked []*domain.Transaction
+	for _, transaction := range transactions {
+		if !transaction.IsReconciled() {
+			unlocked = append(unlocked, transaction)
+		}
+	}
+	return domain.PreviewRules(rules, unlocked), nil
+}
+
+// ApplyRuleChanges saves previewed changes in a single SQL transaction
+func (uc *RuleUseCase) ApplyRuleChanges(ctx context.Context, changes []*domain.RuleChange) error {
+	if len(changes) == 0 {
+		return nil
+	}
+
+	transactions := make([]*domain.Transaction, len(changes))
+	ids := make([]int, len(changes))
+	for i, change := range changes {
+		if err := change.After.Validate(); err != nil {
+			return fmt.Errorf("transaction %d: %w", change.After.ID, err)
+		}
+		transactions[i] = change.After
+		ids[i] = change.After.ID
+	}
+	before, err := uc.history.snapshot(ctx, ids)
+	if err != nil {
+		return err
+	}
+	if err := uc.transactionRepo.BulkRestore(ctx, transactions); err != nil {
+		return err
+	}
+
+	for _, change := range changes {
+		uc.learner.Forget(change.Before)
+		uc.learner.Learn(change.After)
+	}
+	uc.history.record(ctx, "Applied rules to "+countTransactions(len(changes)), before, ids)
+	return nil
+}
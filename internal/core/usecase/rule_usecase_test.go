@@ -0,0 +1,197 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"expense-tracker/internal/core/domain"
+	"expense-tracker/test/mocks"
+)
+
+type RuleUseCaseTestSuite struct {
+	suite.Suite
+	useCase         *RuleUseCase
+	ruleRepo        *mocks.MockRuleRepository
+	transactionRepo *mocks.MockTransactionRepository
+	categoryRepo    *mocks.MockCategoryRepository
+	payeeRepo       *mocks.MockPayeeRepository
+	ctx             context.Context
+}
+
+func (suite *RuleUseCaseTestSuite) SetupTest() {
+	suite.ruleRepo = mocks.NewMockRuleRepository(suite.T())
+	suite.transactionRepo = mocks.NewMockTransactionRepository(suite.T())
+	suite.categoryRepo = mocks.NewMockCategoryRepository(suite.T())
+	suite.payeeRepo = mocks.NewMockPayeeRepository(suite.T())
+	suite.useCase = NewRuleUseCase(suite.ruleRepo, suite.transactionRepo, suite.categoryRepo, suite.payeeRepo, NewCategoryLearner(suite.transactionRepo), nil)
+	suite.ctx = context.Background()
+}
+
+func TestRuleUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(RuleUseCaseTestSuite))
+}
+
+// threeRules returns rules with IDs 1, 2 and 3 in that order
+func threeRules() []*domain.Rule {
+	rules := make([]*domain.Rule, 3)
+	for i := range rules {
+		rules[i] = &domain.Rule{ID: i + 1, Position: i}
+	}
+	return rules
+}
+
+func (suite *RuleUseCaseTestSuite) TestParseRule() {
+	assert := assert.New(suite.T())
+
+	shopping := &domain.Category{ID: 3, Name: "Shopping"}
+	amazon := &domain.Payee{ID: 4, Name: "Amazon"}
+	suite.categoryRepo.On("GetCategories", suite.ctx, "expense").Return([]*domain.Category{shopping}, nil)
+	suite.categoryRepo.On("GetCategories", suite.ctx, "income").Return([]*domain.Category{{ID: 8, Name: "Salary"}}, nil)
+	suite.payeeRepo.On("GetPayees", suite.ctx).Return([]*domain.Payee{amazon}, nil)
+
+	rule, err := suite.useCase.ParseRule(suite.ctx, "contains:amzn then category:shopping payee:amazon", domain.DefaultLocale())
+
+	suite.Require().NoError(err)
+	assert.Equal("expense", rule.Conditions.Type)
+	assert.Equal(shopping, rule.Actions.Category)
+	assert.Equal(amazon, rule.Actions.Payee)
+}
+
+func (suite *RuleUseCaseTestSuite) TestSaveRule() {
+	assert := assert.New(suite.T())
+
+	rule := &domain.Rule{
+		Conditions: domain.RuleConditions{DescriptionContains: "savings"},
+		Actions:    domain.RuleActions{Transfer: true},
+	}
+	suite.ruleRepo.On("CreateRule", suite.ctx, rule).Return(nil)
+
+	assert.NoError(suite.useCase.SaveRule(suite.ctx, rule))
+
+	rule.ID = 5
+	suite.ruleRepo.On("UpdateRule", suite.ctx, rule).Return(nil)
+
+	assert.NoError(suite.useCase.SaveRule(suite.ctx, rule))
+}
+
+func (suite *RuleUseCaseTestSuite) TestSaveRule_Invalid() {
+	err := suite.useCase.SaveRule(suite.ctx, &domain.Rule{Actions: domain.RuleActions{Transfer: true}})
+
+	assert.EqualError(suite.T(), err, "a rule needs at least one condition")
+}
+
+func (suite *RuleUseCaseTestSuite) TestMoveRule() {
+	assert := assert.New(suite.T())
+
+	suite.ruleRepo.On("GetRules", suite.ctx).Return(threeRules(), nil)
+	suite.ruleRepo.On("ReorderRules", suite.ctx, []int{1, 3, 2}).Return(nil)
+
+	rules, err := suite.useCase.MoveRule(suite.ctx, 3, -1)
+
+	suite.Require().NoError(err)
+	suite.Require().Len(rules, 3)
+	assert.Equal(3, rules[1].ID)
+	assert.Equal(1, rules[1].Position)
+}
+
+func (suite *RuleUseCaseTestSuite) TestMoveRule_StopsAtTheEnds() {
+	assert := assert.New(suite.T())
+
+	suite.ruleRepo.On("GetRules", suite.ctx).Return(threeRules(), nil)
+
+	rules, err := suite.useCase.MoveRule(suite.ctx, 1, -1)
+
+	assert.NoError(err)
+	assert.Equal(1, rules[0].ID)
+	suite.ruleRepo.AssertNotCalled(suite.T(), "ReorderRules", mock.Anything, mock.Anything)
+}
+
+func (suite *RuleUseCaseTestSuite) TestMoveRule_NotFound() {
+	suite.ruleRepo.On("GetRules", suite.ctx).Return(threeRules(), nil)
+
+	_, err := suite.useCase.MoveRule(suite.ctx, 9, 1)
+
+	assert.EqualError(suite.T(), err, "rule with id 9 not found")
+}
+
+func (suite *RuleUseCaseTestSuite) TestPreviewAndApply() {
+	assert := assert.New(suite.T())
+
+	start := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 6, 30, 23, 59, 59, 0, time.UTC)
+	rules := []*domain.Rule{{
+		ID:         1,
+		Conditions: domain.RuleConditions{DescriptionContains: "savings"},
+		Actions:    domain.RuleActions{Transfer: true},
+	}}
+	savings := &domain.Transaction{ID: 1, Description: "To savings", Amount: 200, Type: "expense", Date: start}
+	groceries := &domain.Transaction{ID: 2, Description: "Lidl", Amount: 30, Type: "expense", Date: start}
+	suite.ruleRepo.On("GetRules", suite.ctx).Return(rules, nil)
+	suite.transactionRepo.On("GetByDateRange", suite.ctx, start, end).Return([]*domain.Transaction{savings, groceries}, nil)
+
+	changes, err := suite.useCase.PreviewRules(suite.ctx, start, end)
+
+	suite.Require().NoError(err)
+	suite.Require().Len(changes, 1)
+	assert.Same(savings, changes[0].Before)
+	assert.False(savings.Transfer, "previewing saves nothing")
+	assert.True(changes[0].After.Transfer)
+
+	suite.transactionRepo.On("BulkRestore", suite.ctx, []*domain.Transaction{changes[0].After}).Return(nil)
+
+	assert.NoError(suite.useCase.ApplyRuleChanges(suite.ctx, changes))
+}
+
+func (suite *RuleUseCaseTestSuite) TestPreviewRules_SkipsReconciled() {
+	start := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 6, 30, 23, 59, 59, 0, time.UTC)
+	rules := []*domain.Rule{{
+		ID:         1,
+		Conditions: domain.RuleConditions{DescriptionContains: "savings"},
+		Actions:    domain.RuleActions{Transfer: true},
+	}}
+	reconciled := &domain.Transaction{ID: 1, Description: "To savings", Amount: 200, Type: "expense", Date: start, Status: domain.StatusReconciled}
+	suite.ruleRepo.On("GetRules", suite.ctx).Return(rules, nil)
+	suite.transactionRepo.On("GetByDateRange", suite.ctx, start, end).Return([]*domain.Transaction{reconciled}, nil)
+
+	changes, err := suite.useCase.PreviewRules(suite.ctx, start, end)
+
+	suite.Require().NoError(err)
+	assert.Empty(suite.T(), changes, "reconciled transactions are locked")
+}
+
+func (suite *RuleUseCaseTestSuite) TestPreviewRules_NoRules() {
+	assert := assert.New(suite.T())
+
+	suite.ruleRepo.On("GetRules", suite.ctx).Return(nil, nil)
+
+	changes, err := suite.useCase.PreviewRules(suite.ctx, time.Now().AddDate(0, -1, 0), time.Now())
+
+	assert.NoError(err)
+	assert.Empty(changes)
+	suite.transactionRepo.AssertNotCalled(suite.T(), "GetByDateRange", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *RuleUseCaseTestSuite) TestPreviewRules_InvalidRange() {
+	_, err := suite.useCase.PreviewRules(suite.ctx, time.Now(), time.Now().AddDate(0, 0, -1))
+
+	assert.EqualError(suite.T(), err, "start date cannot be after end date")
+}
+
+func (suite *RuleUseCaseTestSuite) TestApplyRuleChanges_RepositoryError() {
+	change := &domain.RuleChange{
+		Before: &domain.Transaction{ID: 1, Description: "x", Amount: 1, Type: "expense", Date: time.Now()},
+		After:  &domain.Transaction{ID: 1, Description: "x", Amount: 1, Type: "expense", Date: time.Now(), Transfer: true},
+	}
+	suite.transactionRepo.On("BulkRestore", suite.ctx, mock.Anything).Return(errors.New("database error"))
+
+	err := suite.useCase.ApplyRuleChanges(suite.ctx, []*domain.RuleChange{change})
+
+	assert.EqualError(suite.T(), err, "database error")
+}
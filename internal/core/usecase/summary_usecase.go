@@ -19,15 +19,14 @@ func NewSummaryUseCase(transactionRepo TransactionRepository) *SummaryUseCase {
 }
 
 func (uc *SummaryUseCase) GetMonthlySummary(ctx context.Context, year int, month time.Month) (*domain.Summary, error) {
-	start := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
-	end := start.AddDate(0, 1, 0).Add(-time.Nanosecond)
+	dateRange := domain.GetMonthRange(year, month)
 
-	totalIncome, err := uc.transactionRepo.GetTotalByDateRange(ctx, start, end, "income")
+	totalIncome, err := uc.transactionRepo.GetTotalByDateRange(ctx, dateRange.Start, dateRange.End, "income")
 	if err != nil {
 		return nil, err
 	}
 
-	totalExpense, err := uc.transactionRepo.GetTotalByDateRange(ctx, start, end, "expense")
+	totalExpense, err := uc.transactionRepo.GetTotalByDateRange(ctx, dateRange.Start, dateRange.End, "expense")
 	if err != nil {
 		return nil, err
 	}
@@ -51,6 +50,14 @@ func (uc *SummaryUseCase) SearchTransactions(ctx context.Context, query string,
 	return uc.transactionRepo.SearchTransactions(ctx, query, offset, limit)
 }
 
+// GetTransactionPage returns one page of the transaction listing with its total count
+func (uc *SummaryUseCase) GetTransactionPage(ctx context.Context, request *domain.PageRequest) (*domain.TransactionPage, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+	return uc.transactionRepo.GetPage(ctx, request)
+}
+
 // GetSummary provides intelligent defaults for period-based summaries
 func (uc *SummaryUseCase) GetSummary(ctx context.Context, periodType ...domain.PeriodType) (*domain.Summary, error) {
 	period := domain.PeriodTypeMonth // Default to current month
@@ -160,7 +167,7 @@ func (uc *SummaryUseCase) GetSummaryByDateRange(ctx context.Context, start, end
 
 // GetSummaryWithComparison gets summary with comparison to previous period
 func (uc *SummaryUseCase) GetSummaryWithComparison(ctx context.Context, periodType domain.PeriodType, reference ...time.Time) (*domain.Summary, error) {
-	refTime := time.Now()
+	refTime := domain.Now()
 	if len(reference) > 0 {
 		refTime = reference[0]
 	}
@@ -225,3 +232,24 @@ func (uc *SummaryUseCase) GetSummaryWithComparison(ctx context.Context, periodTy
 	summary.SetComparison(comparison)
 	return summary, nil
 }
+
+// AddPayeeBreakdowns fills in the income and expense totals per payee for the summary's date range
+func (uc *SummaryUseCase) AddPayeeBreakdowns(ctx context.Context, summary *domain.Summary) error {
+	if summary.DateRange == nil {
+		return fmt.Errorf("summary has no date range")
+	}
+	start, end := summary.DateRange.Start, summary.DateRange.End
+
+	incomePayees, err := uc.transactionRepo.GetPayeeTotalsByDateRange(ctx, start, end, "income")
+	if err != nil {
+		return fmt.Errorf("failed to get income payees: %w", err)
+	}
+
+	expensePayees, err := uc.transactionRepo.GetPayeeTotalsByDateRange(ctx, start, end, "expense")
+	if err != nil {
+		return fmt.Errorf("failed to get expense payees: %w", err)
+	}
+
+	summary.SetPayeeBreakdowns(incomePayees, expensePayees)
+	return nil
+}
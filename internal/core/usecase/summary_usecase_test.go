@@ -24,6 +24,11 @@ func (suite *SummaryUseCaseTestSuite) SetupTest() {
 	suite.transactionRepo = mocks.NewMockTransactionRepository(suite.T())
 	suite.useCase = NewSummaryUseCase(suite.transactionRepo)
 	suite.ctx = context.Background()
+	domain.UseTimezone(time.UTC)
+}
+
+func (suite *SummaryUseCaseTestSuite) TearDownTest() {
+	domain.UseTimezone(nil)
 }
 
 func TestSummaryUseCaseSuite(t *testing.T) {
@@ -207,3 +212,106 @@ func (suite *SummaryUseCaseTestSuite) TestSearchTransactions_Success() {
 
 	suite.transactionRepo.AssertExpectations(suite.T())
 }
+
+func (suite *SummaryUseCaseTestSuite) TestGetTransactionPage_Success() {
+	assert := assert.New(suite.T())
+
+	request := &domain.PageRequest{Query: "grocery", Limit: 20}
+	expectedPage := &domain.TransactionPage{
+		Transactions: []*domain.Transaction{
+			{ID: 1, Description: "Grocery shopping", Amount: 50.0},
+		},
+		TotalCount: 1,
+	}
+
+	suite.transactionRepo.On("GetPage", suite.ctx, request).Return(expectedPage, nil)
+
+	page, err := suite.useCase.GetTransactionPage(suite.ctx, request)
+
+	assert.NoError(err)
+	assert.Equal(expectedPage, page)
+
+	suite.transactionRepo.AssertExpectations(suite.T())
+}
+
+func (suite *SummaryUseCaseTestSuite) TestGetTransactionPage_InvalidRequest() {
+	assert := assert.New(suite.T())
+
+	page, err := suite.useCase.GetTransactionPage(suite.ctx, &domain.PageRequest{Limit: 0})
+
+	assert.Error(err)
+	assert.Nil(page)
+	assert.Contains(err.Error(), "page limit must be positive")
+
+	suite.transactionRepo.AssertNotCalled(suite.T(), "GetPage")
+}
+
+func (suite *SummaryUseCaseTestSuite) TestGetSummaryWithComparison_PreviousMonth() {
+	assert := assert.New(suite.T())
+
+	reference := time.Date(2026, 3, 15, 12, 0, 0, 0, time.UTC)
+	start := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 1, 0).Add(-time.Nanosecond)
+	prevStart := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	prevEnd := start.Add(-time.Nanosecond)
+
+	suite.transactionRepo.On("GetTotalByDateRange", suite.ctx, start, end, "income").Return(3000.0, nil)
+	suite.transactionRepo.On("GetTotalByDateRange", suite.ctx, start, end, "expense").Return(900.0, nil)
+	suite.transactionRepo.On("GetCategoryTotalsByDateRange", suite.ctx, start, end, "income").Return([]*domain.CategoryBreakdown{}, nil)
+	suite.transactionRepo.On("GetCategoryTotalsByDateRange", suite.ctx, start, end, "expense").Return([]*domain.CategoryBreakdown{}, nil)
+	suite.transactionRepo.On("GetTransactionCountByDateRange", suite.ctx, start, end, "").Return(4, nil)
+	suite.transactionRepo.On("GetTransactionCountByDateRange", suite.ctx, start, end, "income").Return(1, nil)
+	suite.transactionRepo.On("GetTransactionCountByDateRange", suite.ctx, start, end, "expense").Return(3, nil)
+	suite.transactionRepo.On("GetTotalByDateRange", suite.ctx, prevStart, prevEnd, "income").Return(2400.0, nil)
+	suite.transactionRepo.On("GetTotalByDateRange", suite.ctx, prevStart, prevEnd, "expense").Return(1200.0, nil)
+
+	summary, err := suite.useCase.GetSummaryWithComparison(suite.ctx, domain.PeriodTypeMonth, reference)
+
+	assert.NoError(err)
+	assert.Equal(domain.PeriodTypeMonth, summary.Period)
+	suite.Require().NotNil(summary.Comparison)
+	assert.Equal(600.0, summary.Comparison.IncomeChange)
+	assert.InDelta(25.0, summary.Comparison.IncomeChangePercent, 0.001)
+	assert.Equal(-300.0, summary.Comparison.ExpenseChange)
+	assert.InDelta(-25.0, summary.Comparison.ExpenseChangePercent, 0.001)
+
+	suite.transactionRepo.AssertExpectations(suite.T())
+}
+
+func (suite *SummaryUseCaseTestSuite) TestAddPayeeBreakdowns() {
+	assert := assert.New(suite.T())
+
+	start := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 1, 0).Add(-time.Nanosecond)
+	summary := domain.NewEnhancedSummary(2000, 400, domain.PeriodTypeMonth, domain.NewDateRange(start, end))
+
+	amazon := &domain.Payee{ID: 1, Name: "Amazon"}
+	suite.transactionRepo.On("GetPayeeTotalsByDateRange", suite.ctx, start, end, "income").Return([]*domain.PayeeBreakdown{}, nil)
+	suite.transactionRepo.On("GetPayeeTotalsByDateRange", suite.ctx, start, end, "expense").Return([]*domain.PayeeBreakdown{
+		{Payee: amazon, TotalAmount: 300, TransactionCount: 4},
+		{TotalAmount: 100, TransactionCount: 2},
+	}, nil)
+
+	err := suite.useCase.AddPayeeBreakdowns(suite.ctx, summary)
+
+	assert.NoError(err)
+	suite.Require().Len(summary.ExpensePayees, 2)
+	assert.Equal(amazon, summary.ExpensePayees[0].Payee)
+	assert.Equal(75.0, summary.ExpensePayees[0].Percentage)
+	assert.Nil(summary.ExpensePayees[1].Payee)
+	assert.Equal(25.0, summary.ExpensePayees[1].Percentage)
+}
+
+func (suite *SummaryUseCaseTestSuite) TestAddPayeeBreakdowns_RepositoryError() {
+	assert := assert.New(suite.T())
+
+	start := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 1, 0).Add(-time.Nanosecond)
+	summary := domain.NewEnhancedSummary(0, 0, domain.PeriodTypeMonth, domain.NewDateRange(start, end))
+
+	suite.transactionRepo.On("GetPayeeTotalsByDateRange", suite.ctx, start, end, "income").Return(nil, errors.New("database error"))
+
+	err := suite.useCase.AddPayeeBreakdowns(suite.ctx, summary)
+
+	assert.EqualError(err, "failed to get income payees: database error")
+}
@@ -3,6 +3,8 @@ package usecase
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"expense-tracker/internal/core/domain"
@@ -11,12 +13,22 @@ import (
 type TransactionUseCase struct {
 	transactionRepo TransactionRepository
 	categoryRepo    CategoryRepository
+	payeeRepo       PayeeRepository
+	ruleRepo        RuleRepository
+	uow             UnitOfWork
+	learner         *CategoryLearner
+	history         *UndoHistory
 }
 
-func NewTransactionUseCase(transactionRepo TransactionRepository, categoryRepo CategoryRepository) *TransactionUseCase {
+func NewTransactionUseCase(transactionRepo TransactionRepository, categoryRepo CategoryRepository, payeeRepo PayeeRepository, ruleRepo RuleRepository, uow UnitOfWork, learner *CategoryLearner, history *UndoHistory) *TransactionUseCase {
 	return &TransactionUseCase{
 		transactionRepo: transactionRepo,
 		categoryRepo:    categoryRepo,
+		payeeRepo:       payeeRepo,
+		ruleRepo:        ruleRepo,
+		uow:             uow,
+		learner:         learner,
+		history:         history,
 	}
 }
 
@@ -34,18 +46,68 @@ func (uc *TransactionUseCase) AddTransaction(ctx context.Context, transaction *d
 	}
 
 	if transaction.Date.IsZero() {
-		transaction.Date = time.Now()
+		transaction.Date = domain.Now()
 	}
 
+	if transaction.IsReconciled() {
+		return fmt.Errorf("new transactions cannot be reconciled")
+	}
+
+	err := uc.uow.WithTx(ctx, func(repos Repositories) error {
+		if err := resolveCategories(ctx, repos.Categories, transaction); err != nil {
+			return err
+		}
+
+		if transaction.Payee == nil {
+			payees, err := repos.Payees.GetPayees(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to get payees: %w", err)
+			}
+			transaction.Payee = domain.NewPayeeMatcher(payees).Match(transaction.Description)
+		}
+
+		rules, err := repos.Rules.GetRules(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get rules: %w", err)
+		}
+		domain.ApplyRules(rules, transaction)
+
+		return repos.Transactions.Create(ctx, transaction)
+	})
+	if err != nil {
+		return err
+	}
+	uc.learner.Learn(transaction)
+	uc.history.record(ctx, fmt.Sprintf("Added %q", transaction.Description), nil, []int{transaction.ID})
+	return nil
+}
+
+// resolveCategories loads the category of a transaction and checks its split lines against
+// its amount and type, filing the whole transaction under the category of its largest line
+func resolveCategories(ctx context.Context, categoryRepo CategoryRepository, transaction *domain.Transaction) error {
 	if transaction.Category != nil && transaction.Category.ID > 0 {
-		category, err := uc.categoryRepo.GetCategoryByID(ctx, transaction.Category.ID, transaction.Type)
+		category, err := categoryRepo.GetCategoryByID(ctx, transaction.Category.ID, transaction.Type)
 		if err != nil {
 			return fmt.Errorf("invalid category: %w", err)
 		}
 		transaction.Category = category
 	}
 
-	return uc.transactionRepo.Create(ctx, transaction)
+	if err := transaction.ValidateSplits(); err != nil {
+		return err
+	}
+
+	for i, split := range transaction.Splits {
+		category, err := categoryRepo.GetCategoryByID(ctx, split.Category.ID, transaction.Type)
+		if err != nil {
+			return fmt.Errorf("invalid category on split line %d: %w", i+1, err)
+		}
+		split.Category = category
+	}
+	if main := transaction.MainSplit(); main != nil {
+		transaction.Category = main.Category
+	}
+	return nil
 }
 
 func (uc *TransactionUseCase) GetTransactionByID(ctx context.Context, id int) (*domain.Transaction, error) {
@@ -92,22 +154,44 @@ func (uc *TransactionUseCase) UpdateTransaction(ctx context.Context, transaction
 		return fmt.Errorf("transaction type must be 'income' or 'expense'")
 	}
 
-	if transaction.Category != nil && transaction.Category.ID > 0 {
-		category, err := uc.categoryRepo.GetCategoryByID(ctx, transaction.Category.ID, transaction.Type)
-		if err != nil {
-			return fmt.Errorf("invalid category: %w", err)
+	var before []*domain.Transaction
+	err := uc.uow.WithTx(ctx, func(repos Repositories) error {
+		var err error
+		if before, err = repos.Transactions.GetByIDs(ctx, []int{transaction.ID}); err != nil {
+			return err
 		}
-		transaction.Category = category
+		if err := resolveCategories(ctx, repos.Categories, transaction); err != nil {
+			return err
+		}
+		return repos.Transactions.Update(ctx, transaction)
+	})
+	if err != nil {
+		return err
 	}
-
-	return uc.transactionRepo.Update(ctx, transaction)
+	uc.learner.Forget(before...)
+	uc.learner.Learn(transaction)
+	uc.history.record(ctx, fmt.Sprintf("Edited %q", transaction.Description), before, []int{transaction.ID})
+	return nil
 }
 
 func (uc *TransactionUseCase) DeleteTransaction(ctx context.Context, id int) error {
 	if id <= 0 {
 		return fmt.Errorf("transaction ID is required for delete")
 	}
-	return uc.transactionRepo.Delete(ctx, id)
+	var before []*domain.Transaction
+	err := uc.uow.WithTx(ctx, func(repos Repositories) error {
+		var err error
+		if before, err = repos.Transactions.GetByIDs(ctx, []int{id}); err != nil {
+			return err
+		}
+		return repos.Transactions.Delete(ctx, id)
+	})
+	if err != nil {
+		return err
+	}
+	uc.learner.Forget(before...)
+	uc.history.record(ctx, fmt.Sprintf("Moved %s to the trash", describeTransaction(before)), before, []int{id})
+	return nil
 }
 
 func (uc *TransactionUseCase) GetCategories(ctx context.Context, transactionType string) ([]*domain.Category, error) {
@@ -116,3 +200,315 @@ func (uc *TransactionUseCase) GetCategories(ctx context.Context, transactionType
 	}
 	return uc.categoryRepo.GetCategories(ctx, transactionType)
 }
+
+// recentCategoryLimit is how many recently used categories are listed before the others
+const recentCategoryLimit = 5
+
+// GetCategoriesByRecency returns the categories of a type with the most recently used ones
+// first, together with how many of them lead the list because they were used recently
+func (uc *TransactionUseCase) GetCategoriesByRecency(ctx context.Context, transactionType string) ([]*domain.Category, int, error) {
+	categories, err := uc.GetCategories(ctx, transactionType)
+	if err != nil {
+		return nil, 0, err
+	}
+	recentIDs, err := uc.transactionRepo.GetRecentCategoryIDs(ctx, transactionType, recentCategoryLimit)
+	if err != nil {
+		return nil, 0, err
+	}
+	ordered, recent := domain.OrderByRecency(categories, recentIDs)
+	return ordered, recent, nil
+}
+
+// CreateCategory adds a category of the given type, refusing names that already exist
+// in another letter case
+func (uc *TransactionUseCase) CreateCategory(ctx context.Context, name, categoryType string) (*domain.Category, error) {
+	categories, err := uc.GetCategories(ctx, categoryType)
+	if err != nil {
+		return nil, err
+	}
+
+	category := &domain.Category{Name: strings.TrimSpace(name)}
+	if err := category.Validate(); err != nil {
+		return nil, err
+	}
+	if existing := domain.FindCategory(category.Name, categories); existing != nil {
+		return nil, fmt.Errorf("category %q already exists", existing.Name)
+	}
+
+	if err := uc.categoryRepo.CreateCategory(ctx, category, categoryType); err != nil {
+		return nil, err
+	}
+	return category, nil
+}
+
+// QuickAdd records a transaction typed on one line, resolving the typed category name
+// against the categories of the entry's type
+func (uc *TransactionUseCase) QuickAdd(ctx context.Context, entry *domain.QuickEntry) (*domain.Transaction, error) {
+	categories, err := uc.GetCategories(ctx, entry.Type)
+	if err != nil {
+		return nil, err
+	}
+	category, err := domain.MatchCategory(entry.Category, categories)
+	if err != nil {
+		return nil, err
+	}
+
+	transaction := &domain.Transaction{
+		Description: entry.Description,
+		Amount:      entry.Amount,
+		Date:        entry.Date,
+		Type:        entry.Type,
+		Category:    category,
+	}
+	if err := uc.AddTransaction(ctx, transaction); err != nil {
+		return nil, err
+	}
+	return transaction, nil
+}
+
+// suggestionCandidates is how many of the most used matching descriptions are ranked by recency
+const suggestionCandidates = 50
+
+// SuggestDescriptions returns up to limit earlier descriptions of the given type with a word
+// starting with prefix, ranked by how often and how recently they were used
+func (uc *TransactionUseCase) SuggestDescriptions(ctx context.Context, prefix, transactionType string, limit int) ([]*domain.DescriptionSuggestion, error) {
+	if transactionType != "income" && transactionType != "expense" {
+		return nil, fmt.Errorf("transaction type must be 'income' or 'expense'")
+	}
+	prefix = strings.TrimSpace(prefix)
+	if prefix == "" || limit <= 0 {
+		return nil, nil
+	}
+
+	suggestions, err := uc.transactionRepo.GetDescriptionSuggestions(ctx, prefix, transactionType, max(limit, suggestionCandidates))
+	if err != nil {
+		return nil, err
+	}
+
+	domain.RankSuggestions(suggestions, domain.Now())
+	if len(suggestions) > limit {
+		suggestions = suggestions[:limit]
+	}
+	return suggestions, nil
+}
+
+// SuggestCategory returns the category earlier transactions with a similar description and
+// amount were filed under, or nil when the history gives no clear answer
+func (uc *TransactionUseCase) SuggestCategory(ctx context.Context, description string, amount float64, transactionType string) (*domain.CategorySuggestion, error) {
+	if transactionType != "income" && transactionType != "expense" {
+		return nil, fmt.Errorf("transaction type must be 'income' or 'expense'")
+	}
+	if strings.TrimSpace(description) == "" {
+		return nil, nil
+	}
+	return uc.learner.Suggest(ctx, description, amount, transactionType)
+}
+
+// BulkDelete deletes the given transactions in one step
+func (uc *TransactionUseCase) BulkDelete(ctx context.Context, ids []int) (*domain.BulkResult, error) {
+	result, snapshot, err := uc.runBulk(ctx, domain.BulkActionDelete, ids, func(repos Repositories, snapshot []*domain.Transaction) (int, error) {
+		return repos.Transactions.BulkDelete(ctx, ids)
+	})
+	if err != nil {
+		return nil, err
+	}
+	uc.learner.Forget(snapshot...)
+
+	return result, nil
+}
+
+// BulkRecategorize moves the given transactions to one category. All transactions
+// must share a type that matches the category's type.
+func (uc *TransactionUseCase) BulkRecategorize(ctx context.Context, ids []int, categoryID int) (*domain.BulkResult, error) {
+	if categoryID <= 0 {
+		return nil, fmt.Errorf("category ID is required")
+	}
+
+	var category *domain.Category
+	result, snapshot, err := uc.runBulk(ctx, domain.BulkActionRecategorize, ids, func(repos Repositories, snapshot []*domain.Transaction) (int, error) {
+		transactionType := snapshot[0].Type
+		for _, transaction := range snapshot {
+			if transaction.Type != transactionType {
+				return 0, fmt.Errorf("cannot re-categorize income and expenses together")
+			}
+		}
+
+		var err error
+		category, err = repos.Categories.GetCategoryByID(ctx, categoryID, transactionType)
+		if err != nil {
+			return 0, fmt.Errorf("invalid category: %w", err)
+		}
+
+		return repos.Transactions.BulkUpdateCategory(ctx, ids, categoryID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	uc.learner.Forget(snapshot...)
+	for _, transaction := range snapshot {
+		recategorized := transaction.Clone()
+		recategorized.Category = category
+		uc.learner.Learn(recategorized)
+	}
+
+	return result, nil
+}
+
+// BulkRetag replaces the tags of the given transactions
+func (uc *TransactionUseCase) BulkRetag(ctx context.Context, ids []int, tags []string) (*domain.BulkResult, error) {
+	tags = domain.NormalizeTags(tags)
+	if err := domain.ValidateTags(tags); err != nil {
+		return nil, err
+	}
+
+	result, _, err := uc.runBulk(ctx, domain.BulkActionRetag, ids, func(repos Repositories, snapshot []*domain.Transaction) (int, error) {
+		return repos.Transactions.BulkSetTags(ctx, ids, tags)
+	})
+	return result, err
+}
+
+// BulkShiftDate moves the date of the given transactions by a number of days, which may be negative
+func (uc *TransactionUseCase) BulkShiftDate(ctx context.Context, ids []int, days int) (*domain.BulkResult, error) {
+	if days == 0 {
+		return nil, fmt.Errorf("date shift must be at least one day")
+	}
+
+	result, _, err := uc.runBulk(ctx, domain.BulkActionShiftDate, ids, func(repos Repositories, snapshot []*domain.Transaction) (int, error) {
+		return repos.Transactions.BulkShiftDate(ctx, ids, days)
+	})
+	return result, err
+}
+
+// runBulk loads the transactions a bulk action is about to change and runs it in the same SQL
+// transaction, then adds it to the undo history. It returns the outcome and the transactions
+// as they were before.
+func (uc *TransactionUseCase) runBulk(ctx context.Context, action domain.BulkAction, ids []int, run func(repos Repositories, snapshot []*domain.Transaction) (int, error)) (*domain.BulkResult, []*domain.Transaction, error) {
+	var snapshot []*domain.Transaction
+	var affected int
+	err := uc.uow.WithTx(ctx, func(repos Repositories) error {
+		var err error
+		if snapshot, err = bulkSnapshot(ctx, repos.Transactions, ids); err != nil {
+			return err
+		}
+		affected, err = run(repos, snapshot)
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := &domain.BulkResult{Action: action, Affected: affected}
+	uc.history.record(ctx, result.Summary(), snapshot, ids)
+	return result, snapshot, nil
+}
+
+// bulkSnapshot loads the current state of the transactions a bulk action is about to change
+func bulkSnapshot(ctx context.Context, transactionRepo TransactionRepository, ids []int) ([]*domain.Transaction, error) {
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("no transactions selected")
+	}
+
+	snapshot, err := transactionRepo.GetByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	if len(snapshot) == 0 {
+		return nil, fmt.Errorf("selected transactions no longer exist")
+	}
+	if reconciled := countReconciled(snapshot); reconciled > 0 {
+		return nil, fmt.Errorf("%d of the selected transactions are locked: %w", reconciled, domain.ErrReconciled)
+	}
+
+	return snapshot, nil
+}
+
+// SetCleared marks the given transactions as cleared, or uncleared, and returns how many
+// changed. Reconciled transactions are left alone.
+func (uc *TransactionUseCase) SetCleared(ctx context.Context, ids []int, cleared bool) (int, error) {
+	status := domain.StatusUncleared
+	if cleared {
+		status = domain.StatusCleared
+	}
+
+	var transactions []*domain.Transaction
+	var changed []int
+	var updated int
+	err := uc.uow.WithTx(ctx, func(repos Repositories) error {
+		var err error
+		if transactions, err = repos.Transactions.GetByIDs(ctx, ids); err != nil {
+			return err
+		}
+
+		for _, transaction := range transactions {
+			if !transaction.IsReconciled() && transaction.Status != status {
+				changed = append(changed, transaction.ID)
+			}
+		}
+		if len(changed) == 0 {
+			return nil
+		}
+		updated, err = repos.Transactions.SetStatus(ctx, changed, status)
+		return err
+	})
+	if err != nil || len(changed) == 0 {
+		return 0, err
+	}
+	description := "Marked " + countTransactions(updated) + " as uncleared"
+	if cleared {
+		description = "Marked " + countTransactions(updated) + " as cleared"
+	}
+	uc.history.record(ctx, description, transactionsWithIDs(transactions, changed), changed)
+	return updated, nil
+}
+
+// UnlockTransactions sets the reconciled transactions among the given ones back to cleared,
+// so they can be changed again, and returns how many were unlocked
+func (uc *TransactionUseCase) UnlockTransactions(ctx context.Context, ids []int) (int, error) {
+	var transactions []*domain.Transaction
+	var locked []int
+	var unlocked int
+	err := uc.uow.WithTx(ctx, func(repos Repositories) error {
+		var err error
+		if transactions, err = repos.Transactions.GetByIDs(ctx, ids); err != nil {
+			return err
+		}
+
+		for _, transaction := range transactions {
+			if transaction.IsReconciled() {
+				locked = append(locked, transaction.ID)
+			}
+		}
+		if len(locked) == 0 {
+			return nil
+		}
+		unlocked, err = repos.Transactions.SetStatus(ctx, locked, domain.StatusCleared)
+		return err
+	})
+	if err != nil || len(locked) == 0 {
+		return 0, err
+	}
+	uc.history.record(ctx, "Unlocked "+countTransactions(unlocked), transactionsWithIDs(transactions, locked), locked)
+	return unlocked, nil
+}
+
+func countReconciled(transactions []*domain.Transaction) int {
+	count := 0
+	for _, transaction := range transactions {
+		if transaction.IsReconciled() {
+			count++
+		}
+	}
+	return count
+}
+
+// transactionsWithIDs picks the transactions with the given IDs
+func transactionsWithIDs(transactions []*domain.Transaction, ids []int) []*domain.Transaction {
+	var picked []*domain.Transaction
+	for _, transaction := range transactions {
+		if slices.Contains(ids, transaction.ID) {
+			picked = append(picked, transaction)
+		}
+	}
+	return picked
+}
@@ -19,16 +19,44 @@ type TransactionUseCaseTestSuite struct {
 	useCase         *TransactionUseCase
 	transactionRepo *mocks.MockTransactionRepository
 	categoryRepo    *mocks.MockCategoryRepository
+	payeeRepo       *mocks.MockPayeeRepository
+	ruleRepo        *mocks.MockRuleRepository
 	ctx             context.Context
 }
 
 func (suite *TransactionUseCaseTestSuite) SetupTest() {
 	suite.transactionRepo = mocks.NewMockTransactionRepository(suite.T())
 	suite.categoryRepo = mocks.NewMockCategoryRepository(suite.T())
-	suite.useCase = NewTransactionUseCase(suite.transactionRepo, suite.categoryRepo)
+	suite.payeeRepo = mocks.NewMockPayeeRepository(suite.T())
+	suite.ruleRepo = mocks.NewMockRuleRepository(suite.T())
+	suite.useCase = NewTransactionUseCase(suite.transactionRepo, suite.categoryRepo, suite.payeeRepo, suite.ruleRepo, suite.unitOfWork(), NewCategoryLearner(suite.transactionRepo), nil)
 	suite.ctx = context.Background()
 }
 
+// unitOfWork runs the use case's steps on the suite's mocks
+func (suite *TransactionUseCaseTestSuite) unitOfWork() UnitOfWork {
+	return passThroughUnitOfWork{
+		Transactions: suite.transactionRepo,
+		Categories:   suite.categoryRepo,
+		Payees:       suite.payeeRepo,
+		Rules:        suite.ruleRepo,
+	}
+}
+
+// passThroughUnitOfWork hands the given repositories to the work without a transaction,
+// for tests that mock them
+type passThroughUnitOfWork Repositories
+
+func (u passThroughUnitOfWork) WithTx(ctx context.Context, fn func(repos Repositories) error) error {
+	return fn(Repositories(u))
+}
+
+// expectNoMatches lets AddTransaction look for a payee and rules without finding any
+func (suite *TransactionUseCaseTestSuite) expectNoMatches() {
+	suite.payeeRepo.On("GetPayees", suite.ctx).Return(nil, nil)
+	suite.ruleRepo.On("GetRules", suite.ctx).Return(nil, nil)
+}
+
 func TestTransactionUseCaseSuite(t *testing.T) {
 	suite.Run(t, new(TransactionUseCaseTestSuite))
 }
@@ -49,6 +77,7 @@ func (suite *TransactionUseCaseTestSuite) TestAddTransaction_Success() {
 	suite.categoryRepo.On("GetCategoryByID", suite.ctx, 1, "expense").Return(expectedCategory, nil)
 
 	// Mock transaction creation
+	suite.expectNoMatches()
 	suite.transactionRepo.On("Create", suite.ctx, transaction).Return(nil)
 
 	err := suite.useCase.AddTransaction(suite.ctx, transaction)
@@ -126,6 +155,7 @@ func (suite *TransactionUseCaseTestSuite) TestAddTransaction_AutoSetDate() {
 		Date:        time.Time{}, // Zero date should be auto-set
 	}
 
+	suite.expectNoMatches()
 	suite.transactionRepo.On("Create", suite.ctx, mock.AnythingOfType("*domain.Transaction")).Return(nil)
 
 	err := suite.useCase.AddTransaction(suite.ctx, transaction)
@@ -218,6 +248,7 @@ func (suite *TransactionUseCaseTestSuite) TestDeleteTransaction_InvalidID() {
 func (suite *TransactionUseCaseTestSuite) TestDeleteTransaction_Success() {
 	assert := assert.New(suite.T())
 
+	suite.transactionRepo.On("GetByIDs", suite.ctx, []int{1}).Return([]*domain.Transaction{{ID: 1, Description: "Coffee"}}, nil)
 	suite.transactionRepo.On("Delete", suite.ctx, 1).Return(nil)
 
 	err := suite.useCase.DeleteTransaction(suite.ctx, 1)
@@ -254,3 +285,545 @@ func (suite *TransactionUseCaseTestSuite) TestGetCategories_Success() {
 	assert.Equal(expectedCategories, categories)
 	suite.categoryRepo.AssertExpectations(suite.T())
 }
+
+func (suite *TransactionUseCaseTestSuite) TestBulkDelete_Success() {
+	assert := assert.New(suite.T())
+
+	ids := []int{1, 2}
+	snapshot := []*domain.Transaction{
+		{ID: 1, Description: "Lidl", Amount: 42.5, Type: "expense"},
+		{ID: 2, Description: "Bus ticket", Amount: 2.8, Type: "expense"},
+	}
+
+	suite.transactionRepo.On("GetByIDs", suite.ctx, ids).Return(snapshot, nil)
+	suite.transactionRepo.On("BulkDelete", suite.ctx, ids).Return(2, nil)
+
+	result, err := suite.useCase.BulkDelete(suite.ctx, ids)
+
+	assert.NoError(err)
+	assert.Equal(domain.BulkActionDelete, result.Action)
+	assert.Equal(2, result.Affected)
+}
+
+func (suite *TransactionUseCaseTestSuite) TestBulkDelete_NoSelection() {
+	assert := assert.New(suite.T())
+
+	result, err := suite.useCase.BulkDelete(suite.ctx, nil)
+
+	assert.Error(err)
+	assert.Nil(result)
+	assert.Contains(err.Error(), "no transactions selected")
+
+	suite.transactionRepo.AssertNotCalled(suite.T(), "BulkDelete")
+}
+
+func (suite *TransactionUseCaseTestSuite) TestBulkRecategorize_Success() {
+	assert := assert.New(suite.T())
+
+	ids := []int{1, 2}
+	snapshot := []*domain.Transaction{
+		{ID: 1, Description: "Lidl", Amount: 42.5, Type: "expense"},
+		{ID: 2, Description: "Aldi", Amount: 18.0, Type: "expense"},
+	}
+
+	suite.transactionRepo.On("GetByIDs", suite.ctx, ids).Return(snapshot, nil)
+	suite.categoryRepo.On("GetCategoryByID", suite.ctx, 3, "expense").Return(&domain.Category{ID: 3, Name: "Groceries"}, nil)
+	suite.transactionRepo.On("BulkUpdateCategory", suite.ctx, ids, 3).Return(2, nil)
+
+	result, err := suite.useCase.BulkRecategorize(suite.ctx, ids, 3)
+
+	assert.NoError(err)
+	assert.Equal(domain.BulkActionRecategorize, result.Action)
+	assert.Equal(2, result.Affected)
+}
+
+func (suite *TransactionUseCaseTestSuite) TestBulkRecategorize_MixedTypes() {
+	assert := assert.New(suite.T())
+
+	ids := []int{1, 2}
+	snapshot := []*domain.Transaction{
+		{ID: 1, Description: "Lidl", Amount: 42.5, Type: "expense"},
+		{ID: 2, Description: "Salary", Amount: 2000, Type: "income"},
+	}
+
+	suite.transactionRepo.On("GetByIDs", suite.ctx, ids).Return(snapshot, nil)
+
+	result, err := suite.useCase.BulkRecategorize(suite.ctx, ids, 3)
+
+	assert.Error(err)
+	assert.Nil(result)
+	assert.Contains(err.Error(), "cannot re-categorize income and expenses together")
+
+	suite.transactionRepo.AssertNotCalled(suite.T(), "BulkUpdateCategory")
+	suite.categoryRepo.AssertNotCalled(suite.T(), "GetCategoryByID")
+}
+
+func (suite *TransactionUseCaseTestSuite) TestBulkRetag_NormalizesTags() {
+	assert := assert.New(suite.T())
+
+	ids := []int{1}
+	snapshot := []*domain.Transaction{{ID: 1, Description: "Lidl", Amount: 42.5, Type: "expense"}}
+
+	suite.transactionRepo.On("GetByIDs", suite.ctx, ids).Return(snapshot, nil)
+	suite.transactionRepo.On("BulkSetTags", suite.ctx, ids, []string{"groceries", "shared"}).Return(1, nil)
+
+	result, err := suite.useCase.BulkRetag(suite.ctx, ids, []string{"Shared ", "groceries", "shared"})
+
+	assert.NoError(err)
+	assert.Equal(1, result.Affected)
+}
+
+func (suite *TransactionUseCaseTestSuite) TestBulkShiftDate_ZeroDays() {
+	assert := assert.New(suite.T())
+
+	result, err := suite.useCase.BulkShiftDate(suite.ctx, []int{1}, 0)
+
+	assert.Error(err)
+	assert.Nil(result)
+	assert.Contains(err.Error(), "date shift must be at least one day")
+
+	suite.transactionRepo.AssertNotCalled(suite.T(), "GetByIDs")
+}
+
+func (suite *TransactionUseCaseTestSuite) TestBulkDelete_Reconciled() {
+	assert := assert.New(suite.T())
+
+	ids := []int{1, 2}
+	snapshot := []*domain.Transaction{
+		{ID: 1, Description: "Lidl", Amount: 42.5, Type: "expense"},
+		{ID: 2, Description: "Rent", Amount: 900, Type: "expense", Status: domain.StatusReconciled},
+	}
+
+	suite.transactionRepo.On("GetByIDs", suite.ctx, ids).Return(snapshot, nil)
+
+	result, err := suite.useCase.BulkDelete(suite.ctx, ids)
+
+	assert.ErrorIs(err, domain.ErrReconciled)
+	assert.Nil(result)
+	suite.transactionRepo.AssertNotCalled(suite.T(), "BulkDelete", mock.Anything, mock.Anything)
+}
+
+func (suite *TransactionUseCaseTestSuite) TestUnlockTransactions() {
+	assert := assert.New(suite.T())
+
+	ids := []int{1, 2, 3}
+	suite.transactionRepo.On("GetByIDs", suite.ctx, ids).Return([]*domain.Transaction{
+		{ID: 1, Description: "Lidl", Amount: 42.5, Type: "expense", Status: domain.StatusCleared},
+		{ID: 2, Description: "Rent", Amount: 900, Type: "expense", Status: domain.StatusReconciled},
+		{ID: 3, Description: "Bus ticket", Amount: 2.8, Type: "expense"},
+	}, nil)
+	suite.transactionRepo.On("SetStatus", suite.ctx, []int{2}, domain.StatusCleared).Return(1, nil)
+
+	unlocked, err := suite.useCase.UnlockTransactions(suite.ctx, ids)
+
+	assert.NoError(err)
+	assert.Equal(1, unlocked)
+}
+
+func (suite *TransactionUseCaseTestSuite) TestSetCleared_SkipsReconciled() {
+	assert := assert.New(suite.T())
+
+	ids := []int{1, 2, 3}
+	suite.transactionRepo.On("GetByIDs", suite.ctx, ids).Return([]*domain.Transaction{
+		{ID: 1, Description: "Lidl", Amount: 42.5, Type: "expense", Status: domain.StatusCleared},
+		{ID: 2, Description: "Rent", Amount: 900, Type: "expense", Status: domain.StatusReconciled},
+		{ID: 3, Description: "Bus ticket", Amount: 2.8, Type: "expense"},
+	}, nil)
+	suite.transactionRepo.On("SetStatus", suite.ctx, []int{1}, domain.StatusUncleared).Return(1, nil)
+
+	changed, err := suite.useCase.SetCleared(suite.ctx, ids, false)
+
+	assert.NoError(err)
+	assert.Equal(1, changed)
+}
+
+func (suite *TransactionUseCaseTestSuite) TestAddTransaction_Reconciled() {
+	transaction := &domain.Transaction{Description: "Rent", Amount: 900, Type: "expense", Date: time.Now(), Status: domain.StatusReconciled}
+
+	err := suite.useCase.AddTransaction(suite.ctx, transaction)
+
+	assert.EqualError(suite.T(), err, "new transactions cannot be reconciled")
+	suite.transactionRepo.AssertNotCalled(suite.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (suite *TransactionUseCaseTestSuite) TestSuggestDescriptions_RanksByFrequencyAndRecency() {
+	assert := assert.New(suite.T())
+	now := time.Now()
+
+	groceries := &domain.Category{ID: 1, Name: "Food & Dining"}
+	stale := &domain.DescriptionSuggestion{Description: "Lidl Berlin", UseCount: 12, LastUsed: now.AddDate(-2, 0, 0), LastAmount: 30}
+	regular := &domain.DescriptionSuggestion{Description: "Lidl", UseCount: 8, LastUsed: now.AddDate(0, 0, -2), LastAmount: 23.4, Category: groceries}
+	recent := &domain.DescriptionSuggestion{Description: "Lieferando", UseCount: 1, LastUsed: now.AddDate(0, 0, -1), LastAmount: 18}
+
+	suite.transactionRepo.On("GetDescriptionSuggestions", suite.ctx, "li", "expense", suggestionCandidates).
+		Return([]*domain.DescriptionSuggestion{stale, regular, recent}, nil)
+
+	suggestions, err := suite.useCase.SuggestDescriptions(suite.ctx, " li", "expense", 2)
+
+	assert.NoError(err)
+	assert.Equal([]*domain.DescriptionSuggestion{regular, recent}, suggestions)
+	assert.Equal(groceries, suggestions[0].Category)
+}
+
+func (suite *TransactionUseCaseTestSuite) TestSuggestDescriptions_EmptyPrefix() {
+	suggestions, err := suite.useCase.SuggestDescriptions(suite.ctx, "  ", "expense", 5)
+
+	assert.NoError(suite.T(), err)
+	assert.Empty(suite.T(), suggestions)
+	suite.transactionRepo.AssertNotCalled(suite.T(), "GetDescriptionSuggestions", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *TransactionUseCaseTestSuite) TestSuggestDescriptions_InvalidType() {
+	_, err := suite.useCase.SuggestDescriptions(suite.ctx, "li", "transfer", 5)
+
+	assert.Error(suite.T(), err)
+}
+
+func (suite *TransactionUseCaseTestSuite) TestSuggestDescriptions_RepositoryError() {
+	suite.transactionRepo.On("GetDescriptionSuggestions", suite.ctx, "li", "expense", suggestionCandidates).
+		Return(nil, errors.New("database is locked"))
+
+	_, err := suite.useCase.SuggestDescriptions(suite.ctx, "li", "expense", 5)
+
+	assert.EqualError(suite.T(), err, "database is locked")
+}
+
+func (suite *TransactionUseCaseTestSuite) TestQuickAdd_Success() {
+	assert := assert.New(suite.T())
+
+	food := &domain.Category{ID: 1, Name: "Food & Dining"}
+	transport := &domain.Category{ID: 2, Name: "Transportation"}
+	suite.categoryRepo.On("GetCategories", suite.ctx, "expense").Return([]*domain.Category{food, transport}, nil)
+	suite.categoryRepo.On("GetCategoryByID", suite.ctx, 1, "expense").Return(food, nil)
+	suite.expectNoMatches()
+	suite.transactionRepo.On("Create", suite.ctx, mock.MatchedBy(func(tx *domain.Transaction) bool {
+		return tx.Description == "Lidl" && tx.Amount == 42.5 && tx.Category == food
+	})).Return(nil)
+
+	date := time.Date(2026, 10, 13, 0, 0, 0, 0, time.UTC)
+	transaction, err := suite.useCase.QuickAdd(suite.ctx, &domain.QuickEntry{
+		Type: "expense", Amount: 42.5, Category: "food", Description: "Lidl", Date: date,
+	})
+
+	assert.NoError(err)
+	assert.Equal(food, transaction.Category)
+	assert.Equal(date, transaction.Date)
+}
+
+func (suite *TransactionUseCaseTestSuite) TestQuickAdd_UnknownCategory() {
+	suite.categoryRepo.On("GetCategories", suite.ctx, "income").Return([]*domain.Category{{ID: 8, Name: "Salary"}}, nil)
+
+	_, err := suite.useCase.QuickAdd(suite.ctx, &domain.QuickEntry{
+		Type: "income", Amount: 10, Category: "rent", Description: "Flatmate", Date: time.Now(),
+	})
+
+	assert.ErrorContains(suite.T(), err, `no category matches "rent"`)
+	suite.transactionRepo.AssertNotCalled(suite.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (suite *TransactionUseCaseTestSuite) TestGetCategoriesByRecency() {
+	assert := assert.New(suite.T())
+
+	categories := []*domain.Category{
+		{ID: 1, Name: "Food"},
+		{ID: 2, Name: "Shopping"},
+		{ID: 3, Name: "Transport"},
+	}
+	suite.categoryRepo.On("GetCategories", suite.ctx, "expense").Return(categories, nil)
+	suite.transactionRepo.On("GetRecentCategoryIDs", suite.ctx, "expense", recentCategoryLimit).Return([]int{3}, nil)
+
+	ordered, recent, err := suite.useCase.GetCategoriesByRecency(suite.ctx, "expense")
+
+	assert.NoError(err)
+	assert.Equal(1, recent)
+	assert.Equal([]*domain.Category{categories[2], categories[0], categories[1]}, ordered)
+}
+
+func (suite *TransactionUseCaseTestSuite) TestCreateCategory_Success() {
+	assert := assert.New(suite.T())
+
+	suite.categoryRepo.On("GetCategories", suite.ctx, "expense").Return([]*domain.Category{{ID: 1, Name: "Food"}}, nil)
+	suite.categoryRepo.On("CreateCategory", suite.ctx, &domain.Category{Name: "Groceries"}, "expense").
+		Run(func(args mock.Arguments) { args.Get(1).(*domain.Category).ID = 7 }).
+		Return(nil)
+
+	category, err := suite.useCase.CreateCategory(suite.ctx, "  Groceries ", "expense")
+
+	assert.NoError(err)
+	assert.Equal(&domain.Category{ID: 7, Name: "Groceries"}, category)
+}
+
+func (suite *TransactionUseCaseTestSuite) TestCreateCategory_Duplicate() {
+	assert := assert.New(suite.T())
+
+	suite.categoryRepo.On("GetCategories", suite.ctx, "expense").Return([]*domain.Category{{ID: 1, Name: "Food"}}, nil)
+
+	category, err := suite.useCase.CreateCategory(suite.ctx, "food", "expense")
+
+	assert.Nil(category)
+	assert.EqualError(err, `category "Food" already exists`)
+	suite.categoryRepo.AssertNotCalled(suite.T(), "CreateCategory")
+}
+
+func (suite *TransactionUseCaseTestSuite) TestCreateCategory_EmptyName() {
+	assert := assert.New(suite.T())
+
+	suite.categoryRepo.On("GetCategories", suite.ctx, "income").Return([]*domain.Category{}, nil)
+
+	_, err := suite.useCase.CreateCategory(suite.ctx, "   ", "income")
+
+	assert.EqualError(err, "category name cannot be empty")
+}
+
+func (suite *TransactionUseCaseTestSuite) TestAddTransaction_Split() {
+	assert := assert.New(suite.T())
+
+	transaction := &domain.Transaction{
+		Description: "Supermarket",
+		Amount:      58.3,
+		Type:        "expense",
+		Date:        time.Now(),
+		Splits: []*domain.Split{
+			{Category: &domain.Category{ID: 1}, Amount: 12.4},
+			{Category: &domain.Category{ID: 2}, Amount: 45.9, Memo: "weekly shop"},
+		},
+	}
+
+	household := &domain.Category{ID: 1, Name: "Household"}
+	groceries := &domain.Category{ID: 2, Name: "Groceries"}
+	suite.categoryRepo.On("GetCategoryByID", suite.ctx, 1, "expense").Return(household, nil)
+	suite.categoryRepo.On("GetCategoryByID", suite.ctx, 2, "expense").Return(groceries, nil)
+	suite.expectNoMatches()
+	suite.transactionRepo.On("Create", suite.ctx, transaction).Return(nil)
+
+	err := suite.useCase.AddTransaction(suite.ctx, transaction)
+
+	assert.NoError(err)
+	assert.Equal(groceries, transaction.Category)
+	assert.Equal(household, transaction.Splits[0].Category)
+	suite.transactionRepo.AssertExpectations(suite.T())
+}
+
+func (suite *TransactionUseCaseTestSuite) TestAddTransaction_UnbalancedSplit() {
+	assert := assert.New(suite.T())
+
+	transaction := &domain.Transaction{
+		Description: "Supermarket",
+		Amount:      58.3,
+		Type:        "expense",
+		Date:        time.Now(),
+		Splits: []*domain.Split{
+			{Category: &domain.Category{ID: 1}, Amount: 12.4},
+			{Category: &domain.Category{ID: 2}, Amount: 40},
+		},
+	}
+
+	err := suite.useCase.AddTransaction(suite.ctx, transaction)
+
+	assert.EqualError(err, "split lines must add up to 58.30 (5.90 unallocated)")
+	suite.transactionRepo.AssertNotCalled(suite.T(), "Create")
+}
+
+func (suite *TransactionUseCaseTestSuite) TestUpdateTransaction_SplitCategoryOfOtherType() {
+	assert := assert.New(suite.T())
+
+	transaction := &domain.Transaction{
+		ID:          4,
+		Description: "Supermarket",
+		Amount:      20,
+		Type:        "expense",
+		Date:        time.Now(),
+		Splits: []*domain.Split{
+			{Category: &domain.Category{ID: 1}, Amount: 10},
+			{Category: &domain.Category{ID: 8}, Amount: 10},
+		},
+	}
+
+	suite.transactionRepo.On("GetByIDs", suite.ctx, []int{4}).Return([]*domain.Transaction{{ID: 4, Description: "Supermarket"}}, nil)
+	suite.categoryRepo.On("GetCategoryByID", suite.ctx, 1, "expense").Return(&domain.Category{ID: 1, Name: "Household"}, nil)
+	suite.categoryRepo.On("GetCategoryByID", suite.ctx, 8, "expense").Return(nil, errors.New("not found"))
+
+	err := suite.useCase.UpdateTransaction(suite.ctx, transaction)
+
+	assert.EqualError(err, "invalid category on split line 2: not found")
+	suite.transactionRepo.AssertNotCalled(suite.T(), "Update")
+}
+
+func (suite *TransactionUseCaseTestSuite) TestAddTransaction_AssignsPayeeFromAlias() {
+	assert := assert.New(suite.T())
+
+	transaction := &domain.Transaction{
+		Description: "AMZN Mktp DE*2K4",
+		Amount:      23.99,
+		Type:        "expense",
+		Date:        time.Now(),
+	}
+
+	amazon := &domain.Payee{ID: 3, Name: "Amazon", Aliases: []string{"amzn mktp*"}}
+	suite.payeeRepo.On("GetPayees", suite.ctx).Return([]*domain.Payee{amazon}, nil)
+	suite.ruleRepo.On("GetRules", suite.ctx).Return(nil, nil)
+	suite.transactionRepo.On("Create", suite.ctx, transaction).Return(nil)
+
+	err := suite.useCase.AddTransaction(suite.ctx, transaction)
+
+	assert.NoError(err)
+	assert.Equal(amazon, transaction.Payee)
+}
+
+func (suite *TransactionUseCaseTestSuite) TestAddTransaction_KeepsChosenPayee() {
+	assert := assert.New(suite.T())
+
+	chosen := &domain.Payee{ID: 5, Name: "Corner Shop"}
+	transaction := &domain.Transaction{
+		Description: "Amazon",
+		Amount:      4.5,
+		Type:        "expense",
+		Date:        time.Now(),
+		Payee:       chosen,
+	}
+
+	suite.ruleRepo.On("GetRules", suite.ctx).Return(nil, nil)
+	suite.transactionRepo.On("Create", suite.ctx, transaction).Return(nil)
+
+	err := suite.useCase.AddTransaction(suite.ctx, transaction)
+
+	assert.NoError(err)
+	assert.Equal(chosen, transaction.Payee)
+	suite.payeeRepo.AssertNotCalled(suite.T(), "GetPayees")
+}
+
+func (suite *TransactionUseCaseTestSuite) TestAddTransaction_AppliesRules() {
+	assert := assert.New(suite.T())
+
+	transaction := &domain.Transaction{
+		Description: "SEPA transfer to savings",
+		Amount:      500,
+		Type:        "expense",
+		Date:        time.Now(),
+		Category:    &domain.Category{ID: 7},
+	}
+
+	other := &domain.Category{ID: 7, Name: "Other"}
+	rules := []*domain.Rule{{
+		ID:         1,
+		Conditions: domain.RuleConditions{DescriptionContains: "to savings"},
+		Actions:    domain.RuleActions{Tags: []string{"savings"}, Transfer: true},
+	}}
+	suite.categoryRepo.On("GetCategoryByID", suite.ctx, 7, "expense").Return(other, nil)
+	suite.payeeRepo.On("GetPayees", suite.ctx).Return(nil, nil)
+	suite.ruleRepo.On("GetRules", suite.ctx).Return(rules, nil)
+	suite.transactionRepo.On("Create", suite.ctx, transaction).Return(nil)
+
+	err := suite.useCase.AddTransaction(suite.ctx, transaction)
+
+	assert.NoError(err)
+	assert.True(transaction.Transfer)
+	assert.Equal([]string{"savings"}, transaction.Tags)
+	assert.Equal(other, transaction.Category)
+}
+
+func (suite *TransactionUseCaseTestSuite) TestAddTransaction_RulesError() {
+	assert := assert.New(suite.T())
+
+	transaction := &domain.Transaction{Description: "Rent", Amount: 900, Type: "expense", Date: time.Now()}
+
+	suite.payeeRepo.On("GetPayees", suite.ctx).Return(nil, nil)
+	suite.ruleRepo.On("GetRules", suite.ctx).Return(nil, errors.New("database error"))
+
+	err := suite.useCase.AddTransaction(suite.ctx, transaction)
+
+	assert.EqualError(err, "failed to get rules: database error")
+	suite.transactionRepo.AssertNotCalled(suite.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (suite *TransactionUseCaseTestSuite) TestSuggestCategory_LearnsFromHistory() {
+	assert := assert.New(suite.T())
+
+	groceries := &domain.Category{ID: 1, Name: "Groceries"}
+	shopping := &domain.Category{ID: 3, Name: "Shopping"}
+	suite.transactionRepo.On("GetCategoryHistory", suite.ctx).Return([]*domain.Transaction{
+		{ID: 1, Description: "Lidl Berlin", Amount: 23.5, Type: "expense", Category: groceries},
+		{ID: 2, Description: "Lidl", Amount: 31, Type: "expense", Category: groceries},
+		{ID: 3, Description: "Amazon order", Amount: 35, Type: "expense", Category: shopping},
+	}, nil).Once()
+
+	suggestion, err := suite.useCase.SuggestCategory(suite.ctx, "LIDL Hamburg", 27, "expense")
+
+	suite.Require().NoError(err)
+	suite.Require().NotNil(suggestion)
+	assert.Equal(groceries, suggestion.Category)
+
+	// Later changes are learned without reading the history again
+	suite.expectNoMatches()
+	suite.categoryRepo.On("GetCategoryByID", suite.ctx, 3, "expense").Return(shopping, nil)
+	suite.transactionRepo.On("Create", suite.ctx, mock.AnythingOfType("*domain.Transaction")).Return(nil)
+	suite.Require().NoError(suite.useCase.AddTransaction(suite.ctx, &domain.Transaction{
+		Description: "Rossmann", Amount: 12, Type: "expense", Date: time.Now(), Category: shopping,
+	}))
+
+	suite.transactionRepo.On("GetByIDs", suite.ctx, []int{1, 2}).Return([]*domain.Transaction{
+		{ID: 1, Description: "Lidl Berlin", Amount: 23.5, Type: "expense", Category: groceries},
+		{ID: 2, Description: "Lidl", Amount: 31, Type: "expense", Category: groceries},
+	}, nil)
+	suite.transactionRepo.On("BulkUpdateCategory", suite.ctx, []int{1, 2}, 3).Return(2, nil)
+	_, err = suite.useCase.BulkRecategorize(suite.ctx, []int{1, 2}, 3)
+	suite.Require().NoError(err)
+
+	for _, description := range []string{"Rossmann", "Lidl"} {
+		suggestion, err = suite.useCase.SuggestCategory(suite.ctx, description, 20, "expense")
+		suite.Require().NoError(err)
+		suite.Require().NotNil(suggestion, description)
+		assert.Equal(shopping, suggestion.Category, description)
+	}
+}
+
+func (suite *TransactionUseCaseTestSuite) TestSuggestCategory_LearnsEditsAndDeletes() {
+	assert := assert.New(suite.T())
+
+	groceries := &domain.Category{ID: 1, Name: "Groceries"}
+	shopping := &domain.Category{ID: 3, Name: "Shopping"}
+	lidl := &domain.Transaction{ID: 1, Description: "Lidl", Amount: 31, Type: "expense", Category: groceries}
+	amazon := &domain.Transaction{ID: 2, Description: "Amazon order", Amount: 35, Type: "expense", Category: shopping}
+	// The history is read once; edits and deletes update what was learned from it
+	suite.transactionRepo.On("GetCategoryHistory", suite.ctx).Return([]*domain.Transaction{lidl, amazon}, nil).Once()
+
+	suggestion, err := suite.useCase.SuggestCategory(suite.ctx, "Lidl", 20, "expense")
+	suite.Require().NoError(err)
+	suite.Require().NotNil(suggestion)
+	assert.Equal(groceries, suggestion.Category)
+
+	edited := lidl.Clone()
+	edited.Category = &domain.Category{ID: 3}
+	suite.transactionRepo.On("GetByIDs", suite.ctx, []int{1}).Return([]*domain.Transaction{lidl}, nil).Once()
+	suite.categoryRepo.On("GetCategoryByID", suite.ctx, 3, "expense").Return(shopping, nil)
+	suite.transactionRepo.On("Update", suite.ctx, edited).Return(nil)
+	suite.Require().NoError(suite.useCase.UpdateTransaction(suite.ctx, edited))
+
+	suggestion, err = suite.useCase.SuggestCategory(suite.ctx, "Lidl", 20, "expense")
+	suite.Require().NoError(err)
+	suite.Require().NotNil(suggestion)
+	assert.Equal(shopping, suggestion.Category, "the edit moved the suggestion")
+
+	suite.transactionRepo.On("GetByIDs", suite.ctx, []int{1}).Return([]*domain.Transaction{edited}, nil).Once()
+	suite.transactionRepo.On("Delete", suite.ctx, 1).Return(nil)
+	suite.Require().NoError(suite.useCase.DeleteTransaction(suite.ctx, 1))
+
+	suggestion, err = suite.useCase.SuggestCategory(suite.ctx, "Lidl", 20, "expense")
+	suite.Require().NoError(err)
+	assert.Nil(suggestion, "nothing like Lidl is left after the delete")
+}
+
+func (suite *TransactionUseCaseTestSuite) TestSuggestCategory_NothingToSuggest() {
+	assert := assert.New(suite.T())
+
+	suggestion, err := suite.useCase.SuggestCategory(suite.ctx, "  ", 20, "expense")
+	assert.NoError(err)
+	assert.Nil(suggestion)
+
+	_, err = suite.useCase.SuggestCategory(suite.ctx, "Lidl", 20, "transfer")
+	assert.EqualError(err, "transaction type must be 'income' or 'expense'")
+
+	suite.transactionRepo.On("GetCategoryHistory", suite.ctx).Return(nil, errors.New("database error"))
+	_, err = suite.useCase.SuggestCategory(suite.ctx, "Lidl", 20, "expense")
+	assert.EqualError(err, "failed to train category suggestions: database error")
+}
@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"expense-tracker/internal/core/domain"
+)
+
+type TrashUseCase struct {
+	transactionRepo TransactionRepository
+	history         *UndoHistory
+	retentionDays   int
+}
+
+// NewTrashUseCase takes how many days deleted transactions stay in the trash; 0 or less
+// uses domain.DefaultTrashRetentionDays
+func NewTrashUseCase(transactionRepo TransactionRepository, history *UndoHistory, retentionDays int) *TrashUseCase {
+	if retentionDays <= 0 {
+		retentionDays = domain.DefaultTrashRetentionDays
+	}
+	return &TrashUseCase{
+		transactionRepo: transactionRepo,
+		history:         history,
+		retentionDays:   retentionDays,
+	}
+}
+
+// RetentionDays is how many days deleted transactions stay in the trash
+func (uc *TrashUseCase) RetentionDays() int {
+	return uc.retentionDays
+}
+
+// GetTrash returns the deleted transactions, most recently deleted first
+func (uc *TrashUseCase) GetTrash(ctx context.Context) ([]*domain.Transaction, error) {
+	transactions, err := uc.transactionRepo.GetTrash(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get trash: %w", err)
+	}
+	return transactions, nil
+}
+
+// Restore takes transactions back out of the trash and returns how many were restored
+func (uc *TrashUseCase) Restore(ctx context.Context, ids []int) (int, error) {
+	if len(ids) == 0 {
+		return 0, fmt.Errorf("no transactions selected")
+	}
+
+	before, err := uc.history.snapshot(ctx, ids)
+	if err != nil {
+		return 0, fmt.Errorf("failed to restore transactions: %w", err)
+	}
+	restored, err := uc.transactionRepo.RestoreDeleted(ctx, ids)
+	if err != nil {
+		return 0, fmt.Errorf("failed to restore transactions: %w", err)
+	}
+	uc.history.record(ctx, "Restored "+countTransactions(restored)+" from the trash", before, ids)
+	return restored, nil
+}
+
+// Purge deletes transactions in the trash for good and returns how many were removed
+func (uc *TrashUseCase) Purge(ctx context.Context, ids []int) (int, error) {
+	if len(ids) == 0 {
+		return 0, fmt.Errorf("no transactions selected")
+	}
+
+	purged, err := uc.transactionRepo.Purge(ctx, ids)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge transactions: %w", err)
+	}
+	uc.history.forget(ids)
+	return purged, nil
+}
+
+// PurgeExpired deletes for good the transactions that have been in the trash longer than
+// the retention period and returns how many were removed
+func (uc *TrashUseCase) PurgeExpired(ctx context.Context) (int, error) {
+	cutoff := domain.Now().AddDate(0, 0, -uc.retentionDays)
+	purged, err := uc.transactionRepo.PurgeDeletedBefore(ctx, cutoff)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge expired transactions: %w", err)
+	}
+	uc.history.forget(purged)
+	return len(purged), nil
+}
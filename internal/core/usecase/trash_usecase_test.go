@@ -0,0 +1,101 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"expense-tracker/internal/core/domain"
+	"expense-tracker/test/mocks"
+)
+
+type TrashUseCaseTestSuite struct {
+	suite.Suite
+	useCase         *TrashUseCase
+	transactionRepo *mocks.MockTransactionRepository
+	ctx             context.Context
+}
+
+func (suite *TrashUseCaseTestSuite) SetupTest() {
+	suite.transactionRepo = mocks.NewMockTransactionRepository(suite.T())
+	suite.useCase = NewTrashUseCase(suite.transactionRepo, nil, 7)
+	suite.ctx = context.Background()
+}
+
+func TestTrashUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(TrashUseCaseTestSuite))
+}
+
+func (suite *TrashUseCaseTestSuite) TestNewTrashUseCase_DefaultRetention() {
+	assert.Equal(suite.T(), 7, suite.useCase.RetentionDays())
+	assert.Equal(suite.T(), domain.DefaultTrashRetentionDays, NewTrashUseCase(suite.transactionRepo, nil, 0).RetentionDays())
+}
+
+func (suite *TrashUseCaseTestSuite) TestGetTrash() {
+	deleted := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	trash := []*domain.Transaction{{ID: 4, Description: "Coffee", DeletedAt: &deleted}}
+	suite.transactionRepo.On("GetTrash", suite.ctx).Return(trash, nil)
+
+	result, err := suite.useCase.GetTrash(suite.ctx)
+
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), trash, result)
+}
+
+func (suite *TrashUseCaseTestSuite) TestRestore() {
+	suite.transactionRepo.On("RestoreDeleted", suite.ctx, []int{4, 5}).Return(2, nil)
+
+	restored, err := suite.useCase.Restore(suite.ctx, []int{4, 5})
+
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), 2, restored)
+}
+
+func (suite *TrashUseCaseTestSuite) TestRestore_NothingSelected() {
+	_, err := suite.useCase.Restore(suite.ctx, nil)
+
+	assert.EqualError(suite.T(), err, "no transactions selected")
+}
+
+func (suite *TrashUseCaseTestSuite) TestPurge() {
+	suite.transactionRepo.On("Purge", suite.ctx, []int{4}).Return(0, errors.New("database is locked"))
+
+	_, err := suite.useCase.Purge(suite.ctx, []int{4})
+
+	assert.EqualError(suite.T(), err, "failed to purge transactions: database is locked")
+}
+
+func (suite *TrashUseCaseTestSuite) TestPurgeExpired() {
+	before := time.Now().AddDate(0, 0, -7)
+	suite.transactionRepo.On("PurgeDeletedBefore", suite.ctx, mock.MatchedBy(func(cutoff time.Time) bool {
+		return !cutoff.Before(before) && cutoff.Before(before.Add(time.Minute))
+	})).Return([]int{4, 5, 6}, nil)
+
+	purged, err := suite.useCase.PurgeExpired(suite.ctx)
+
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), 3, purged)
+}
+
+func (suite *TrashUseCaseTestSuite) TestPurgeExpired_ForgetsChanges() {
+	history := NewUndoHistory(suite.transactionRepo, passThroughUnitOfWork{Transactions: suite.transactionRepo}, nil, 0)
+	useCase := NewTrashUseCase(suite.transactionRepo, history, 7)
+
+	coffee := &domain.Transaction{ID: 4, Description: "Coffee", Amount: 3, Type: "expense"}
+	suite.transactionRepo.On("GetByIDs", suite.ctx, []int{4}).Return([]*domain.Transaction{coffee}, nil).Once()
+	history.record(suite.ctx, `Edited "Coffee"`, []*domain.Transaction{{ID: 4, Description: "Coffee", Amount: 2.5, Type: "expense"}}, []int{4})
+	undo, _ := history.Pending()
+	suite.Require().Equal(`Edited "Coffee"`, undo)
+
+	suite.transactionRepo.On("PurgeDeletedBefore", suite.ctx, mock.AnythingOfType("time.Time")).Return([]int{4}, nil)
+	_, err := useCase.PurgeExpired(suite.ctx)
+	suite.Require().NoError(err)
+
+	undo, _ = history.Pending()
+	assert.Empty(suite.T(), undo, "the change can no longer be undone once its transaction is gone")
+}
@@ -0,0 +1,170 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"expense-tracker/internal/core/domain"
+)
+
+// UndoHistory records the changes the use cases make to transactions as commands, so they
+// can be undone and redone in order for as long as the app runs. Undo and redo refuse a
+// command once its transactions were changed some other way, e.g. by reconciling them.
+// A nil history records nothing, for use cases that do not need one.
+type UndoHistory struct {
+	transactionRepo TransactionRepository
+	uow             UnitOfWork
+	learner         *CategoryLearner
+	mu              sync.Mutex
+	stack           *domain.CommandStack
+}
+
+// NewUndoHistory keeps up to limit changes; 0 or less uses domain.DefaultCommandLimit
+func NewUndoHistory(transactionRepo TransactionRepository, uow UnitOfWork, learner *CategoryLearner, limit int) *UndoHistory {
+	return &UndoHistory{
+		transactionRepo: transactionRepo,
+		uow:             uow,
+		learner:         learner,
+		stack:           domain.NewCommandStack(limit),
+	}
+}
+
+// Pending describes what the next undo and redo would do, empty when there is nothing to do
+func (h *UndoHistory) Pending() (undo, redo string) {
+	if h == nil {
+		return "", ""
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if command := h.stack.NextUndo(); command != nil {
+		undo = command.Description
+	}
+	if command := h.stack.NextRedo(); command != nil {
+		redo = command.Description
+	}
+	return undo, redo
+}
+
+// Undo reverts the latest change and returns its description
+func (h *UndoHistory) Undo(ctx context.Context) (string, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	command := h.stack.NextUndo()
+	if command == nil {
+		return "", fmt.Errorf("nothing to undo")
+	}
+	if err := h.apply(ctx, command.Inverse()); err != nil {
+		if errors.Is(err, domain.ErrStaleCommand) {
+			h.stack.DropUndo()
+		}
+		return "", fmt.Errorf("failed to undo %s: %w", command.Description, err)
+	}
+	h.stack.Undone()
+	return command.Description, nil
+}
+
+// Redo makes the latest undone change again and returns its description
+func (h *UndoHistory) Redo(ctx context.Context) (string, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	command := h.stack.NextRedo()
+	if command == nil {
+		return "", fmt.Errorf("nothing to redo")
+	}
+	if err := h.apply(ctx, command); err != nil {
+		if errors.Is(err, domain.ErrStaleCommand) {
+			h.stack.DropRedo()
+		}
+		return "", fmt.Errorf("failed to redo %s: %w", command.Description, err)
+	}
+	h.stack.Redone()
+	return command.Description, nil
+}
+
+// apply takes the command's transactions from its before to its after state, moving the
+// ones it deletes to the trash and writing the others back as they were
+func (h *UndoHistory) apply(ctx context.Context, command *domain.Command) error {
+	err := h.uow.WithTx(ctx, func(repos Repositories) error {
+		current, err := repos.Transactions.GetByIDs(ctx, command.IDs())
+		if err != nil {
+			return err
+		}
+		if !command.AppliesTo(current) {
+			return domain.ErrStaleCommand
+		}
+
+		if deleted := command.Deleted(); len(deleted) > 0 {
+			if _, err := repos.Transactions.BulkDelete(ctx, deleted); err != nil {
+				return err
+			}
+		}
+		if len(command.After) > 0 {
+			return repos.Transactions.BulkRestore(ctx, command.After)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if h.learner != nil {
+		h.learner.Reset()
+	}
+	return nil
+}
+
+// snapshot loads the transactions a change is about to make, for recording it afterwards
+func (h *UndoHistory) snapshot(ctx context.Context, ids []int) ([]*domain.Transaction, error) {
+	if h == nil || len(ids) == 0 {
+		return nil, nil
+	}
+	return h.transactionRepo.GetByIDs(ctx, ids)
+}
+
+// record adds a change that was just saved, given the transactions it touched and their
+// state before it. The change stands even when its result cannot be loaded; it then only
+// goes unrecorded, and older commands are refused as they no longer match.
+func (h *UndoHistory) record(ctx context.Context, description string, before []*domain.Transaction, ids []int) {
+	if h == nil || len(ids) == 0 {
+		return
+	}
+	after, err := h.transactionRepo.GetByIDs(ctx, ids)
+	if err != nil {
+		return
+	}
+
+	if command := domain.NewCommand(description, before, after); command != nil {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		h.stack.Push(command)
+	}
+}
+
+// forget drops the changes to transactions that were deleted for good
+func (h *UndoHistory) forget(ids []int) {
+	if h == nil {
+		return
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.stack.Forget(ids)
+}
+
+// describeTransaction names the transaction a change touched, for its description
+func describeTransaction(transactions []*domain.Transaction) string {
+	if len(transactions) == 0 {
+		return "a transaction"
+	}
+	return fmt.Sprintf("%q", transactions[0].Description)
+}
+
+// countTransactions phrases a number of transactions, e.g. "1 transaction"
+func countTransactions(count int) string {
+	if count == 1 {
+		return "1 transaction"
+	}
+	return fmt.Sprintf("%d transactions", count)
+}
@@ -0,0 +1,187 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"expense-tracker/internal/core/domain"
+	"expense-tracker/test/mocks"
+)
+
+type UndoHistoryTestSuite struct {
+	suite.Suite
+	history         *UndoHistory
+	learner         *CategoryLearner
+	transactionRepo *mocks.MockTransactionRepository
+	lidl            *domain.Transaction
+	ctx             context.Context
+}
+
+func (suite *UndoHistoryTestSuite) SetupTest() {
+	suite.transactionRepo = mocks.NewMockTransactionRepository(suite.T())
+	suite.learner = NewCategoryLearner(suite.transactionRepo)
+	suite.history = NewUndoHistory(suite.transactionRepo, passThroughUnitOfWork{Transactions: suite.transactionRepo}, suite.learner, 0)
+	suite.lidl = &domain.Transaction{
+		ID:          1,
+		Description: "Lidl",
+		Amount:      20,
+		Date:        time.Date(2026, 10, 2, 0, 0, 0, 0, time.UTC),
+		Type:        "expense",
+		Category:    &domain.Category{ID: 1, Name: "Groceries"},
+	}
+	suite.ctx = context.Background()
+}
+
+func TestUndoHistorySuite(t *testing.T) {
+	suite.Run(t, new(UndoHistoryTestSuite))
+}
+
+// expectState makes the next lookup of the transactions with ids return them as given
+func (suite *UndoHistoryTestSuite) expectState(ids []int, transactions ...*domain.Transaction) {
+	suite.transactionRepo.On("GetByIDs", suite.ctx, ids).Return(transactions, nil).Once()
+}
+
+func (suite *UndoHistoryTestSuite) TestUndoRedo_Delete() {
+	assert := assert.New(suite.T())
+	useCase := NewTransactionUseCase(suite.transactionRepo, nil, nil, nil, passThroughUnitOfWork{Transactions: suite.transactionRepo}, suite.learner, suite.history)
+
+	suite.expectState([]int{1}, suite.lidl)
+	suite.transactionRepo.On("Delete", suite.ctx, 1).Return(nil).Once()
+	suite.expectState([]int{1})
+	suite.Require().NoError(useCase.DeleteTransaction(suite.ctx, 1))
+
+	undo, redo := suite.history.Pending()
+	assert.Equal(`Moved "Lidl" to the trash`, undo)
+	assert.Empty(redo)
+
+	suite.expectState([]int{1})
+	suite.transactionRepo.On("BulkRestore", suite.ctx, []*domain.Transaction{suite.lidl}).Return(nil).Once()
+	description, err := suite.history.Undo(suite.ctx)
+	suite.Require().NoError(err)
+	assert.Equal(`Moved "Lidl" to the trash`, description)
+
+	undo, redo = suite.history.Pending()
+	assert.Empty(undo)
+	assert.Equal(`Moved "Lidl" to the trash`, redo)
+
+	suite.expectState([]int{1}, suite.lidl)
+	suite.transactionRepo.On("BulkDelete", suite.ctx, []int{1}).Return(1, nil).Once()
+	_, err = suite.history.Redo(suite.ctx)
+	suite.Require().NoError(err)
+
+	undo, redo = suite.history.Pending()
+	assert.Equal(`Moved "Lidl" to the trash`, undo)
+	assert.Empty(redo)
+}
+
+func (suite *UndoHistoryTestSuite) TestUndo_AddMovesToTrash() {
+	suite.expectState([]int{1}, suite.lidl)
+	suite.history.record(suite.ctx, `Added "Lidl"`, nil, []int{1})
+
+	suite.expectState([]int{1}, suite.lidl)
+	suite.transactionRepo.On("BulkDelete", suite.ctx, []int{1}).Return(1, nil).Once()
+	_, err := suite.history.Undo(suite.ctx)
+	suite.Require().NoError(err)
+
+	// Redoing brings the same transaction back rather than adding another one
+	suite.expectState([]int{1})
+	suite.transactionRepo.On("BulkRestore", suite.ctx, []*domain.Transaction{suite.lidl}).Return(nil).Once()
+	_, err = suite.history.Redo(suite.ctx)
+	suite.Require().NoError(err)
+}
+
+func (suite *UndoHistoryTestSuite) TestUndo_ChangedSince() {
+	assert := assert.New(suite.T())
+
+	edited := suite.lidl.Clone()
+	edited.Amount = 25
+	suite.expectState([]int{1}, edited)
+	suite.history.record(suite.ctx, `Edited "Lidl"`, []*domain.Transaction{suite.lidl}, []int{1})
+
+	reconciled := edited.Clone()
+	reconciled.Status = domain.StatusReconciled
+	suite.expectState([]int{1}, reconciled)
+	_, err := suite.history.Undo(suite.ctx)
+
+	assert.ErrorIs(err, domain.ErrStaleCommand)
+	assert.Contains(err.Error(), `failed to undo Edited "Lidl"`)
+	suite.transactionRepo.AssertNotCalled(suite.T(), "BulkRestore")
+	undo, _ := suite.history.Pending()
+	assert.Empty(undo, "the command is dropped once it no longer applies")
+}
+
+func (suite *UndoHistoryTestSuite) TestUndo_Nothing() {
+	_, err := suite.history.Undo(suite.ctx)
+	assert.EqualError(suite.T(), err, "nothing to undo")
+
+	_, err = suite.history.Redo(suite.ctx)
+	assert.EqualError(suite.T(), err, "nothing to redo")
+}
+
+func (suite *UndoHistoryTestSuite) TestWithoutHistoryOrLearner() {
+	var none *UndoHistory
+	undo, redo := none.Pending()
+	assert.Empty(suite.T(), undo)
+	assert.Empty(suite.T(), redo)
+
+	history := NewUndoHistory(suite.transactionRepo, passThroughUnitOfWork{Transactions: suite.transactionRepo}, nil, 0)
+	suite.expectState([]int{1}, suite.lidl)
+	history.record(suite.ctx, `Added "Lidl"`, nil, []int{1})
+	suite.expectState([]int{1}, suite.lidl)
+	suite.transactionRepo.On("BulkDelete", suite.ctx, []int{1}).Return(1, nil).Once()
+	_, err := history.Undo(suite.ctx)
+	suite.Require().NoError(err)
+}
+
+func (suite *UndoHistoryTestSuite) TestRecord_NothingChanged() {
+	suite.expectState([]int{1}, suite.lidl)
+	suite.history.record(suite.ctx, `Edited "Lidl"`, []*domain.Transaction{suite.lidl.Clone()}, []int{1})
+
+	undo, _ := suite.history.Pending()
+	assert.Empty(suite.T(), undo)
+}
+
+func (suite *UndoHistoryTestSuite) TestUndo_RetrainsSuggestions() {
+	history := []*domain.Transaction{suite.lidl}
+	suite.transactionRepo.On("GetCategoryHistory", suite.ctx).Return(history, nil).Twice()
+
+	edited := suite.lidl.Clone()
+	edited.Category = &domain.Category{ID: 2, Name: "Shopping"}
+	suite.expectState([]int{1}, edited)
+	suite.history.record(suite.ctx, `Edited "Lidl"`, []*domain.Transaction{suite.lidl}, []int{1})
+
+	_, err := suite.learner.Suggest(suite.ctx, "Lidl", 20, "expense")
+	suite.Require().NoError(err)
+	suite.expectState([]int{1}, edited)
+	suite.transactionRepo.On("BulkRestore", suite.ctx, []*domain.Transaction{suite.lidl}).Return(nil).Once()
+	_, err = suite.history.Undo(suite.ctx)
+	suite.Require().NoError(err)
+	_, err = suite.learner.Suggest(suite.ctx, "Lidl", 20, "expense")
+	suite.Require().NoError(err)
+
+	suite.transactionRepo.AssertNumberOfCalls(suite.T(), "GetCategoryHistory", 2)
+}
+
+func (suite *UndoHistoryTestSuite) TestPurgeForgetsChanges() {
+	trash := NewTrashUseCase(suite.transactionRepo, suite.history, 0)
+
+	suite.expectState([]int{1})
+	suite.transactionRepo.On("RestoreDeleted", suite.ctx, []int{1}).Return(1, nil).Once()
+	suite.expectState([]int{1}, suite.lidl)
+	_, err := trash.Restore(suite.ctx, []int{1})
+	suite.Require().NoError(err)
+
+	undo, _ := suite.history.Pending()
+	assert.Equal(suite.T(), "Restored 1 transaction from the trash", undo)
+
+	suite.transactionRepo.On("Purge", suite.ctx, []int{1}).Return(1, nil).Once()
+	_, err = trash.Purge(suite.ctx, []int{1})
+	suite.Require().NoError(err)
+
+	undo, _ = suite.history.Pending()
+	assert.Empty(suite.T(), undo)
+}
@@ -3,13 +3,13 @@ package tui
 import (
 	"context"
 	"fmt"
-	"strconv"
 	"strings"
 	"time"
 
 	"expense-tracker/internal/core/domain"
 	"expense-tracker/internal/core/usecase"
 
+	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
@@ -17,12 +17,36 @@ import (
 
 type transactionCategoriesMsg struct {
 	categories []*domain.Category
+	recent     int // how many categories lead the list because they were used recently
 	err        error
 }
 
+type categoryCreatedMsg struct {
+	category *domain.Category
+	err      error
+}
+
+// pickerRows is how many categories the category popup shows at once
+const pickerRows = 10
+
+type descriptionSuggestionsMsg struct {
+	query       string
+	suggestions []*domain.DescriptionSuggestion
+}
+
+// suggestionLimit is how many earlier descriptions are offered while typing
+const suggestionLimit = 5
+
+type categorySuggestionMsg struct {
+	description string
+	suggestion  *domain.CategorySuggestion
+}
+
 type transactionSubmissionMsg struct {
-	success bool
-	err     error
+	success     bool
+	andNew      bool                // keep the form open for the next entry
+	duplicateOf *domain.Transaction // a saved transaction the entry looks like; nothing was saved
+	err         error
 }
 
 type TransactionType string
@@ -39,6 +63,7 @@ const (
 	fieldAmount
 	fieldDate
 	fieldCategory
+	fieldSplit
 	fieldSubmit
 )
 
@@ -48,31 +73,50 @@ const (
 	modeNavigate editMode = iota
 	modeEdit
 	modeCategorySelect
+	modeSplit
 )
 
 type AddTransactionModel struct {
 	transactionUseCase *usecase.TransactionUseCase
+	duplicateUseCase   *usecase.DuplicateUseCase
 	transactionType    TransactionType
 	categories         []*domain.Category
+	recentCategories   int
 	inputs             []textinput.Model
 	currentField       formField
 	selectedCategory   int
+	categoryFilter     textinput.Model
+	filtering          bool
+	pickerIndex        int
+	pickerErr          string
+	splits             []*domain.Split
+	splitInput         textinput.Model
+	splitIndex         int // line being edited, -1 while typing a new one
+	splitErr           string
 	currentMode        editMode
+	suggestions        []*domain.DescriptionSuggestion
+	suggestionIndex    int
+	categorySuggestion *domain.CategorySuggestion
+	duplicateOf        *domain.Transaction // set after a warning, so saving again keeps both
 	loading            bool
 	err                error
 	successMsg         string
+	savedCount         int
 	shouldReturn       bool
 	width              int
 	height             int
 }
 
-func NewAddTransactionModel(transactionUseCase *usecase.TransactionUseCase, transactionType TransactionType) *AddTransactionModel {
+func NewAddTransactionModel(transactionUseCase *usecase.TransactionUseCase, duplicateUseCase *usecase.DuplicateUseCase, transactionType TransactionType) *AddTransactionModel {
 	m := &AddTransactionModel{
 		transactionUseCase: transactionUseCase,
+		duplicateUseCase:   duplicateUseCase,
 		transactionType:    transactionType,
 		inputs:             make([]textinput.Model, 3),
 		currentField:       fieldDescription,
 		currentMode:        modeNavigate,
+		suggestionIndex:    -1,
+		splitIndex:         -1,
 	}
 
 	// Description input
@@ -82,13 +126,22 @@ func NewAddTransactionModel(transactionUseCase *usecase.TransactionUseCase, tran
 
 	// Amount input
 	m.inputs[1] = textinput.New()
-	m.inputs[1].Placeholder = "0.00"
-	m.inputs[1].CharLimit = 20
+	m.inputs[1].Placeholder = locale.FormatNumber(0, 2) + " or 120/3"
+	m.inputs[1].CharLimit = 64
 
 	// Date input
 	m.inputs[2] = textinput.New()
-	m.inputs[2].Placeholder = "YYYY-MM-DD (leave empty for today)"
-	m.inputs[2].CharLimit = 10
+	m.inputs[2].Placeholder = locale.InputDateFormat + ", yesterday, -3d, last fri (empty for today)"
+	m.inputs[2].CharLimit = 24
+
+	m.categoryFilter = textinput.New()
+	m.categoryFilter.Prompt = "/ "
+	m.categoryFilter.Placeholder = "type to filter or create"
+	m.categoryFilter.CharLimit = 50
+
+	m.splitInput = textinput.New()
+	m.splitInput.Placeholder = locale.FormatNumber(12.4, 2) + " household detergent (no amount for the rest)"
+	m.splitInput.CharLimit = 200
 
 	return m
 }
@@ -108,6 +161,11 @@ func (m *AddTransactionModel) Reset() {
 	m.currentField = fieldDescription
 	m.selectedCategory = 0
 	m.currentMode = modeNavigate
+	m.closePicker()
+	m.clearSplits()
+	m.clearSuggestions()
+	m.categorySuggestion = nil
+	m.duplicateOf = nil
 	m.loading = false
 	m.err = nil
 	m.successMsg = ""
@@ -122,28 +180,29 @@ func (m *AddTransactionModel) SetDimensions(width, height int) {
 func (m *AddTransactionModel) fetchCategories() tea.Cmd {
 	return tea.Cmd(func() tea.Msg {
 		ctx := context.Background()
-		categories, err := m.transactionUseCase.GetCategories(ctx, string(m.transactionType))
-		return transactionCategoriesMsg{categories: categories, err: err}
+		categories, recent, err := m.transactionUseCase.GetCategoriesByRecency(ctx, string(m.transactionType))
+		return transactionCategoriesMsg{categories: categories, recent: recent, err: err}
 	})
 }
 
-func (m *AddTransactionModel) submitTransaction() tea.Cmd {
+func (m *AddTransactionModel) submitTransaction(andNew bool) tea.Cmd {
+	checkDuplicates := m.duplicateOf == nil
 	return tea.Cmd(func() tea.Msg {
 		ctx := context.Background()
 
-		amount, err := strconv.ParseFloat(m.inputs[1].Value(), 64)
-		if err != nil || amount <= 0 {
-			return transactionSubmissionMsg{err: fmt.Errorf("invalid amount")}
+		amount, err := evaluateAmount(m.inputs[1].Value())
+		if err != nil {
+			return transactionSubmissionMsg{err: err}
 		}
 
 		dateStr := strings.TrimSpace(m.inputs[2].Value())
 		var date time.Time
 		if dateStr == "" {
-			date = time.Now()
+			date = domain.Now()
 		} else {
-			parsedDate, err := time.Parse("2006-01-02", dateStr)
+			parsedDate, err := newDateParser().Parse(dateStr)
 			if err != nil {
-				return transactionSubmissionMsg{err: fmt.Errorf("invalid date format (use YYYY-MM-DD)")}
+				return transactionSubmissionMsg{err: err}
 			}
 			date = parsedDate
 		}
@@ -159,13 +218,28 @@ func (m *AddTransactionModel) submitTransaction() tea.Cmd {
 			Type:        string(m.transactionType),
 			Category:    m.categories[m.selectedCategory],
 		}
+		if len(m.splits) > 0 {
+			// The largest line decides the category
+			transaction.Category = nil
+			for _, split := range m.splits {
+				transaction.Splits = append(transaction.Splits, &domain.Split{Category: split.Category, Amount: split.Amount, Memo: split.Memo})
+			}
+		}
+
+		if checkDuplicates {
+			// The check only warns, so when it fails the entry is saved as usual
+			pairs, err := m.duplicateUseCase.FindDuplicatesOf(ctx, transaction)
+			if err == nil && len(pairs) > 0 {
+				return transactionSubmissionMsg{andNew: andNew, duplicateOf: pairs[0].Original}
+			}
+		}
 
 		err = m.transactionUseCase.AddTransaction(ctx, transaction)
 		if err != nil {
 			return transactionSubmissionMsg{err: err}
 		}
 
-		return transactionSubmissionMsg{success: true}
+		return transactionSubmissionMsg{success: true, andNew: andNew}
 	})
 }
 
@@ -177,12 +251,46 @@ func (m *AddTransactionModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.err = msg.err
 		} else {
 			m.categories = msg.categories
+			m.recentCategories = msg.recent
 		}
 		return m, nil
 
-	case transactionSubmissionMsg:
+	case categoryCreatedMsg:
 		if msg.err != nil {
+			m.pickerErr = msg.err.Error()
+			return m, nil
+		}
+		m.categories = append(m.categories, msg.category)
+		m.selectedCategory = len(m.categories) - 1
+		m.currentMode = modeNavigate
+		m.closePicker()
+		return m, nil
+
+	case descriptionSuggestionsMsg:
+		// Drop answers to queries the user has typed past
+		if m.currentMode == modeEdit && m.currentField == fieldDescription && msg.query == m.inputs[0].Value() {
+			m.suggestions = msg.suggestions
+			m.suggestionIndex = -1
+		}
+		return m, nil
+
+	case categorySuggestionMsg:
+		// Drop answers for a description that has changed since
+		if msg.description == m.inputs[0].Value() {
+			m.categorySuggestion = msg.suggestion
+		}
+		return m, nil
+
+	case transactionSubmissionMsg:
+		m.loading = false
+		if msg.duplicateOf != nil {
+			m.duplicateOf = msg.duplicateOf
+		} else if msg.err != nil {
 			m.err = msg.err
+		} else if msg.success && msg.andNew {
+			m.savedCount++
+			m.prepareNext()
+			return m, m.inputs[0].Focus()
 		} else if msg.success {
 			m.successMsg = strings.Title(string(m.transactionType)) + " added successfully!"
 			m.shouldReturn = true
@@ -198,6 +306,8 @@ func (m *AddTransactionModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m.handleEditMode(msg)
 		case modeCategorySelect:
 			return m.handleCategorySelectMode(msg)
+		case modeSplit:
+			return m.handleSplitMode(msg)
 		}
 	}
 
@@ -206,68 +316,364 @@ func (m *AddTransactionModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 // Navigation and input handling methods (similar to original but unified)
 func (m *AddTransactionModel) handleNavigateMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
-	switch msg.String() {
-	case "ctrl+c", "esc":
+	switch {
+	case key.Matches(msg, keys.Form.Cancel):
 		m.shouldReturn = true
 		return m, nil
-	case "up", "k":
+	case key.Matches(msg, keys.Form.Up):
 		m.navigateUp()
 		return m, nil
-	case "down", "j":
+	case key.Matches(msg, keys.Form.Down):
 		m.navigateDown()
 		return m, nil
-	case "enter":
+	case key.Matches(msg, keys.Form.Edit):
 		return m.enterCurrentField()
-	case "ctrl+s":
-		return m.attemptSubmit()
+	case key.Matches(msg, keys.Form.Save):
+		return m.attemptSubmit(false)
+	case key.Matches(msg, keys.Form.SaveAndNew):
+		return m.attemptSubmit(true)
+	case key.Matches(msg, keys.Form.Reset):
+		m.Reset()
+		return m, nil
+	case key.Matches(msg, keys.Form.AcceptCategory):
+		if index := m.suggestedCategoryIndex(); index >= 0 {
+			m.selectedCategory = index
+		}
+		return m, nil
 	}
 	return m, nil
 }
 
 func (m *AddTransactionModel) handleEditMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
-	switch msg.String() {
-	case "esc":
+	if len(m.suggestions) > 0 {
+		switch {
+		case key.Matches(msg, keys.Form.NextSuggestion):
+			m.suggestionIndex = min(m.suggestionIndex+1, len(m.suggestions)-1)
+			return m, nil
+		case key.Matches(msg, keys.Form.PrevSuggestion):
+			m.suggestionIndex = max(m.suggestionIndex-1, -1)
+			return m, nil
+		case key.Matches(msg, keys.Form.AcceptSuggestion):
+			m.acceptSuggestion(max(m.suggestionIndex, 0))
+			return m, nil
+		case key.Matches(msg, keys.Form.Confirm) && m.suggestionIndex >= 0:
+			m.acceptSuggestion(m.suggestionIndex)
+		}
+	}
+
+	switch {
+	case key.Matches(msg, keys.Form.StopEditing):
 		m.currentMode = modeNavigate
 		m.inputs[m.getInputIndex()].Blur()
-		return m, nil
-	case "enter":
+		m.clearSuggestions()
+		return m, m.fetchCategorySuggestion()
+	case key.Matches(msg, keys.Form.Confirm):
+		m.clearSuggestions()
 		m.currentMode = modeNavigate
 		m.inputs[m.getInputIndex()].Blur()
 		m.navigateDown()
-		return m, nil
-	case "ctrl+u":
+		return m, m.fetchCategorySuggestion()
+	case key.Matches(msg, keys.Form.ClearField):
 		m.inputs[m.getInputIndex()].SetValue("")
 		return m, nil
 	}
 
 	if idx := m.getInputIndex(); idx >= 0 {
+		previous := m.inputs[idx].Value()
 		var cmd tea.Cmd
 		m.inputs[idx], cmd = m.inputs[idx].Update(msg)
+		if m.currentField == fieldDescription && m.inputs[idx].Value() != previous {
+			return m, tea.Batch(cmd, m.fetchSuggestions(m.inputs[idx].Value()))
+		}
 		return m, cmd
 	}
 	return m, nil
 }
 
+// fetchSuggestions looks up earlier descriptions matching what has been typed so far
+func (m *AddTransactionModel) fetchSuggestions(query string) tea.Cmd {
+	if strings.TrimSpace(query) == "" {
+		m.clearSuggestions()
+		return nil
+	}
+	return func() tea.Msg {
+		suggestions, err := m.transactionUseCase.SuggestDescriptions(context.Background(), query, string(m.transactionType), suggestionLimit)
+		if err != nil {
+			// Suggestions are a convenience; typing goes on without them
+			return descriptionSuggestionsMsg{query: query}
+		}
+		return descriptionSuggestionsMsg{query: query, suggestions: suggestions}
+	}
+}
+
+// acceptSuggestion fills in a suggested description along with the category used most
+// often with it and, unless an amount was typed already, the last amount
+func (m *AddTransactionModel) acceptSuggestion(index int) {
+	suggestion := m.suggestions[index]
+	m.inputs[0].SetValue(suggestion.Description)
+	m.inputs[0].CursorEnd()
+
+	if suggestion.Category != nil {
+		for i, category := range m.categories {
+			if category.ID == suggestion.Category.ID {
+				m.selectedCategory = i
+				break
+			}
+		}
+	}
+	if strings.TrimSpace(m.inputs[1].Value()) == "" && suggestion.LastAmount > 0 {
+		m.inputs[1].SetValue(locale.FormatNumber(suggestion.LastAmount, 2))
+	}
+
+	m.clearSuggestions()
+}
+
+// fetchCategorySuggestion asks which category earlier transactions with a similar
+// description and amount were filed under
+func (m *AddTransactionModel) fetchCategorySuggestion() tea.Cmd {
+	description := m.inputs[0].Value()
+	if strings.TrimSpace(description) == "" {
+		m.categorySuggestion = nil
+		return nil
+	}
+	// An amount that does not parse yet still leaves the description to go by
+	amount, _ := evaluateAmount(m.inputs[1].Value())
+	return func() tea.Msg {
+		suggestion, err := m.transactionUseCase.SuggestCategory(context.Background(), description, amount, string(m.transactionType))
+		if err != nil {
+			return categorySuggestionMsg{description: description}
+		}
+		return categorySuggestionMsg{description: description, suggestion: suggestion}
+	}
+}
+
+// suggestedCategoryIndex returns where the suggested category is in the list, or -1 when
+// there is nothing to suggest beyond what is selected already
+func (m *AddTransactionModel) suggestedCategoryIndex() int {
+	if m.categorySuggestion == nil || len(m.splits) > 0 {
+		return -1
+	}
+	for i, category := range m.categories {
+		if category.ID == m.categorySuggestion.Category.ID && i != m.selectedCategory {
+			return i
+		}
+	}
+	return -1
+}
+
+func (m *AddTransactionModel) clearSuggestions() {
+	m.suggestions = nil
+	m.suggestionIndex = -1
+}
+
 func (m *AddTransactionModel) handleCategorySelectMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
-	switch msg.String() {
-	case "esc":
+	// While filtering, letters are typed even when they are bound to dialog actions
+	typed := msg.Type == tea.KeyRunes || msg.Type == tea.KeySpace
+	switch {
+	case m.filtering && key.Matches(msg, keys.Input.Cancel):
+		m.filtering = false
+		m.categoryFilter.Blur()
+		m.categoryFilter.SetValue("")
+		m.pickerIndex = m.selectedCategory
+		m.pickerErr = ""
+		return m, nil
+	case !m.filtering && key.Matches(msg, keys.Dialog.Cancel):
 		m.currentMode = modeNavigate
+		m.closePicker()
 		return m, nil
-	case "up", "k":
-		if m.selectedCategory > 0 {
-			m.selectedCategory--
+	case key.Matches(msg, keys.Dialog.Up) && !(m.filtering && typed):
+		if m.pickerIndex > 0 {
+			m.pickerIndex--
 		}
 		return m, nil
-	case "down", "j":
-		if m.selectedCategory < len(m.categories)-1 {
-			m.selectedCategory++
+	case key.Matches(msg, keys.Dialog.Down) && !(m.filtering && typed):
+		if m.pickerIndex < m.pickerLen()-1 {
+			m.pickerIndex++
 		}
 		return m, nil
-	case "enter":
+	case key.Matches(msg, keys.Dialog.Select):
+		return m, m.choosePickerItem()
+	case !m.filtering && key.Matches(msg, keys.Dialog.Filter):
+		m.filtering = true
+		return m, m.categoryFilter.Focus()
+	}
+
+	// Typing anything else starts filtering with that text
+	if !typed && !m.filtering {
+		return m, nil
+	}
+	cmd := m.categoryFilter.Focus()
+	m.filtering = true
+	m.categoryFilter, _ = m.categoryFilter.Update(msg)
+	m.pickerIndex = 0
+	m.pickerErr = ""
+	return m, cmd
+}
+
+// pickerMatches returns the categories matching the filter, best match first
+func (m *AddTransactionModel) pickerMatches() []*domain.Category {
+	return domain.FilterCategories(m.categoryFilter.Value(), m.categories)
+}
+
+// canCreateCategory reports whether the popup offers to create the typed category
+func (m *AddTransactionModel) canCreateCategory() bool {
+	name := strings.TrimSpace(m.categoryFilter.Value())
+	return name != "" && domain.FindCategory(name, m.categories) == nil
+}
+
+// pickerLen is the number of rows in the popup: the matches and possibly the create option
+func (m *AddTransactionModel) pickerLen() int {
+	n := len(m.pickerMatches())
+	if m.canCreateCategory() {
+		n++
+	}
+	return n
+}
+
+// choosePickerItem selects the highlighted category, or creates the typed one when
+// the create option is highlighted
+func (m *AddTransactionModel) choosePickerItem() tea.Cmd {
+	matches := m.pickerMatches()
+	if m.pickerIndex < len(matches) {
+		for i, category := range m.categories {
+			if category == matches[m.pickerIndex] {
+				m.selectedCategory = i
+			}
+		}
 		m.currentMode = modeNavigate
+		m.closePicker()
+		return nil
+	}
+	if !m.canCreateCategory() {
+		return nil
+	}
+
+	name := strings.TrimSpace(m.categoryFilter.Value())
+	return func() tea.Msg {
+		category, err := m.transactionUseCase.CreateCategory(context.Background(), name, string(m.transactionType))
+		return categoryCreatedMsg{category: category, err: err}
+	}
+}
+
+// openPicker shows the category popup with the current category highlighted
+func (m *AddTransactionModel) openPicker() {
+	m.closePicker()
+	m.pickerIndex = m.selectedCategory
+	m.currentMode = modeCategorySelect
+}
+
+func (m *AddTransactionModel) closePicker() {
+	m.filtering = false
+	m.categoryFilter.Blur()
+	m.categoryFilter.SetValue("")
+	m.pickerIndex = 0
+	m.pickerErr = ""
+}
+
+// handleSplitMode edits the split lines. Every line is typed into one input as
+// "[amount] <category> [memo]"; the arrow keys pick an existing line to change.
+func (m *AddTransactionModel) handleSplitMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	typed := msg.Type == tea.KeyRunes || msg.Type == tea.KeySpace
+	switch {
+	case key.Matches(msg, keys.Input.Cancel):
+		if m.splitIndex >= 0 || m.splitInput.Value() != "" {
+			m.selectSplit(-1)
+			return m, nil
+		}
+		m.currentMode = modeNavigate
+		m.splitInput.Blur()
+		m.splitErr = ""
+		return m, nil
+	case key.Matches(msg, keys.Input.Apply):
+		if strings.TrimSpace(m.splitInput.Value()) == "" {
+			m.currentMode = modeNavigate
+			m.splitInput.Blur()
+			m.splitErr = ""
+			m.navigateDown()
+			return m, nil
+		}
+		m.applySplitLine()
+		return m, nil
+	case key.Matches(msg, keys.Dialog.Up) && !typed:
+		if m.splitIndex == -1 {
+			m.selectSplit(len(m.splits) - 1)
+		} else if m.splitIndex > 0 {
+			m.selectSplit(m.splitIndex - 1)
+		}
+		return m, nil
+	case key.Matches(msg, keys.Dialog.Down) && !typed:
+		if m.splitIndex >= 0 {
+			m.selectSplit(m.splitIndex + 1)
+		}
+		return m, nil
+	case key.Matches(msg, keys.Form.RemoveSplit) && m.splitIndex >= 0:
+		m.splits = append(m.splits[:m.splitIndex], m.splits[m.splitIndex+1:]...)
+		m.selectSplit(-1)
 		return m, nil
 	}
-	return m, nil
+
+	var cmd tea.Cmd
+	m.splitInput, cmd = m.splitInput.Update(msg)
+	m.splitErr = ""
+	return m, cmd
+}
+
+// selectSplit loads line index into the input for editing; -1 or an index past the last line starts a new line
+func (m *AddTransactionModel) selectSplit(index int) {
+	m.splitErr = ""
+	if index < 0 || index >= len(m.splits) {
+		m.splitIndex = -1
+		m.splitInput.SetValue("")
+		return
+	}
+	m.splitIndex = index
+	split := m.splits[index]
+	m.splitInput.SetValue(strings.TrimSpace(locale.FormatNumber(split.Amount, 2) + " " + split.Category.Name + " " + split.Memo))
+	m.splitInput.CursorEnd()
+}
+
+// applySplitLine adds the typed line, or replaces the one being edited
+func (m *AddTransactionModel) applySplitLine() {
+	remainder, _ := m.unallocated()
+	if m.splitIndex >= 0 {
+		remainder += m.splits[m.splitIndex].Amount
+	}
+
+	split, err := domain.ParseSplitLine(m.splitInput.Value(), locale, m.categories, remainder)
+	if err != nil {
+		m.splitErr = err.Error()
+		return
+	}
+	if m.splitIndex >= 0 {
+		m.splits[m.splitIndex] = split
+	} else {
+		m.splits = append(m.splits, split)
+	}
+	m.selectSplit(-1)
+}
+
+// unallocated is the part of the typed amount not yet covered by split lines; ok is false
+// while the amount field does not hold a valid amount
+func (m *AddTransactionModel) unallocated() (remainder float64, ok bool) {
+	amount, err := evaluateAmount(m.inputs[1].Value())
+	if err != nil {
+		return 0, false
+	}
+	transaction := &domain.Transaction{Amount: amount, Splits: m.splits}
+	return transaction.Unallocated(), true
+}
+
+func (m *AddTransactionModel) clearSplits() {
+	m.splits = nil
+	m.splitInput.Blur()
+	m.splitIndex = -1
+	m.splitInput.SetValue("")
+	m.splitErr = ""
+}
+
+// capturesKey reports whether the form needs a key that would otherwise take the user back to the dashboard
+func (m *AddTransactionModel) capturesKey(msg tea.KeyMsg) bool {
+	return m.currentMode != modeNavigate
 }
 
 // Helper methods
@@ -281,8 +687,10 @@ func (m *AddTransactionModel) navigateUp() {
 		m.currentField = fieldAmount
 	case fieldCategory:
 		m.currentField = fieldDate
-	case fieldSubmit:
+	case fieldSplit:
 		m.currentField = fieldCategory
+	case fieldSubmit:
+		m.currentField = fieldSplit
 	}
 }
 
@@ -295,6 +703,8 @@ func (m *AddTransactionModel) navigateDown() {
 	case fieldDate:
 		m.currentField = fieldCategory
 	case fieldCategory:
+		m.currentField = fieldSplit
+	case fieldSplit:
 		m.currentField = fieldSubmit
 	case fieldSubmit:
 		// Stay at submit
@@ -302,6 +712,10 @@ func (m *AddTransactionModel) navigateDown() {
 }
 
 func (m *AddTransactionModel) enterCurrentField() (tea.Model, tea.Cmd) {
+	if m.currentField != fieldSubmit {
+		// A changed entry is checked for duplicates again
+		m.duplicateOf = nil
+	}
 	switch m.currentField {
 	case fieldDescription, fieldAmount, fieldDate:
 		m.currentMode = modeEdit
@@ -312,11 +726,18 @@ func (m *AddTransactionModel) enterCurrentField() (tea.Model, tea.Cmd) {
 		return m, nil
 	case fieldCategory:
 		if len(m.categories) > 0 {
-			m.currentMode = modeCategorySelect
+			m.openPicker()
+		}
+		return m, nil
+	case fieldSplit:
+		if len(m.categories) > 0 {
+			m.currentMode = modeSplit
+			m.selectSplit(-1)
+			return m, m.splitInput.Focus()
 		}
 		return m, nil
 	case fieldSubmit:
-		return m.attemptSubmit()
+		return m.attemptSubmit(false)
 	}
 	return m, nil
 }
@@ -334,7 +755,7 @@ func (m *AddTransactionModel) getInputIndex() int {
 	}
 }
 
-func (m *AddTransactionModel) attemptSubmit() (tea.Model, tea.Cmd) {
+func (m *AddTransactionModel) attemptSubmit(andNew bool) (tea.Model, tea.Cmd) {
 	if m.inputs[0].Value() == "" {
 		m.err = fmt.Errorf("description is required")
 		return m, nil
@@ -350,7 +771,22 @@ func (m *AddTransactionModel) attemptSubmit() (tea.Model, tea.Cmd) {
 
 	m.loading = true
 	m.err = nil
-	return m, m.submitTransaction()
+	return m, m.submitTransaction(andNew)
+}
+
+// prepareNext clears the form for the next entry after a save-and-new, keeping the date
+// and category since a stack of receipts usually shares them, and starts typing the description
+func (m *AddTransactionModel) prepareNext() {
+	m.inputs[0].SetValue("")
+	m.inputs[1].SetValue("")
+	m.clearSplits()
+	m.clearSuggestions()
+	m.categorySuggestion = nil
+	m.duplicateOf = nil
+	m.err = nil
+	m.successMsg = fmt.Sprintf("%s added (%d this session), enter the next one", strings.Title(string(m.transactionType)), m.savedCount)
+	m.currentField = fieldDescription
+	m.currentMode = modeEdit
 }
 
 func (m *AddTransactionModel) View() string {
@@ -387,7 +823,7 @@ func (m *AddTransactionModel) View() string {
 	
 	// If category selection is active, show the popup
 	if m.currentMode == modeCategorySelect {
-		popup := m.createCategoryPopup()
+		popup := lipgloss.JoinVertical(lipgloss.Center, m.createCategoryPopup(), "", helpContent)
 		return lipgloss.Place(config.Width, config.Height, 
 			lipgloss.Center, lipgloss.Center, popup)
 	}
@@ -406,6 +842,11 @@ func (m *AddTransactionModel) createFormContent() string {
 	if m.successMsg != "" {
 		b.WriteString(successStyle.Render("✅ " + m.successMsg) + "\n\n")
 	}
+	if m.duplicateOf != nil {
+		b.WriteString(warningStyle.Render(fmt.Sprintf("⚠ Looks like a duplicate of %s, %s on %s",
+			m.duplicateOf.Description, locale.FormatAmount(m.duplicateOf.Amount), locale.FormatDate(m.duplicateOf.Date))) + "\n")
+		b.WriteString(helpDescStyle.Render("Save again to keep both") + "\n\n")
+	}
 	
 	// Create form fields
 	fields := []struct {
@@ -418,6 +859,7 @@ func (m *AddTransactionModel) createFormContent() string {
 		{"Amount", fieldAmount, m.renderFormField(fieldAmount), true},
 		{"Date", fieldDate, m.renderFormField(fieldDate), false},
 		{"Category", fieldCategory, m.renderFormField(fieldCategory), true},
+		{"Split", fieldSplit, m.renderFormField(fieldSplit), false},
 	}
 	
 	for _, field := range fields {
@@ -435,6 +877,9 @@ func (m *AddTransactionModel) createFormContent() string {
 		
 		label := formFieldLabelStyle.Render(field.label + required + ":")
 		fieldLine := fmt.Sprintf("%s%s\n   %s", indicator, label, field.content)
+		if preview := m.renderFieldPreview(field.field); preview != "" {
+			fieldLine += "\n   " + preview
+		}
 		b.WriteString(fieldLine + "\n\n")
 	}
 	
@@ -465,6 +910,8 @@ func (m *AddTransactionModel) renderFormField(field formField) string {
 		return m.renderTextInput(int(field))
 	case fieldCategory:
 		return m.renderCategoryField()
+	case fieldSplit:
+		return m.renderSplitField()
 	default:
 		return ""
 	}
@@ -485,11 +932,111 @@ func (m *AddTransactionModel) renderTextInput(index int) string {
 	return inputStyle.Render(value)
 }
 
+// renderFieldPreview shows what a typed amount or date resolves to, or why it cannot be used
+func (m *AddTransactionModel) renderFieldPreview(field formField) string {
+	switch field {
+	case fieldDescription:
+		if m.currentMode != modeEdit || m.currentField != fieldDescription {
+			return ""
+		}
+		rows := make([]string, len(m.suggestions))
+		for i, suggestion := range m.suggestions {
+			details := locale.FormatAmount(suggestion.LastAmount)
+			if suggestion.Category != nil {
+				details = suggestion.Category.Name + " · " + details
+			}
+			if i == m.suggestionIndex {
+				rows[i] = dropdownItemSelectedStyle.Render("▶ "+suggestion.Description) + " " + helpDescStyle.Render(details)
+			} else {
+				rows[i] = dropdownItemStyle.Render("  "+suggestion.Description) + " " + helpDescStyle.Render(details)
+			}
+		}
+		return strings.Join(rows, "\n   ")
+	case fieldAmount:
+		value := strings.TrimSpace(m.inputs[1].Value())
+		if value == "" {
+			return ""
+		}
+		amount, err := evaluateAmount(value)
+		if err != nil {
+			return errorStyle.Render("✗ " + err.Error())
+		}
+		if !domain.IsAmountExpression(value) {
+			return ""
+		}
+		return helpDescStyle.Render("= " + locale.FormatAmount(amount))
+	case fieldDate:
+		value := strings.TrimSpace(m.inputs[2].Value())
+		if value == "" {
+			return ""
+		}
+		date, err := newDateParser().Parse(value)
+		if err != nil {
+			return errorStyle.Render("✗ " + err.Error())
+		}
+		return helpDescStyle.Render("→ " + date.Format("Monday") + ", " + locale.FormatDate(date))
+	case fieldCategory:
+		if m.suggestedCategoryIndex() < 0 {
+			return ""
+		}
+		return helpDescStyle.Render(fmt.Sprintf("Suggested: %s (%.0f%%), %s to use",
+			m.categorySuggestion.Category.Name, m.categorySuggestion.Confidence*100, keys.Form.AcceptCategory.Help().Key))
+	case fieldSplit:
+		if m.splitErr != "" {
+			return errorStyle.Render("✗ " + m.splitErr)
+		}
+		if len(m.splits) == 0 {
+			return ""
+		}
+		remainder, ok := m.unallocated()
+		switch {
+		case !ok:
+			return errorStyle.Render("Enter the amount to see what is unallocated")
+		case remainder == 0:
+			return successStyle.Render("Fully allocated")
+		case remainder < 0:
+			return errorStyle.Render("Over by " + locale.FormatAmount(-remainder))
+		default:
+			return errorStyle.Render("Unallocated: " + locale.FormatAmount(remainder))
+		}
+	}
+	return ""
+}
+
+// renderSplitField lists the split lines followed by the input for the next one
+func (m *AddTransactionModel) renderSplitField() string {
+	editing := m.currentMode == modeSplit
+	if len(m.splits) == 0 && !editing {
+		return inputStyle.Render(inputPlaceholderStyle.Render("Not split, Enter to divide across categories"))
+	}
+
+	var rows []string
+	for i, split := range m.splits {
+		line := fmt.Sprintf("%10s  %s", locale.FormatAmount(split.Amount), split.Category.Name)
+		if split.Memo != "" {
+			line += " · " + split.Memo
+		}
+		if editing && i == m.splitIndex {
+			rows = append(rows, dropdownItemSelectedStyle.Render("▶ "+line))
+		} else {
+			rows = append(rows, dropdownItemStyle.Render("  "+line))
+		}
+	}
+	if editing {
+		rows = append(rows, inputFocusedStyle.Render(m.splitInput.View()))
+	}
+	return strings.Join(rows, "\n   ")
+}
+
 func (m *AddTransactionModel) renderCategoryField() string {
 	if len(m.categories) == 0 {
 		return inputStyle.Render(inputPlaceholderStyle.Render("Loading categories..."))
 	}
 	
+	if len(m.splits) > 0 {
+		return inputStyle.Render(inputPlaceholderStyle.Render("Split, see below"))
+	}
+
 	selectedCategory := m.categories[m.selectedCategory].Name
 	
 	if m.currentMode == modeCategorySelect {
@@ -509,32 +1056,34 @@ func (m *AddTransactionModel) renderSubmitButton(text string) string {
 
 
 func (m *AddTransactionModel) createFormHelpText() string {
-	var helpTexts []string
+	var bindings []key.Binding
+	width := NewCenterConfig(m.width, m.height).CalculateContentWidth()
 	
 	switch m.currentMode {
 	case modeNavigate:
-		helpTexts = []string{
-			helpKeyStyle.Render("↑/↓") + " Navigate",
-			helpKeyStyle.Render("Enter") + " Edit/Select", 
-			helpKeyStyle.Render("Ctrl+S") + " Save",
-			helpKeyStyle.Render("Esc") + " Cancel",
-		}
+		return renderFooterHelp([]key.Binding{keys.Form.Up, keys.Form.Down, keys.Form.Edit, keys.Form.Save, keys.Form.SaveAndNew, enabledIf(keys.Form.AcceptCategory, m.suggestedCategoryIndex() >= 0)}, keys.Form.Cancel, width)
 	case modeEdit:
-		helpTexts = []string{
-			helpKeyStyle.Render("Type") + " to edit",
-			helpKeyStyle.Render("Enter") + " Confirm",
-			helpKeyStyle.Render("Ctrl+U") + " Clear",
-			helpKeyStyle.Render("Esc") + " Cancel",
+		bindings = []key.Binding{keys.Form.Confirm, keys.Form.ClearField, keys.Form.StopEditing}
+		if len(m.suggestions) > 0 {
+			bindings = append([]key.Binding{keys.Form.AcceptSuggestion, keys.Form.NextSuggestion}, bindings...)
+		}
+	case modeSplit:
+		apply := withHelp(keys.Input.Apply, "Add line")
+		if m.splitIndex >= 0 {
+			apply = withHelp(keys.Input.Apply, "Update line")
+		} else if strings.TrimSpace(m.splitInput.Value()) == "" {
+			apply = withHelp(keys.Input.Apply, "Done")
 		}
+		bindings = []key.Binding{apply, withHelp(keys.Dialog.Up, "Pick line"), enabledIf(keys.Form.RemoveSplit, m.splitIndex >= 0), keys.Input.Cancel}
 	case modeCategorySelect:
-		helpTexts = []string{
-			helpKeyStyle.Render("↑/↓") + " Navigate",
-			helpKeyStyle.Render("Enter") + " Select", 
-			helpKeyStyle.Render("Esc") + " Cancel",
+		if m.filtering {
+			bindings = []key.Binding{keys.Dialog.Up, keys.Dialog.Down, keys.Dialog.Select, withHelp(keys.Input.Cancel, "Clear filter")}
+		} else {
+			bindings = []key.Binding{keys.Dialog.Up, keys.Dialog.Down, keys.Dialog.Select, keys.Dialog.Filter, keys.Dialog.Cancel}
 		}
 	}
 	
-	return strings.Join(helpTexts, " • ")
+	return renderShortHelp(bindings, width)
 }
 
 // createCategoryPopup creates a centered popup for category selection
@@ -545,15 +1094,48 @@ func (m *AddTransactionModel) createCategoryPopup() string {
 	header := modalHeaderStyle.Render("Select Category")
 	b.WriteString(header + "\n\n")
 	
-	// Category list
-	for i, category := range m.categories {
-		if i == m.selectedCategory {
-			b.WriteString(dropdownItemSelectedStyle.Render("▶ " + category.Name))
+	if m.filtering || m.categoryFilter.Value() != "" {
+		b.WriteString(m.categoryFilter.View() + "\n\n")
+	} else {
+		b.WriteString(helpDescStyle.Render("Type to filter or create") + "\n\n")
+	}
+
+	// Category list, scrolled so the highlighted row stays visible
+	matches := m.pickerMatches()
+	rows := make([]string, 0, len(matches)+1)
+	for _, category := range matches {
+		rows = append(rows, category.Name)
+	}
+	if m.canCreateCategory() {
+		rows = append(rows, fmt.Sprintf("+ Create '%s'", strings.TrimSpace(m.categoryFilter.Value())))
+	}
+	if len(rows) == 0 {
+		b.WriteString(helpDescStyle.Render("No matching categories") + "\n")
+	}
+
+	start := max(0, min(m.pickerIndex-pickerRows/2, len(rows)-pickerRows))
+	end := min(len(rows), start+pickerRows)
+	grouped := m.categoryFilter.Value() == "" && m.recentCategories > 0 && m.recentCategories < len(m.categories)
+	for i := start; i < end; i++ {
+		if grouped && i == 0 {
+			b.WriteString(helpDescStyle.Render("Recent") + "\n")
+		}
+		if grouped && i == m.recentCategories {
+			b.WriteString(helpDescStyle.Render("All") + "\n")
+		}
+		if i == m.pickerIndex {
+			b.WriteString(dropdownItemSelectedStyle.Render("▶ " + rows[i]))
 		} else {
-			b.WriteString(dropdownItemStyle.Render("  " + category.Name))
+			b.WriteString(dropdownItemStyle.Render("  " + rows[i]))
 		}
 		b.WriteString("\n")
 	}
+	if len(rows) > pickerRows {
+		b.WriteString(helpDescStyle.Render(fmt.Sprintf("%d of %d", m.pickerIndex+1, len(rows))) + "\n")
+	}
+	if m.pickerErr != "" {
+		b.WriteString(errorStyle.Render(m.pickerErr) + "\n")
+	}
 	
 	// Create modal popup
 	return modalStyle.Render(b.String())
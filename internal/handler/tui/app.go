@@ -3,7 +3,9 @@ package tui
 import (
 	"expense-tracker/internal/core/usecase"
 
+	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
 )
 
 type sessionState int
@@ -13,6 +15,11 @@ const (
 	addExpenseView
 	addIncomeView
 	listTransactionsView
+	rulesView
+	duplicatesView
+	reconcileView
+	changesView
+	trashView
 )
 
 type Model struct {
@@ -21,25 +28,56 @@ type Model struct {
 	height             int
 	transactionUseCase *usecase.TransactionUseCase
 	summaryUseCase     *usecase.SummaryUseCase
+	ruleUseCase        *usecase.RuleUseCase
+	duplicateUseCase   *usecase.DuplicateUseCase
+	reconciliationUseCase *usecase.ReconciliationUseCase
+	auditUseCase       *usecase.AuditUseCase
+	trashUseCase       *usecase.TrashUseCase
+	history            *usecase.UndoHistory
 	dashboardModel     *DashboardModel
 	addTransactionModel *AddTransactionModel
 	transactionsModel  *TransactionsModel
+	rulesModel         *RulesModel
+	duplicatesModel    *DuplicatesModel
+	reconcileModel     *ReconcileModel
+	changesModel       *ChangesModel
+	trashModel         *TrashModel
+	showHelp           bool
+	historyStatus      string // outcome of the last undo or redo
+	historyErr         error
 }
 
 func NewModel(
 	transactionUseCase *usecase.TransactionUseCase,
 	summaryUseCase *usecase.SummaryUseCase,
+	ruleUseCase *usecase.RuleUseCase,
+	duplicateUseCase *usecase.DuplicateUseCase,
+	reconciliationUseCase *usecase.ReconciliationUseCase,
+	auditUseCase *usecase.AuditUseCase,
+	trashUseCase *usecase.TrashUseCase,
+	history *usecase.UndoHistory,
 ) *Model {
 	m := &Model{
 		state:              dashboardView,
 		transactionUseCase: transactionUseCase,
 		summaryUseCase:     summaryUseCase,
+		ruleUseCase:        ruleUseCase,
+		duplicateUseCase:   duplicateUseCase,
+		reconciliationUseCase: reconciliationUseCase,
+		auditUseCase:       auditUseCase,
+		trashUseCase:       trashUseCase,
+		history:            history,
 	}
 
-	m.dashboardModel = NewDashboardModel(summaryUseCase)
+	m.dashboardModel = NewDashboardModel(summaryUseCase, transactionUseCase)
 	// Start with expense as default - will be reconfigured when needed
-	m.addTransactionModel = NewAddTransactionModel(transactionUseCase, TransactionTypeExpense)
-	m.transactionsModel = NewTransactionsModel(summaryUseCase)
+	m.addTransactionModel = NewAddTransactionModel(transactionUseCase, duplicateUseCase, TransactionTypeExpense)
+	m.transactionsModel = NewTransactionsModel(transactionUseCase, summaryUseCase, auditUseCase)
+	m.rulesModel = NewRulesModel(ruleUseCase)
+	m.duplicatesModel = NewDuplicatesModel(duplicateUseCase)
+	m.reconcileModel = NewReconcileModel(reconciliationUseCase)
+	m.changesModel = NewChangesModel(auditUseCase)
+	m.trashModel = NewTrashModel(trashUseCase)
 
 	return m
 }
@@ -55,19 +93,46 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.height = msg.Height
 		
 		// Update dimensions for all models that need responsive layout
-		m.dashboardModel.SetDimensions(msg.Width, msg.Height)
-		m.transactionsModel.SetDimensions(msg.Width, msg.Height)
-		m.addTransactionModel.SetDimensions(msg.Width, msg.Height)
+		m.dashboardModel.SetDimensions(msg.Width, msg.Height-statusBarHeight)
+		m.transactionsModel.SetDimensions(msg.Width, msg.Height-statusBarHeight)
+		m.addTransactionModel.SetDimensions(msg.Width, msg.Height-statusBarHeight)
+		m.rulesModel.SetDimensions(msg.Width, msg.Height-statusBarHeight)
+		m.duplicatesModel.SetDimensions(msg.Width, msg.Height-statusBarHeight)
+		m.reconcileModel.SetDimensions(msg.Width, msg.Height-statusBarHeight)
+		m.changesModel.SetDimensions(msg.Width, msg.Height-statusBarHeight)
+		m.trashModel.SetDimensions(msg.Width, msg.Height-statusBarHeight)
 		
 		return m, nil
 
+	case historyMsg:
+		return m.updateHistory(msg)
+
 	case tea.KeyMsg:
-		// Global navigation - works in all views
-		switch msg.String() {
-		case "ctrl+c":
+		if key.Matches(msg, keys.Global.ForceQuit) {
 			return m, tea.Quit
-			
-		case "q", "esc":
+		}
+		m.historyStatus, m.historyErr = "", nil
+
+		// The help overlay swallows every key until it is closed
+		if m.showHelp {
+			if key.Matches(msg, keys.Global.Help, keys.Global.Back) {
+				m.showHelp = false
+			}
+			return m, nil
+		}
+
+		// Views that are typing text or showing a dialog get every key first
+		if m.capturesKey(msg) {
+			break
+		}
+
+		// Global navigation - works in all views
+		switch {
+		case key.Matches(msg, keys.Global.Help):
+			m.showHelp = true
+			return m, nil
+
+		case key.Matches(msg, keys.Global.Back):
 			// Context-sensitive quit/back behavior
 			if m.state == dashboardView {
 				return m, tea.Quit
@@ -75,38 +140,60 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			// Go back to dashboard from other views
 			m.state = dashboardView
 			return m, m.dashboardModel.Refresh()
+
+		case key.Matches(msg, keys.Global.Undo) && m.undoAvailable():
+			return m, m.undo()
+
+		case key.Matches(msg, keys.Global.Redo) && m.undoAvailable():
+			return m, m.redo()
 		}
 
 		// Dashboard-specific navigation
 		if m.state == dashboardView {
-			switch msg.String() {
-			case "a":
+			switch {
+			case key.Matches(msg, keys.Dashboard.AddExpense):
 				// Configure for expense and switch to form
-				m.addTransactionModel = NewAddTransactionModel(m.transactionUseCase, TransactionTypeExpense)
-				m.addTransactionModel.SetDimensions(m.width, m.height)
+				m.addTransactionModel = NewAddTransactionModel(m.transactionUseCase, m.duplicateUseCase, TransactionTypeExpense)
+				m.addTransactionModel.SetDimensions(m.width, m.height-statusBarHeight)
 				m.state = addExpenseView
 				m.addTransactionModel.Reset()
 				return m, m.addTransactionModel.Init()
 
-			case "i":
+			case key.Matches(msg, keys.Dashboard.AddIncome):
 				// Configure for income and switch to form
-				m.addTransactionModel = NewAddTransactionModel(m.transactionUseCase, TransactionTypeIncome)
-				m.addTransactionModel.SetDimensions(m.width, m.height)
+				m.addTransactionModel = NewAddTransactionModel(m.transactionUseCase, m.duplicateUseCase, TransactionTypeIncome)
+				m.addTransactionModel.SetDimensions(m.width, m.height-statusBarHeight)
 				m.state = addIncomeView
 				m.addTransactionModel.Reset()
 				return m, m.addTransactionModel.Init()
 
-			case "l":
+			case key.Matches(msg, keys.Dashboard.List):
 				m.state = listTransactionsView
 				return m, m.transactionsModel.Init()
+
+			case key.Matches(msg, keys.Dashboard.Rules):
+				m.state = rulesView
+				return m, m.rulesModel.Init()
+
+			case key.Matches(msg, keys.Dashboard.Duplicates):
+				m.state = duplicatesView
+				return m, m.duplicatesModel.Init()
+
+			case key.Matches(msg, keys.Dashboard.Reconcile):
+				m.state = reconcileView
+				return m, m.reconcileModel.Init()
+
+			case key.Matches(msg, keys.Dashboard.Changes):
+				m.state = changesView
+				return m, m.changesModel.Init()
+
+			case key.Matches(msg, keys.Dashboard.Trash):
+				m.state = trashView
+				return m, m.trashModel.Init()
 				
-			case "r":
+			case key.Matches(msg, keys.Dashboard.Refresh):
 				// Refresh data
 				return m, m.dashboardModel.Refresh()
-				
-			case "?", "h":
-				// TODO: Show help modal in future iteration
-				return m, nil
 			}
 		}
 	}
@@ -132,12 +219,69 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		transactionsModel, cmd := m.transactionsModel.Update(msg)
 		m.transactionsModel = transactionsModel.(*TransactionsModel)
 		return m, cmd
+
+	case rulesView:
+		rulesModel, cmd := m.rulesModel.Update(msg)
+		m.rulesModel = rulesModel.(*RulesModel)
+		return m, cmd
+
+	case duplicatesView:
+		duplicatesModel, cmd := m.duplicatesModel.Update(msg)
+		m.duplicatesModel = duplicatesModel.(*DuplicatesModel)
+		return m, cmd
+
+	case reconcileView:
+		reconcileModel, cmd := m.reconcileModel.Update(msg)
+		m.reconcileModel = reconcileModel.(*ReconcileModel)
+		return m, cmd
+
+	case changesView:
+		changesModel, cmd := m.changesModel.Update(msg)
+		m.changesModel = changesModel.(*ChangesModel)
+		return m, cmd
+
+	case trashView:
+		trashModel, cmd := m.trashModel.Update(msg)
+		m.trashModel = trashModel.(*TrashModel)
+		return m, cmd
 	}
 
 	return m, cmd
 }
 
+// capturesKey reports whether the active view needs a key before the global bindings see it
+func (m Model) capturesKey(msg tea.KeyMsg) bool {
+	switch m.state {
+	case dashboardView:
+		return m.dashboardModel.capturesKey(msg)
+	case addExpenseView, addIncomeView:
+		return m.addTransactionModel.capturesKey(msg)
+	case listTransactionsView:
+		return m.transactionsModel.capturesKey(msg)
+	case rulesView:
+		return m.rulesModel.capturesKey(msg)
+	case duplicatesView:
+		return m.duplicatesModel.capturesKey(msg)
+	case reconcileView:
+		return m.reconcileModel.capturesKey(msg)
+	case changesView:
+		return m.changesModel.capturesKey(msg)
+	case trashView:
+		return m.trashModel.capturesKey(msg)
+	}
+	return false
+}
+
 func (m Model) View() string {
+	if m.showHelp {
+		return renderHelpOverlay(m.state, m.width, m.height)
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, m.viewContent(), m.renderStatusBar())
+}
+
+// viewContent renders the current view above the status bar
+func (m Model) viewContent() string {
 	switch m.state {
 	case dashboardView:
 		return m.dashboardModel.View()
@@ -145,6 +289,16 @@ func (m Model) View() string {
 		return m.addTransactionModel.View()
 	case listTransactionsView:
 		return m.transactionsModel.View()
+	case rulesView:
+		return m.rulesModel.View()
+	case duplicatesView:
+		return m.duplicatesModel.View()
+	case reconcileView:
+		return m.reconcileModel.View()
+	case changesView:
+		return m.changesModel.View()
+	case trashView:
+		return m.trashModel.View()
 	default:
 		return "Unknown view"
 	}
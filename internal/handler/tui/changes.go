@@ -0,0 +1,193 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"expense-tracker/internal/core/domain"
+	"expense-tracker/internal/core/usecase"
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// recentChangesLimit is how many audit entries the recent changes screen loads
+const recentChangesLimit = 200
+
+type recentChangesMsg struct {
+	entries []*domain.AuditEntry
+	err     error
+}
+
+// ChangesModel lists the latest entries of the audit log, with the fields the highlighted
+// entry changed below the table
+type ChangesModel struct {
+	auditUseCase *usecase.AuditUseCase
+	entries      []*domain.AuditEntry
+	cursor       int
+	loading      bool
+	err          error
+	width        int
+	height       int
+}
+
+func NewChangesModel(auditUseCase *usecase.AuditUseCase) *ChangesModel {
+	return &ChangesModel{auditUseCase: auditUseCase}
+}
+
+// Init loads the latest changes
+func (m *ChangesModel) Init() tea.Cmd {
+	m.cursor = 0
+	m.err = nil
+	m.loading = true
+	return func() tea.Msg {
+		entries, err := m.auditUseCase.RecentChanges(context.Background(), recentChangesLimit)
+		return recentChangesMsg{entries: entries, err: err}
+	}
+}
+
+// SetDimensions updates the model's width and height for responsive layout
+func (m *ChangesModel) SetDimensions(width, height int) {
+	m.width = width
+	m.height = height
+}
+
+func (m *ChangesModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case recentChangesMsg:
+		m.loading = false
+		m.entries = msg.entries
+		m.err = msg.err
+
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, keys.Changes.Up):
+			if m.cursor > 0 {
+				m.cursor--
+			}
+		case key.Matches(msg, keys.Changes.Down):
+			if m.cursor < len(m.entries)-1 {
+				m.cursor++
+			}
+		}
+	}
+	return m, nil
+}
+
+// capturesKey reports whether the recent changes screen needs a key that would otherwise take the user back
+func (m *ChangesModel) capturesKey(msg tea.KeyMsg) bool {
+	return false
+}
+
+func (m *ChangesModel) View() string {
+	config := NewCenterConfig(m.width, m.height)
+
+	if m.loading {
+		content := loadingStyle.Render("Loading changes...")
+		return lipgloss.Place(config.Width, config.Height,
+			lipgloss.Center, lipgloss.Center, content)
+	}
+
+	panel := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(colorNeutral).
+		Width(config.CalculateContentWidth()-4).
+		Height(max(config.Height-10, 6)).
+		Padding(1, 2).
+		Align(lipgloss.Left).
+		Render(m.createChangesPanel())
+
+	hasEntries := len(m.entries) > 0
+	helpPanel := renderFooterHelp([]key.Binding{
+		enabledIf(keys.Changes.Up, hasEntries),
+		enabledIf(keys.Changes.Down, hasEntries),
+	}, keys.Global.Back, config.CalculateContentWidth())
+
+	fullContent := lipgloss.JoinVertical(
+		lipgloss.Center,
+		titleStyle.Render("🕘 Recent Changes"),
+		"",
+		panel,
+		"",
+		helpPanel,
+	)
+
+	return lipgloss.Place(config.Width, config.Height,
+		lipgloss.Center, lipgloss.Center, fullContent)
+}
+
+// createChangesPanel renders the table of changes and the fields of the highlighted one
+func (m *ChangesModel) createChangesPanel() string {
+	var b strings.Builder
+
+	b.WriteString(summaryHeaderStyle.Render(fmt.Sprintf("🕘 Latest %d changes to transactions and categories", recentChangesLimit)) + "\n\n")
+
+	if m.err != nil {
+		b.WriteString(errorStyle.Render("❌ " + m.err.Error()))
+		return b.String()
+	}
+	if len(m.entries) == 0 {
+		b.WriteString(helpStyle.Render("Nothing has changed since the audit log was introduced."))
+		return b.String()
+	}
+
+	panelWidth := NewCenterConfig(m.width, m.height).CalculateContentWidth() - 8
+	columns := []TableColumn{
+		{Header: "When", Width: 18, Alignment: lipgloss.Left},
+		{Header: "Who", Width: 10, Alignment: lipgloss.Left},
+		{Header: "What", Width: max(panelWidth*45/100-30, 15), Alignment: lipgloss.Left},
+		{Header: "Change", Width: max(panelWidth*55/100-4, 12), Alignment: lipgloss.Left},
+	}
+
+	totalWidth := 0
+	for _, col := range columns {
+		totalWidth += col.Width + 1
+	}
+	b.WriteString(CreateTableHeader(columns) + "\n" + CreateTableSeparator(totalWidth-1) + "\n")
+
+	// Keep the cursor in view, leaving room for the field list below the table
+	visibleRows := max(NewCenterConfig(m.width, m.height).Height-30, 3)
+	start := min(max(m.cursor-visibleRows/2, 0), max(len(m.entries)-visibleRows, 0))
+	for i := start; i < min(start+visibleRows, len(m.entries)); i++ {
+		entry := m.entries[i]
+		what := fmt.Sprintf("%s %d", entry.Entity, entry.EntityID)
+		if subject := entry.Subject(); subject != "" {
+			what += " · " + subject
+		}
+		change := entry.Summary()
+		if entry.Action == domain.AuditUpdate {
+			change = "changed " + change
+		}
+		row := FormatTableRow(columns, []string{
+			locale.FormatDate(entry.At) + " " + entry.At.Format("15:04"),
+			TruncateWithEllipsis(auditActor(entry), columns[1].Width),
+			TruncateWithEllipsis(what, columns[2].Width),
+			TruncateWithEllipsis(change, columns[3].Width),
+		})
+		if i == m.cursor {
+			b.WriteString(tableRowSelectedStyle.Render(row))
+		} else if i%2 == 0 {
+			b.WriteString(tableRowStyle.Render(row))
+		} else {
+			b.WriteString(tableRowAltStyle.Render(row))
+		}
+		b.WriteString("\n")
+	}
+	if len(m.entries) > visibleRows {
+		b.WriteString(infoStyle.Render(fmt.Sprintf("%d of %d", m.cursor+1, len(m.entries))) + "\n")
+	}
+
+	changes, err := m.entries[m.cursor].Changes()
+	if err != nil {
+		b.WriteString("\n" + errorStyle.Render(err.Error()))
+		return b.String()
+	}
+	b.WriteString("\n")
+	for _, change := range changes {
+		b.WriteString(helpDescStyle.Render(change.Field+": ") +
+			auditChangeValue(change.Before) + helpDescStyle.Render(" → ") + auditChangeValue(change.After) + "\n")
+	}
+
+	return b.String()
+}
@@ -3,11 +3,15 @@ package tui
 import (
 	"context"
 	"fmt"
+	"hash/fnv"
+	"sort"
 	"strings"
 	"time"
 
 	"expense-tracker/internal/core/domain"
 	"expense-tracker/internal/core/usecase"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
@@ -18,19 +22,56 @@ type summaryMsg struct {
 	err          error
 }
 
+type quickAddMsg struct {
+	transaction *domain.Transaction
+	err         error
+}
+
+// breakdownTopCategories is how many categories the breakdown chart shows before grouping the rest as "Other"
+const breakdownTopCategories = 5
+
 type DashboardModel struct {
-	summaryUseCase *usecase.SummaryUseCase
+	summaryUseCase     *usecase.SummaryUseCase
+	transactionUseCase *usecase.TransactionUseCase
 	summary        *domain.Summary
 	transactions   []*domain.Transaction
+	breakdownType  string // "expense" or "income"
+	byPayee        bool   // break the totals down by payee instead of category
+	periodType     domain.PeriodType
+	reference      time.Time         // any moment inside the displayed period
+	customRange    *domain.DateRange // set while a custom range is displayed
+	rangeInput     textinput.Model
+	editingRange   bool
+	rangeErr       string
+	quickInput     textinput.Model
+	editingQuick   bool
+	quickErr       string
+	statusMsg      string
 	loading        bool
 	err            error
 	width          int
 	height         int
 }
 
-func NewDashboardModel(summaryUseCase *usecase.SummaryUseCase) *DashboardModel {
+func NewDashboardModel(summaryUseCase *usecase.SummaryUseCase, transactionUseCase *usecase.TransactionUseCase) *DashboardModel {
+	rangeInput := textinput.New()
+	rangeInput.Placeholder = locale.FormatInputDate(time.Date(2026, 1, 1, 0, 0, 0, 0, time.Local)) + ".." + locale.FormatInputDate(time.Date(2026, 3, 31, 0, 0, 0, 0, time.Local))
+	rangeInput.CharLimit = 30
+	rangeInput.Width = 30
+
+	quickInput := textinput.New()
+	quickInput.Placeholder = locale.FormatNumber(42.5, 2) + " food Lidl yesterday"
+	quickInput.CharLimit = 120
+	quickInput.Width = 40
+
 	return &DashboardModel{
-		summaryUseCase: summaryUseCase,
+		summaryUseCase:     summaryUseCase,
+		transactionUseCase: transactionUseCase,
+		quickInput:         quickInput,
+		breakdownType:  "expense",
+		periodType:     domain.PeriodTypeMonth,
+		reference:      domain.Now(),
+		rangeInput:     rangeInput,
 		loading:        true,
 	}
 }
@@ -48,11 +89,19 @@ func (m *DashboardModel) fetchData() tea.Cmd {
 	return tea.Cmd(func() tea.Msg {
 		ctx := context.Background()
 
-		// Use the enhanced summary system with intelligent defaults (current month)
-		summary, err := m.summaryUseCase.GetSummary(ctx)
+		var summary *domain.Summary
+		var err error
+		if m.customRange != nil {
+			summary, err = m.summaryUseCase.GetCustomSummary(ctx, m.customRange.Start, m.customRange.End)
+		} else {
+			summary, err = m.summaryUseCase.GetSummaryWithComparison(ctx, m.periodType, m.reference)
+		}
 		if err != nil {
 			return summaryMsg{err: err}
 		}
+		if err := m.summaryUseCase.AddPayeeBreakdowns(ctx, summary); err != nil {
+			return summaryMsg{err: err}
+		}
 
 		transactions, err := m.summaryUseCase.GetRecentTransactions(ctx, 10)
 		if err != nil {
@@ -78,10 +127,140 @@ func (m *DashboardModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.err = nil
 		}
 		return m, nil
+
+	case quickAddMsg:
+		if msg.err != nil {
+			m.quickErr = msg.err.Error()
+			return m, nil
+		}
+		m.editingQuick = false
+		m.quickInput.Blur()
+		transaction := msg.transaction
+		amount := locale.FormatAmount(-transaction.Amount)
+		if transaction.Type == "income" {
+			amount = locale.FormatSignedAmount(transaction.Amount)
+		}
+		m.statusMsg = fmt.Sprintf("Added %s %s · %s · %s", transaction.Description, amount, transaction.Category.Name, locale.FormatShortDate(transaction.Date))
+		return m, m.Refresh()
+
+	case tea.KeyMsg:
+		if m.editingRange {
+			return m, m.updateRangeInput(msg)
+		}
+		if m.editingQuick {
+			return m, m.updateQuickInput(msg)
+		}
+		m.statusMsg = ""
+
+		switch {
+		case key.Matches(msg, keys.Dashboard.PeriodType):
+			// Cycle week → month → quarter → year, keeping the displayed date
+			if m.customRange != nil {
+				m.reference = m.customRange.Start
+				m.customRange = nil
+			} else {
+				m.periodType = m.periodType.Next()
+			}
+			return m, m.Refresh()
+		case key.Matches(msg, keys.Dashboard.PrevPeriod):
+			return m, m.shiftPeriod(-1)
+		case key.Matches(msg, keys.Dashboard.NextPeriod):
+			return m, m.shiftPeriod(1)
+		case key.Matches(msg, keys.Dashboard.Today):
+			// Back to the period containing today
+			m.customRange = nil
+			m.reference = domain.Now()
+			return m, m.Refresh()
+		case key.Matches(msg, keys.Dashboard.DateRange):
+			m.editingRange = true
+			m.rangeErr = ""
+			m.rangeInput.SetValue("")
+			return m, m.rangeInput.Focus()
+		case key.Matches(msg, keys.Dashboard.QuickAdd):
+			m.editingQuick = true
+			m.quickErr = ""
+			m.quickInput.SetValue("")
+			return m, m.quickInput.Focus()
+		case key.Matches(msg, keys.Dashboard.Breakdown):
+			// Toggle between the expense and income breakdown
+			if m.breakdownType == "expense" {
+				m.breakdownType = "income"
+			} else {
+				m.breakdownType = "expense"
+			}
+			return m, nil
+		case key.Matches(msg, keys.Dashboard.Payees):
+			m.byPayee = !m.byPayee
+			return m, nil
+		}
 	}
 	return m, nil
 }
 
+// capturesKey reports whether the dashboard needs a key that would otherwise trigger a global action
+func (m *DashboardModel) capturesKey(msg tea.KeyMsg) bool {
+	return m.editingRange || m.editingQuick
+}
+
+// shiftPeriod moves the displayed period by steps; custom ranges move by their own length
+func (m *DashboardModel) shiftPeriod(steps int) tea.Cmd {
+	if m.customRange != nil {
+		m.customRange = domain.ShiftDateRange(m.customRange, steps)
+	} else {
+		m.reference = domain.ShiftPeriod(m.periodType, m.reference, steps)
+	}
+	return m.Refresh()
+}
+
+// updateRangeInput handles keys while the custom date range prompt is open
+func (m *DashboardModel) updateRangeInput(msg tea.KeyMsg) tea.Cmd {
+	switch {
+	case key.Matches(msg, keys.Input.Cancel):
+		m.editingRange = false
+		m.rangeInput.Blur()
+		return nil
+	case key.Matches(msg, keys.Input.Apply):
+		dateRange, err := domain.ParseDateRange(m.rangeInput.Value(), locale)
+		if err != nil {
+			m.rangeErr = err.Error()
+			return nil
+		}
+		m.editingRange = false
+		m.rangeInput.Blur()
+		m.customRange = dateRange
+		return m.Refresh()
+	}
+
+	var cmd tea.Cmd
+	m.rangeInput, cmd = m.rangeInput.Update(msg)
+	return cmd
+}
+
+// updateQuickInput handles keys while the quick add prompt is open
+func (m *DashboardModel) updateQuickInput(msg tea.KeyMsg) tea.Cmd {
+	switch {
+	case key.Matches(msg, keys.Input.Cancel):
+		m.editingQuick = false
+		m.quickInput.Blur()
+		return nil
+	case key.Matches(msg, keys.Input.Apply):
+		entry, err := domain.ParseQuickAdd(m.quickInput.Value(), locale, newDateParser())
+		if err != nil {
+			m.quickErr = err.Error()
+			return nil
+		}
+		m.quickErr = ""
+		return func() tea.Msg {
+			transaction, err := m.transactionUseCase.QuickAdd(context.Background(), entry)
+			return quickAddMsg{transaction: transaction, err: err}
+		}
+	}
+
+	var cmd tea.Cmd
+	m.quickInput, cmd = m.quickInput.Update(msg)
+	return cmd
+}
+
 func (m *DashboardModel) View() string {
 	// Use full terminal dimensions and auto-scale content
 	config := NewCenterConfig(m.width, m.height)
@@ -126,69 +305,215 @@ func (m *DashboardModel) View() string {
 func (m *DashboardModel) createSummaryPanel() string {
 	var b strings.Builder
 	
-	// Header with current month/year
-	now := time.Now()
-	monthYear := fmt.Sprintf("%s %d", now.Month().String(), now.Year())
-	header := summaryHeaderStyle.Render("📊 " + monthYear + " Summary")
-	b.WriteString(header + "\n\n")
+	// Header with the displayed period
+	header := summaryHeaderStyle.Render("📊 " + domain.PeriodLabel(m.summary.Period, m.summary.DateRange, locale) + " Summary")
+	b.WriteString(header + "  " + helpStyle.Render("◂ "+m.periodName()+" ▸") + "\n")
+	if m.editingRange {
+		b.WriteString("Date range: " + m.rangeInput.View())
+		if m.rangeErr != "" {
+			b.WriteString("  " + errorStyle.Render(m.rangeErr))
+		}
+		b.WriteString("\n")
+	}
+	if m.editingQuick {
+		b.WriteString("Quick add: " + m.quickInput.View())
+		if m.quickErr != "" {
+			b.WriteString("\n" + errorStyle.Render(m.quickErr))
+		}
+		b.WriteString("\n")
+	} else if m.statusMsg != "" {
+		b.WriteString(successStyle.Render("✅ "+m.statusMsg) + "\n")
+	}
+	b.WriteString("\n")
 	
 	// Financial summary with better formatting
-	summaryLine1 := fmt.Sprintf("%-12s %s", "Income:", incomeStyle.Render(fmt.Sprintf("$%.2f", m.summary.TotalIncome)))
-	summaryLine2 := fmt.Sprintf("%-12s %s", "Expenses:", expenseStyle.Render(fmt.Sprintf("$%.2f", m.summary.TotalExpense)))
+	comparison := m.summary.Comparison
+	incomeDelta, expenseDelta := "", ""
+	if comparison != nil {
+		incomeDelta = "  " + formatChange(m.summary.TotalIncome, comparison.PreviousPeriodIncome, comparison.IncomeChangePercent, true)
+		expenseDelta = "  " + formatChange(m.summary.TotalExpense, comparison.PreviousPeriodExpense, comparison.ExpenseChangePercent, false)
+	}
+	summaryLine1 := fmt.Sprintf("%-12s %s%s", "Income:", incomeStyle.Render(locale.FormatAmount(m.summary.TotalIncome)), incomeDelta)
+	summaryLine2 := fmt.Sprintf("%-12s %s%s", "Expenses:", expenseStyle.Render(locale.FormatAmount(m.summary.TotalExpense)), expenseDelta)
 	summaryLine3 := fmt.Sprintf("%-12s %s", "Balance:", m.formatBalance(m.summary.NetBalance))
 	
 	b.WriteString(summaryLine1 + "\n")
 	b.WriteString(summaryLine2 + "\n")
 	b.WriteString(summaryLine3 + "\n\n")
 	
-	// Add a simple expense breakdown bar (dummy data for now)
-	b.WriteString(m.createExpenseBreakdownBar())
+	b.WriteString(m.createBreakdownChart())
 	
 	return b.String()
 }
 
-// createExpenseBreakdownBar creates a visual representation of expense categories
-func (m *DashboardModel) createExpenseBreakdownBar() string {
-	// For now, create a dummy breakdown bar
-	// TODO: Replace with actual category data
-	categories := []struct {
-		name       string
-		percentage int
-		color      lipgloss.Style
-	}{
-		{"Food", 35, expenseStyle},
-		{"Transport", 20, warningStyle},
-		{"Bills", 25, errorStyle},
-		{"Other", 20, infoStyle},
+// periodName names the kind of period being displayed, e.g. "Month"
+func (m *DashboardModel) periodName() string {
+	if m.customRange != nil {
+		return "Custom"
 	}
-	
-	var b strings.Builder
-	b.WriteString("Expense Breakdown:\n")
-	
-	// Create a horizontal bar
-	totalWidth := 50
-	bar := ""
-	
-	for _, cat := range categories {
-		segmentWidth := (cat.percentage * totalWidth) / 100
-		if segmentWidth < 1 {
-			segmentWidth = 1
+	switch m.periodType {
+	case domain.PeriodTypeWeek:
+		return "Week"
+	case domain.PeriodTypeQuarter:
+		return "Quarter"
+	case domain.PeriodTypeYear:
+		return "Year"
+	default:
+		return "Month"
+	}
+}
+
+// formatChange renders the change against the previous period as an arrow with a percentage.
+// Rising income is good news and rising expenses are bad news, which decides the color.
+func formatChange(current, previous, percent float64, higherIsBetter bool) string {
+	var text string
+	switch {
+	case previous == 0 && current == 0:
+		return helpStyle.Render("–")
+	case previous == 0:
+		text = "▲ new"
+	case percent > 0:
+		text = "▲ " + formatPercent(percent)
+	case percent < 0:
+		text = "▼ " + formatPercent(-percent)
+	default:
+		return helpStyle.Render("= " + formatPercent(0))
+	}
+
+	rising := previous == 0 || percent > 0
+	if rising == higherIsBetter {
+		return incomeStyle.Render(text)
+	}
+	return expenseStyle.Render(text)
+}
+
+// createBreakdownChart renders the category or payee breakdown of the summary as a stacked bar with a legend
+func (m *DashboardModel) createBreakdownChart() string {
+	breakdowns := m.summary.ExpenseBreakdown
+	title := "Expense Breakdown"
+	if m.breakdownType == "income" {
+		breakdowns = m.summary.IncomeBreakdown
+		title = "Income Breakdown"
+	}
+	if m.byPayee {
+		payees := m.summary.ExpensePayees
+		if m.breakdownType == "income" {
+			payees = m.summary.IncomePayees
 		}
-		segment := strings.Repeat("█", segmentWidth)
-		bar += cat.color.Render(segment)
+		breakdowns = payeeSegments(payees)
+		title += " by Payee"
 	}
-	
-	b.WriteString(bar + "\n")
-	
-	// Add legend
-	for _, cat := range categories {
-		legend := fmt.Sprintf("%s %d%%", cat.name, cat.percentage)
-		b.WriteString(cat.color.Render("■") + " " + legend + "  ")
+
+	var b strings.Builder
+	b.WriteString(title + ":\n")
+
+	if len(breakdowns) == 0 {
+		b.WriteString(helpStyle.Render("No " + m.breakdownType + " recorded for this period."))
+		return b.String()
 	}
-	
+
+	segments := domain.CollapseBreakdown(breakdowns, breakdownTopCategories)
+
+	// Fit the bar to the summary panel (content width minus border, padding and margin)
+	totalWidth := NewCenterConfig(m.width, m.height).CalculateContentWidth() - 12
+	if totalWidth < 10 {
+		totalWidth = 10
+	}
+
+	amounts := make([]float64, len(segments))
+	for i, segment := range segments {
+		amounts[i] = segment.TotalAmount
+	}
+
+	var bar strings.Builder
+	for i, width := range allocateWidths(amounts, totalWidth) {
+		style := lipgloss.NewStyle().Foreground(categoryColor(segments[i].Category))
+		bar.WriteString(style.Render(strings.Repeat("█", width)))
+	}
+	b.WriteString(bar.String() + "\n")
+
+	// Legend, wrapped to the bar width
+	lineWidth := 0
+	for _, segment := range segments {
+		style := lipgloss.NewStyle().Foreground(categoryColor(segment.Category))
+		legend := segment.Category.Name + " " + formatPercent(segment.Percentage)
+		entryWidth := lipgloss.Width(legend) + 4
+		if lineWidth > 0 && lineWidth+entryWidth > totalWidth {
+			b.WriteString("\n")
+			lineWidth = 0
+		}
+		b.WriteString(style.Render("■") + " " + legend + "  ")
+		lineWidth += entryWidth
+	}
+
 	return b.String()
 }
 
+// payeeSegments turns payee breakdowns into chart segments; transactions without a payee
+// share the neutral color of the "Other" bucket
+func payeeSegments(payees []*domain.PayeeBreakdown) []*domain.CategoryBreakdown {
+	segments := make([]*domain.CategoryBreakdown, len(payees))
+	for i, breakdown := range payees {
+		label := &domain.Category{Name: "No payee"}
+		if breakdown.Payee != nil {
+			label = &domain.Category{ID: breakdown.Payee.ID, Name: breakdown.Payee.Name}
+		}
+		segments[i] = &domain.CategoryBreakdown{
+			Category:         label,
+			TotalAmount:      breakdown.TotalAmount,
+			TransactionCount: breakdown.TransactionCount,
+			Percentage:       breakdown.Percentage,
+		}
+	}
+	return segments
+}
+
+// categoryColor returns the stable chart color of a category; the "Other" bucket is always neutral
+func categoryColor(category *domain.Category) lipgloss.TerminalColor {
+	if category == nil || category.ID == 0 {
+		return colorNeutral
+	}
+	hash := fnv.New32a()
+	hash.Write([]byte(strings.ToLower(category.Name)))
+	return categoryPalette[hash.Sum32()%uint32(len(categoryPalette))]
+}
+
+// allocateWidths splits total cells between values proportionally using the largest
+// remainder method, so the segments always add up to exactly total
+func allocateWidths(values []float64, total int) []int {
+	widths := make([]int, len(values))
+	sum := 0.0
+	for _, v := range values {
+		sum += v
+	}
+	if sum <= 0 {
+		return widths
+	}
+
+	type remainder struct {
+		index int
+		value float64
+	}
+	remainders := make([]remainder, len(values))
+	used := 0
+	for i, v := range values {
+		exact := v / sum * float64(total)
+		widths[i] = int(exact)
+		used += widths[i]
+		remainders[i] = remainder{index: i, value: exact - float64(widths[i])}
+	}
+
+	sort.SliceStable(remainders, func(i, j int) bool {
+		return remainders[i].value > remainders[j].value
+	})
+	for i := 0; used < total && i < len(remainders); i++ {
+		widths[remainders[i].index]++
+		used++
+	}
+
+	return widths
+}
+
 // createTransactionsPanel creates the middle panel with recent transactions
 func (m *DashboardModel) createTransactionsPanel() string {
 	var b strings.Builder
@@ -198,7 +523,7 @@ func (m *DashboardModel) createTransactionsPanel() string {
 	b.WriteString(header + "\n\n")
 	
 	if len(m.transactions) == 0 {
-		b.WriteString(helpStyle.Render("No transactions found. Press 'a' to add an expense or 'i' to add income."))
+		b.WriteString(helpStyle.Render(fmt.Sprintf("No transactions found. Press '%s' to add an expense or '%s' to add income.", keys.Dashboard.AddExpense.Help().Key, keys.Dashboard.AddIncome.Help().Key)))
 		return b.String()
 	}
 	
@@ -233,21 +558,18 @@ func (m *DashboardModel) createTransactionsPanel() string {
 	
 	// Transaction rows
 	for _, transaction := range m.transactions {
-		categoryName := "Uncategorized"
-		if transaction.Category != nil {
-			categoryName = transaction.Category.Name
-		}
+		categoryName := transaction.CategoryName()
 		
 		// Format amount with color coding based on transaction type
 		var formattedAmount string
 		if transaction.Type == "income" {
-			formattedAmount = incomeStyle.Render(fmt.Sprintf("+$%.2f", transaction.Amount))
+			formattedAmount = incomeStyle.Render(locale.FormatSignedAmount(transaction.Amount))
 		} else {
-			formattedAmount = expenseStyle.Render(fmt.Sprintf("-$%.2f", transaction.Amount))
+			formattedAmount = expenseStyle.Render(locale.FormatAmount(-transaction.Amount))
 		}
 		
 		values := []string{
-			transaction.Date.Format("Jan 02"),
+			locale.FormatShortDate(transaction.Date),
 			TruncateWithEllipsis(categoryName, columns[1].Width),
 			TruncateWithEllipsis(transaction.Description, columns[2].Width),
 			formattedAmount,
@@ -297,25 +619,15 @@ func (m *DashboardModel) createPanelWithBorder(content, title string, config Cen
 
 // createHelpText creates context-aware help text
 func (m *DashboardModel) createHelpText() string {
-	keys := []struct {
-		key  string
-		desc string
-	}{
-		{"a", "Add Expense"},
-		{"i", "Add Income"},
-		{"l", "List All"},
-		{"r", "Refresh"},
-		{"?", "Help"},
-		{"q", "Quit"},
+	width := NewCenterConfig(m.width, m.height).CalculateContentWidth()
+	if m.editingRange {
+		return renderShortHelp([]key.Binding{withHelp(keys.Input.Apply, "Show Range"), keys.Input.Cancel}, width)
 	}
-	
-	var parts []string
-	for _, k := range keys {
-		part := helpKeyStyle.Render("("+k.key+")") + " " + k.desc
-		parts = append(parts, part)
+	if m.editingQuick {
+		return renderShortHelp([]key.Binding{withHelp(keys.Input.Apply, "Add"), keys.Input.Cancel}, width)
 	}
-	
-	return strings.Join(parts, " • ")
+
+	return renderFooterHelp(keys.Dashboard.ShortHelp(), withHelp(keys.Global.Back, "Quit"), width)
 }
 
 // SetDimensions updates the model's width and height for responsive layout
@@ -326,9 +638,9 @@ func (m *DashboardModel) SetDimensions(width, height int) {
 
 func (m *DashboardModel) formatBalance(balance float64) string {
 	if balance >= 0 {
-		return balancePositiveStyle.Render(fmt.Sprintf("$%.2f", balance))
+		return balancePositiveStyle.Render(locale.FormatAmount(balance))
 	}
-	return balanceNegativeStyle.Render(fmt.Sprintf("$%.2f", balance))
+	return balanceNegativeStyle.Render(locale.FormatAmount(balance))
 }
 
 func (m *DashboardModel) formatTransactionType(transactionType string) string {
@@ -0,0 +1,404 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"expense-tracker/internal/core/domain"
+	"expense-tracker/internal/core/usecase"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+type duplicatesMode int
+
+const (
+	duplicatesModeNone duplicatesMode = iota
+	duplicatesModeRange
+	duplicatesModeConfirmMerge
+)
+
+// duplicateScanDays is how far back the review screen looks when it is opened
+const duplicateScanDays = 90
+
+type duplicatesMsg struct {
+	pairs []*domain.DuplicatePair
+	err   error
+}
+
+type duplicateResolvedMsg struct {
+	status string
+	err    error
+}
+
+// DuplicatesModel lists likely duplicate transactions in a date range and lets the user
+// merge each pair, keep both for now, or mark it as not a duplicate for good
+type DuplicatesModel struct {
+	duplicateUseCase *usecase.DuplicateUseCase
+	pairs            []*domain.DuplicatePair
+	kept             map[domain.DuplicateKey]bool // pairs kept this visit, hidden until the screen is opened again
+	cursor           int
+	mode             duplicatesMode
+	keepDuplicate    bool // the merge being confirmed keeps the newer entry
+	dateRange        *domain.DateRange
+	rangeInput       textinput.Model
+	inputErr         string
+	loading          bool
+	err              error
+	statusMsg        string
+	width            int
+	height           int
+}
+
+func NewDuplicatesModel(duplicateUseCase *usecase.DuplicateUseCase) *DuplicatesModel {
+	rangeInput := textinput.New()
+	rangeInput.Placeholder = locale.FormatInputDate(time.Date(2026, 1, 1, 0, 0, 0, 0, time.Local)) + ".." + locale.FormatInputDate(time.Date(2026, 3, 31, 0, 0, 0, 0, time.Local))
+	rangeInput.CharLimit = 30
+	rangeInput.Width = 30
+
+	return &DuplicatesModel{
+		duplicateUseCase: duplicateUseCase,
+		rangeInput:       rangeInput,
+	}
+}
+
+// Init scans the last duplicateScanDays days, or the range chosen on the previous visit
+func (m *DuplicatesModel) Init() tea.Cmd {
+	m.mode = duplicatesModeNone
+	m.statusMsg = ""
+	m.err = nil
+	m.kept = map[domain.DuplicateKey]bool{}
+	if m.dateRange == nil {
+		today := domain.StartOfDay(domain.Now())
+		m.dateRange = domain.NewDateRange(today.AddDate(0, 0, -duplicateScanDays), today.AddDate(0, 0, 1).Add(-time.Nanosecond))
+	}
+	return m.scan()
+}
+
+// SetDimensions updates the model's width and height for responsive layout
+func (m *DuplicatesModel) SetDimensions(width, height int) {
+	m.width = width
+	m.height = height
+}
+
+func (m *DuplicatesModel) scan() tea.Cmd {
+	m.loading = true
+	dateRange := m.dateRange
+	return func() tea.Msg {
+		pairs, err := m.duplicateUseCase.FindDuplicates(context.Background(), dateRange.Start, dateRange.End)
+		return duplicatesMsg{pairs: pairs, err: err}
+	}
+}
+
+func (m *DuplicatesModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case duplicatesMsg:
+		m.loading = false
+		m.err = msg.err
+		if msg.err == nil {
+			m.pairs = m.pairs[:0]
+			for _, pair := range msg.pairs {
+				if !m.kept[pair.Key()] {
+					m.pairs = append(m.pairs, pair)
+				}
+			}
+			m.cursor = min(m.cursor, max(len(m.pairs)-1, 0))
+		}
+		return m, nil
+
+	case duplicateResolvedMsg:
+		if msg.err != nil {
+			m.loading = false
+			m.err = msg.err
+			return m, nil
+		}
+		m.statusMsg = msg.status
+		return m, m.scan()
+
+	case tea.KeyMsg:
+		switch m.mode {
+		case duplicatesModeRange:
+			return m, m.updateRangeInput(msg)
+		case duplicatesModeConfirmMerge:
+			return m, m.updateConfirmMerge(msg)
+		}
+
+		m.err = nil
+		m.statusMsg = ""
+		pair := m.selectedPair()
+		switch {
+		case key.Matches(msg, keys.Duplicates.Up):
+			if m.cursor > 0 {
+				m.cursor--
+			}
+		case key.Matches(msg, keys.Duplicates.Down):
+			if m.cursor < len(m.pairs)-1 {
+				m.cursor++
+			}
+		case key.Matches(msg, keys.Duplicates.Merge, keys.Duplicates.MergeNewer):
+			if pair != nil {
+				m.keepDuplicate = key.Matches(msg, keys.Duplicates.MergeNewer)
+				m.mode = duplicatesModeConfirmMerge
+			}
+		case key.Matches(msg, keys.Duplicates.KeepBoth):
+			if pair != nil {
+				m.kept[pair.Key()] = true
+				m.pairs = append(m.pairs[:m.cursor:m.cursor], m.pairs[m.cursor+1:]...)
+				m.cursor = min(m.cursor, max(len(m.pairs)-1, 0))
+				m.statusMsg = "Kept both for now"
+			}
+		case key.Matches(msg, keys.Duplicates.NotDuplicate):
+			if pair != nil {
+				return m, func() tea.Msg {
+					if err := m.duplicateUseCase.Dismiss(context.Background(), pair); err != nil {
+						return duplicateResolvedMsg{err: err}
+					}
+					return duplicateResolvedMsg{status: "Marked as not duplicate; the pair won't be flagged again"}
+				}
+			}
+		case key.Matches(msg, keys.Duplicates.DateRange):
+			m.mode = duplicatesModeRange
+			m.inputErr = ""
+			m.rangeInput.SetValue("")
+			return m, m.rangeInput.Focus()
+		}
+	}
+	return m, nil
+}
+
+// capturesKey reports whether the duplicates screen needs a key that would otherwise take the user back
+func (m *DuplicatesModel) capturesKey(msg tea.KeyMsg) bool {
+	return m.mode != duplicatesModeNone
+}
+
+func (m *DuplicatesModel) selectedPair() *domain.DuplicatePair {
+	if m.cursor < len(m.pairs) {
+		return m.pairs[m.cursor]
+	}
+	return nil
+}
+
+// updateRangeInput handles keys while the date range to scan is typed
+func (m *DuplicatesModel) updateRangeInput(msg tea.KeyMsg) tea.Cmd {
+	switch {
+	case key.Matches(msg, keys.Input.Cancel):
+		m.mode = duplicatesModeNone
+		m.rangeInput.Blur()
+		return nil
+	case key.Matches(msg, keys.Input.Apply):
+		dateRange, err := domain.ParseDateRange(m.rangeInput.Value(), locale)
+		if err != nil {
+			m.inputErr = err.Error()
+			return nil
+		}
+		m.rangeInput.Blur()
+		m.mode = duplicatesModeNone
+		m.dateRange = dateRange
+		m.cursor = 0
+		return m.scan()
+	}
+
+	var cmd tea.Cmd
+	m.rangeInput, cmd = m.rangeInput.Update(msg)
+	return cmd
+}
+
+func (m *DuplicatesModel) updateConfirmMerge(msg tea.KeyMsg) tea.Cmd {
+	switch {
+	case key.Matches(msg, keys.Dialog.Yes):
+		m.mode = duplicatesModeNone
+		pair, keepDuplicate := m.selectedPair(), m.keepDuplicate
+		m.loading = true
+		return func() tea.Msg {
+			merged, err := m.duplicateUseCase.Merge(context.Background(), pair, keepDuplicate)
+			if err != nil {
+				return duplicateResolvedMsg{err: err}
+			}
+			return duplicateResolvedMsg{status: fmt.Sprintf("Merged into %s of %s", merged.Description, locale.FormatDate(merged.Date))}
+		}
+	case key.Matches(msg, keys.Dialog.No):
+		m.mode = duplicatesModeNone
+	}
+	return nil
+}
+
+func (m *DuplicatesModel) View() string {
+	config := NewCenterConfig(m.width, m.height)
+
+	if m.loading {
+		content := loadingStyle.Render("Looking for duplicates...")
+		return lipgloss.Place(config.Width, config.Height,
+			lipgloss.Center, lipgloss.Center, content)
+	}
+
+	helpPanel := m.createHelpPanel()
+
+	if m.mode == duplicatesModeConfirmMerge {
+		popup := lipgloss.JoinVertical(lipgloss.Center, m.createMergePopup(), "", helpPanel)
+		return lipgloss.Place(config.Width, config.Height,
+			lipgloss.Center, lipgloss.Center, popup)
+	}
+
+	panel := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(colorNeutral).
+		Width(config.CalculateContentWidth()-4).
+		Height(max(config.Height-10, 6)).
+		Padding(1, 2).
+		Align(lipgloss.Left).
+		Render(m.createDuplicatesPanel())
+
+	fullContent := lipgloss.JoinVertical(
+		lipgloss.Center,
+		titleStyle.Render("⧉  Duplicates"),
+		"",
+		panel,
+		"",
+		helpPanel,
+	)
+
+	return lipgloss.Place(config.Width, config.Height,
+		lipgloss.Center, lipgloss.Center, fullContent)
+}
+
+// createDuplicatesPanel renders the range, messages, the pair table and the selected pair's details
+func (m *DuplicatesModel) createDuplicatesPanel() string {
+	var b strings.Builder
+
+	b.WriteString(summaryHeaderStyle.Render(fmt.Sprintf("⧉  Possible duplicates (%d)", len(m.pairs))) + "\n")
+	if m.dateRange != nil {
+		b.WriteString(helpStyle.Render(fmt.Sprintf("%s – %s · same amount, at most %d days apart, similar description",
+			locale.FormatDate(m.dateRange.Start), locale.FormatDate(m.dateRange.End), m.duplicateUseCase.WindowDays())) + "\n\n")
+	}
+
+	if m.mode == duplicatesModeRange {
+		b.WriteString("Look in: " + m.rangeInput.View())
+		if m.inputErr != "" {
+			b.WriteString("  " + errorStyle.Render(m.inputErr))
+		}
+		b.WriteString("\n\n")
+	}
+
+	if m.err != nil {
+		b.WriteString(errorStyle.Render("❌ "+m.err.Error()) + "\n\n")
+	} else if m.statusMsg != "" {
+		b.WriteString(successStyle.Render("✅ "+m.statusMsg) + "\n\n")
+	}
+
+	if len(m.pairs) == 0 {
+		b.WriteString(helpStyle.Render("No likely duplicates in this range."))
+		return b.String()
+	}
+
+	panelWidth := NewCenterConfig(m.width, m.height).CalculateContentWidth() - 8
+	descriptionWidth := max((panelWidth-44)/2, 12)
+	columns := []TableColumn{
+		{Header: "Amount", Width: 12, Alignment: lipgloss.Right},
+		{Header: "Date", Width: 10, Alignment: lipgloss.Left},
+		{Header: "Original", Width: descriptionWidth, Alignment: lipgloss.Left},
+		{Header: "Date", Width: 10, Alignment: lipgloss.Left},
+		{Header: "Duplicate", Width: descriptionWidth, Alignment: lipgloss.Left},
+		{Header: "Match", Width: 6, Alignment: lipgloss.Right},
+	}
+
+	totalWidth := 0
+	for _, col := range columns {
+		totalWidth += col.Width + 1
+	}
+	b.WriteString(CreateTableHeader(columns) + "\n" + CreateTableSeparator(totalWidth-1) + "\n")
+
+	// Keep the cursor in view when there are more pairs than rows
+	visibleRows := max(NewCenterConfig(m.width, m.height).Height-22, 3)
+	start := min(max(m.cursor-visibleRows/2, 0), max(len(m.pairs)-visibleRows, 0))
+	for i := start; i < min(start+visibleRows, len(m.pairs)); i++ {
+		pair := m.pairs[i]
+		row := FormatTableRow(columns, []string{
+			locale.FormatAmount(pair.Original.Amount),
+			locale.FormatShortDate(pair.Original.Date),
+			TruncateWithEllipsis(pair.Original.Description, descriptionWidth),
+			locale.FormatShortDate(pair.Duplicate.Date),
+			TruncateWithEllipsis(pair.Duplicate.Description, descriptionWidth),
+			formatPercent(pair.Similarity * 100),
+		})
+		if i == m.cursor {
+			b.WriteString(tableRowSelectedStyle.Render(row))
+		} else if i%2 == 0 {
+			b.WriteString(tableRowStyle.Render(row))
+		} else {
+			b.WriteString(tableRowAltStyle.Render(row))
+		}
+		b.WriteString("\n")
+	}
+	if len(m.pairs) > visibleRows {
+		b.WriteString(infoStyle.Render(fmt.Sprintf("%d of %d", m.cursor+1, len(m.pairs))) + "\n")
+	}
+
+	if pair := m.selectedPair(); pair != nil {
+		b.WriteString("\n" + helpDescStyle.Render("Original:  "+duplicateDetails(pair.Original)))
+		b.WriteString("\n" + helpDescStyle.Render("Duplicate: "+duplicateDetails(pair.Duplicate)))
+	}
+
+	return b.String()
+}
+
+// duplicateDetails describes what tells the entries of a pair apart besides the table columns
+func duplicateDetails(transaction *domain.Transaction) string {
+	details := []string{transaction.CategoryName()}
+	if transaction.Payee != nil {
+		details = append(details, "payee "+transaction.Payee.Name)
+	}
+	if len(transaction.Tags) > 0 {
+		details = append(details, "tags "+strings.Join(transaction.Tags, ", "))
+	}
+	return strings.Join(details, " · ")
+}
+
+// createMergePopup asks to confirm which entry is kept and which deleted
+func (m *DuplicatesModel) createMergePopup() string {
+	pair := m.selectedPair()
+	keep, drop := pair.Original, pair.Duplicate
+	if m.keepDuplicate {
+		keep, drop = drop, keep
+	}
+
+	line := func(transaction *domain.Transaction) string {
+		return TruncateWithEllipsis(fmt.Sprintf("%s  %s  %s",
+			locale.FormatDate(transaction.Date), transaction.Description, locale.FormatAmount(transaction.Amount)), 56)
+	}
+
+	var b strings.Builder
+	b.WriteString(modalHeaderStyle.Render("Merge Duplicates") + "\n\n")
+	b.WriteString(successStyle.Render("Keep:   ") + line(keep) + "\n")
+	b.WriteString(errorStyle.Render("Trash:  ") + line(drop) + "\n\n")
+	b.WriteString(helpDescStyle.Render("Tags are combined; a missing category or payee is taken over") + "\n\n")
+	b.WriteString(renderShortHelp([]key.Binding{withHelp(keys.Dialog.Yes, "Merge"), withHelp(keys.Dialog.No, "Cancel")}, 0))
+	return modalStyle.Render(b.String())
+}
+
+// createHelpPanel renders the footer for the current mode
+func (m *DuplicatesModel) createHelpPanel() string {
+	width := NewCenterConfig(m.width, m.height).CalculateContentWidth()
+
+	switch m.mode {
+	case duplicatesModeRange:
+		return renderShortHelp(keys.Input.ShortHelp(), width)
+	case duplicatesModeConfirmMerge:
+		return renderShortHelp([]key.Binding{withHelp(keys.Dialog.Yes, "Merge"), keys.Dialog.No}, width)
+	}
+
+	hasPairs := len(m.pairs) > 0
+	duplicates := keys.Duplicates
+	return renderFooterHelp([]key.Binding{
+		duplicates.Up,
+		duplicates.Down,
+		enabledIf(duplicates.Merge, hasPairs),
+		enabledIf(duplicates.MergeNewer, hasPairs),
+		enabledIf(duplicates.KeepBoth, hasPairs),
+		enabledIf(duplicates.NotDuplicate, hasPairs),
+		duplicates.DateRange,
+	}, keys.Global.Back, width)
+}
@@ -0,0 +1,45 @@
+package tui
+
+import (
+	"fmt"
+
+	"expense-tracker/internal/core/domain"
+)
+
+// locale decides how every view writes amounts and dates and how forms read them
+var locale = domain.DefaultLocale()
+
+// UseLocale switches the number, currency and date formats of all views
+func UseLocale(l *domain.Locale) {
+	locale = l
+}
+
+// futureDays is how many days ahead a typed date may lie
+var futureDays int
+
+// UseFutureDays sets how many days in the future forms accept dates for
+func UseFutureDays(days int) {
+	futureDays = days
+}
+
+// newDateParser returns a parser for dates typed into forms, relative to the current time
+func newDateParser() *domain.DateParser {
+	return domain.NewDateParser(locale, domain.Now, futureDays)
+}
+
+// evaluateAmount reads a positive amount typed as a number or as arithmetic such as "120/3"
+func evaluateAmount(input string) (float64, error) {
+	amount, err := locale.EvaluateAmount(input)
+	if err != nil {
+		return 0, fmt.Errorf("invalid amount: %w", err)
+	}
+	if amount <= 0 {
+		return 0, fmt.Errorf("amount must be greater than zero")
+	}
+	return amount, nil
+}
+
+// formatPercent writes a percentage with one decimal in the locale's number format
+func formatPercent(value float64) string {
+	return locale.FormatNumber(value, 1) + "%"
+}
@@ -0,0 +1,102 @@
+package tui
+
+import (
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// helpSection is one titled group of bindings in the help overlay
+type helpSection struct {
+	title  string
+	keyMap help.KeyMap
+}
+
+// newHelpModel returns a help bubble styled like the rest of the application
+func newHelpModel(width int) help.Model {
+	model := help.New()
+	model.Width = width
+	model.Styles = help.Styles{
+		Ellipsis:       helpDescStyle,
+		ShortKey:       helpKeyStyle,
+		ShortDesc:      helpDescStyle,
+		ShortSeparator: helpDescStyle,
+		FullKey:        helpKeyStyle,
+		FullDesc:       helpDescStyle,
+		FullSeparator:  helpDescStyle,
+	}
+	return model
+}
+
+// renderShortHelp renders a footer line from bindings, truncated to width when it is set
+func renderShortHelp(bindings []key.Binding, width int) string {
+	return newHelpModel(width).ShortHelpView(bindings)
+}
+
+// renderFooterHelp renders a view footer that always ends with the help and back bindings;
+// only the view's own bindings are truncated when the line does not fit
+func renderFooterHelp(bindings []key.Binding, back key.Binding, width int) string {
+	model := newHelpModel(0)
+	pinned := model.ShortHelpView([]key.Binding{keys.Global.Help, back})
+	if width > 0 {
+		model.Width = max(width-lipgloss.Width(pinned)-lipgloss.Width(model.ShortSeparator), 1)
+	}
+	return model.ShortHelpView(bindings) + helpDescStyle.Render(model.ShortSeparator) + pinned
+}
+
+// viewHelpSection returns the bindings of the view the user opened the overlay from
+func viewHelpSection(state sessionState) helpSection {
+	switch state {
+	case listTransactionsView:
+		return helpSection{"Transaction List", keys.List}
+	case rulesView:
+		return helpSection{"Rules", keys.Rules}
+	case duplicatesView:
+		return helpSection{"Duplicates", keys.Duplicates}
+	case reconcileView:
+		return helpSection{"Reconciliation", keys.Reconcile}
+	case changesView:
+		return helpSection{"Recent Changes", keys.Changes}
+	case trashView:
+		return helpSection{"Trash", keys.Trash}
+	case addExpenseView, addIncomeView:
+		return helpSection{"Add Expense / Income", keys.Form}
+	default:
+		return helpSection{"Dashboard", keys.Dashboard}
+	}
+}
+
+// renderHelpSection renders a titled block of bindings
+func renderHelpSection(model help.Model, section helpSection) string {
+	return summaryHeaderStyle.Render(section.title) + "\n" + model.FullHelpView(section.keyMap.FullHelp())
+}
+
+// renderHelpOverlay renders the full-screen help for the current view: its own bindings
+// followed by the dialog, prompt and global bindings that apply everywhere
+func renderHelpOverlay(state sessionState, width, height int) string {
+	config := NewCenterConfig(width, height)
+	model := newHelpModel(config.CalculateContentWidth())
+
+	shared := []string{
+		renderHelpSection(model, helpSection{"Dialogs & Menus", keys.Dialog}),
+		renderHelpSection(model, helpSection{"Search & Prompts", keys.Input}),
+		renderHelpSection(model, helpSection{"Everywhere", keys.Global}),
+	}
+	for i := range shared[:len(shared)-1] {
+		shared[i] = lipgloss.NewStyle().PaddingRight(4).Render(shared[i])
+	}
+
+	content := lipgloss.JoinVertical(
+		lipgloss.Center,
+		titleStyle.Render("⌨  Keyboard Shortcuts"),
+		"",
+		renderHelpSection(model, viewHelpSection(state)),
+		"",
+		lipgloss.JoinHorizontal(lipgloss.Top, shared...),
+		"",
+		renderShortHelp([]key.Binding{withHelp(keys.Global.Help, "Close")}, 0),
+	)
+
+	return lipgloss.Place(config.Width, config.Height,
+		lipgloss.Center, lipgloss.Center, modalStyle.Render(content))
+}
@@ -0,0 +1,290 @@
+package tui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// actions maps the action names used in the config file to the bindings of a key map
+func (k *KeyMap) actions() map[string]*key.Binding {
+	return map[string]*key.Binding{
+		"global.help":       &k.Global.Help,
+		"global.back":       &k.Global.Back,
+		"global.force_quit": &k.Global.ForceQuit,
+		"global.undo":       &k.Global.Undo,
+		"global.redo":       &k.Global.Redo,
+
+		"dashboard.add_expense": &k.Dashboard.AddExpense,
+		"dashboard.add_income":  &k.Dashboard.AddIncome,
+		"dashboard.list":        &k.Dashboard.List,
+		"dashboard.refresh":     &k.Dashboard.Refresh,
+		"dashboard.prev_period": &k.Dashboard.PrevPeriod,
+		"dashboard.next_period": &k.Dashboard.NextPeriod,
+		"dashboard.period_type": &k.Dashboard.PeriodType,
+		"dashboard.date_range":  &k.Dashboard.DateRange,
+		"dashboard.today":       &k.Dashboard.Today,
+		"dashboard.breakdown":   &k.Dashboard.Breakdown,
+		"dashboard.payees":      &k.Dashboard.Payees,
+		"dashboard.quick_add":   &k.Dashboard.QuickAdd,
+		"dashboard.rules":       &k.Dashboard.Rules,
+		"dashboard.duplicates":  &k.Dashboard.Duplicates,
+		"dashboard.reconcile":   &k.Dashboard.Reconcile,
+		"dashboard.changes":     &k.Dashboard.Changes,
+		"dashboard.trash":       &k.Dashboard.Trash,
+
+		"list.up":              &k.List.Up,
+		"list.down":            &k.List.Down,
+		"list.prev_page":       &k.List.PrevPage,
+		"list.next_page":       &k.List.NextPage,
+		"list.first_page":      &k.List.FirstPage,
+		"list.last_page":       &k.List.LastPage,
+		"list.toggle":          &k.List.Toggle,
+		"list.select_page":     &k.List.SelectPage,
+		"list.clear_selection": &k.List.ClearSelection,
+		"list.delete":          &k.List.Delete,
+		"list.bulk_edit":       &k.List.BulkEdit,
+		"list.search":          &k.List.Search,
+		"list.clear_search":    &k.List.ClearSearch,
+		"list.cleared":         &k.List.Cleared,
+		"list.details":         &k.List.Details,
+
+		"rules.up":        &k.Rules.Up,
+		"rules.down":      &k.Rules.Down,
+		"rules.new":       &k.Rules.New,
+		"rules.edit":      &k.Rules.Edit,
+		"rules.delete":    &k.Rules.Delete,
+		"rules.move_up":   &k.Rules.MoveUp,
+		"rules.move_down": &k.Rules.MoveDown,
+		"rules.apply":     &k.Rules.Apply,
+
+		"duplicates.up":            &k.Duplicates.Up,
+		"duplicates.down":          &k.Duplicates.Down,
+		"duplicates.merge":         &k.Duplicates.Merge,
+		"duplicates.merge_newer":   &k.Duplicates.MergeNewer,
+		"duplicates.keep_both":     &k.Duplicates.KeepBoth,
+		"duplicates.not_duplicate": &k.Duplicates.NotDuplicate,
+		"duplicates.date_range":    &k.Duplicates.DateRange,
+
+		"reconcile.up":         &k.Reconcile.Up,
+		"reconcile.down":       &k.Reconcile.Down,
+		"reconcile.toggle":     &k.Reconcile.Toggle,
+		"reconcile.toggle_all": &k.Reconcile.ToggleAll,
+		"reconcile.statement":  &k.Reconcile.Statement,
+		"reconcile.finish":     &k.Reconcile.Finish,
+
+		"changes.up":   &k.Changes.Up,
+		"changes.down": &k.Changes.Down,
+
+		"trash.up":      &k.Trash.Up,
+		"trash.down":    &k.Trash.Down,
+		"trash.restore": &k.Trash.Restore,
+		"trash.purge":   &k.Trash.Purge,
+		"trash.empty":   &k.Trash.Empty,
+
+		"form.up":           &k.Form.Up,
+		"form.down":         &k.Form.Down,
+		"form.edit":         &k.Form.Edit,
+		"form.save":         &k.Form.Save,
+		"form.save_and_new": &k.Form.SaveAndNew,
+		"form.reset":        &k.Form.Reset,
+		"form.cancel":       &k.Form.Cancel,
+		"form.confirm":      &k.Form.Confirm,
+		"form.clear_field":  &k.Form.ClearField,
+		"form.stop_editing": &k.Form.StopEditing,
+
+		"form.next_suggestion":   &k.Form.NextSuggestion,
+		"form.prev_suggestion":   &k.Form.PrevSuggestion,
+		"form.accept_suggestion": &k.Form.AcceptSuggestion,
+		"form.accept_category":   &k.Form.AcceptCategory,
+		"form.remove_split":      &k.Form.RemoveSplit,
+
+		"dialog.up":     &k.Dialog.Up,
+		"dialog.down":   &k.Dialog.Down,
+		"dialog.select": &k.Dialog.Select,
+		"dialog.cancel": &k.Dialog.Cancel,
+		"dialog.filter": &k.Dialog.Filter,
+		"dialog.yes":    &k.Dialog.Yes,
+		"dialog.no":     &k.Dialog.No,
+
+		"input.apply":  &k.Input.Apply,
+		"input.cancel": &k.Input.Cancel,
+	}
+}
+
+// conflictGroups lists the actions that are active at the same time. Two actions in one
+// group may not share a key. Some defaults overlap on purpose, e.g. Esc clears the list
+// selection before it goes back, so those pairs never share a group.
+var conflictGroups = []struct {
+	name    string
+	actions []string
+}{
+	{"dashboard", []string{"global.help", "global.back", "global.force_quit", "global.undo", "global.redo", "dashboard.*"}},
+	{"transaction list", []string{"global.help", "global.force_quit", "global.undo", "global.redo", "list.*"}},
+	{"transaction list", []string{"global.back", "list.up", "list.down", "list.prev_page", "list.next_page", "list.first_page", "list.last_page", "list.toggle", "list.select_page", "list.delete", "list.bulk_edit", "global.undo", "global.redo", "list.search", "list.clear_search", "list.cleared", "list.details"}},
+	{"rules", []string{"global.help", "global.back", "global.force_quit", "global.undo", "global.redo", "rules.*"}},
+	{"rule preview", []string{"global.force_quit", "dialog.up", "dialog.down", "dialog.yes", "dialog.no"}},
+	{"duplicates", []string{"global.help", "global.back", "global.force_quit", "global.undo", "global.redo", "duplicates.*"}},
+	{"reconciliation", []string{"global.help", "global.back", "global.force_quit", "reconcile.*"}},
+	{"recent changes", []string{"global.help", "global.back", "global.force_quit", "global.undo", "global.redo", "changes.*"}},
+	{"trash", []string{"global.help", "global.back", "global.force_quit", "global.undo", "global.redo", "trash.*"}},
+	{"form", []string{"global.help", "global.force_quit", "form.up", "form.down", "form.edit", "form.save", "form.save_and_new", "form.reset", "form.cancel", "form.accept_category"}},
+	{"form", []string{"global.back", "form.up", "form.down", "form.edit", "form.save", "form.save_and_new", "form.reset", "form.accept_category"}},
+	{"form field", []string{"global.force_quit", "form.confirm", "form.clear_field", "form.stop_editing", "form.next_suggestion", "form.prev_suggestion", "form.accept_suggestion"}},
+	{"menu", []string{"global.force_quit", "dialog.up", "dialog.down", "dialog.select", "dialog.cancel"}},
+	{"split editor", []string{"global.force_quit", "input.apply", "input.cancel", "dialog.up", "dialog.down", "form.remove_split"}},
+	{"category picker", []string{"global.force_quit", "dialog.up", "dialog.down", "dialog.select", "dialog.cancel", "dialog.filter"}},
+	{"confirmation", []string{"global.force_quit", "dialog.yes", "dialog.no"}},
+	{"prompt", []string{"global.force_quit", "input.apply", "input.cancel"}},
+	{"help", []string{"global.help", "global.back", "global.force_quit"}},
+}
+
+// keyAliases are friendlier spellings accepted in the config file
+var keyAliases = map[string]string{
+	"space":  " ",
+	"escape": "esc",
+	"return": "enter",
+}
+
+// keyNames holds every named key bubbletea reports, such as "ctrl+s", "pgup" or "f5"
+var keyNames = func() map[string]bool {
+	names := map[string]bool{}
+	for keyType := tea.KeyType(-200); keyType <= 200; keyType++ {
+		if name := keyType.String(); name != "" && keyType != tea.KeyRunes {
+			names[name] = true
+		}
+	}
+	return names
+}()
+
+// ConfigureKeybindings replaces the bindings of the listed actions and installs the
+// resulting key map. It fails on unknown actions, unparseable keys and conflicts,
+// leaving the current key map untouched.
+func ConfigureKeybindings(overrides map[string][]string) error {
+	keyMap, err := NewKeyMap(overrides)
+	if err != nil {
+		return err
+	}
+	keys = keyMap
+	return nil
+}
+
+// NewKeyMap returns the default key map with overrides applied and validated
+func NewKeyMap(overrides map[string][]string) (KeyMap, error) {
+	keyMap := DefaultKeyMap()
+	actions := keyMap.actions()
+
+	names := make([]string, 0, len(overrides))
+	for name := range overrides {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		configured := overrides[name]
+		binding, ok := actions[name]
+		if !ok {
+			return KeyMap{}, fmt.Errorf("unknown keybinding action %q (actions look like \"form.save\" or \"list.up\")", name)
+		}
+		if len(configured) == 0 {
+			return KeyMap{}, fmt.Errorf("keybinding %q has no keys", name)
+		}
+
+		var parsed []string
+		for _, raw := range configured {
+			k, err := parseKey(raw)
+			if err != nil {
+				return KeyMap{}, fmt.Errorf("keybinding %q: %w", name, err)
+			}
+			parsed = append(parsed, k)
+		}
+
+		binding.SetKeys(parsed...)
+		binding.SetHelp(keyHelp(parsed), binding.Help().Desc)
+	}
+
+	if err := checkConflicts(actions); err != nil {
+		return KeyMap{}, err
+	}
+	return keyMap, nil
+}
+
+// parseKey normalizes a key from the config file to the string bubbletea reports for it.
+// Named keys are case-insensitive; single characters keep their case so "G" and "g" differ.
+func parseKey(raw string) (string, error) {
+	k := strings.TrimSpace(raw)
+	if k == "" && raw != "" {
+		k = " "
+	}
+
+	prefix := ""
+	if len(k) > len("alt+") && strings.EqualFold(k[:len("alt+")], "alt+") {
+		prefix, k = "alt+", k[len("alt+"):]
+	}
+	if utf8.RuneCountInString(k) == 1 {
+		return prefix + k, nil
+	}
+
+	k = strings.ToLower(k)
+	if alias, ok := keyAliases[k]; ok {
+		k = alias
+	}
+	if keyNames[k] {
+		return prefix + k, nil
+	}
+	return "", fmt.Errorf("unknown key %q", raw)
+}
+
+// keyHelp renders keys the way the footers show them, e.g. "↑/ctrl+p"
+func keyHelp(keys []string) string {
+	symbols := map[string]string{"up": "↑", "down": "↓", "left": "←", "right": "→", " ": "space"}
+	labels := make([]string, len(keys))
+	for i, k := range keys {
+		if symbol, ok := symbols[k]; ok {
+			k = symbol
+		}
+		labels[i] = k
+	}
+	return strings.Join(labels, "/")
+}
+
+// checkConflicts reports the first key bound to two actions that are active at the same time
+func checkConflicts(actions map[string]*key.Binding) error {
+	for _, group := range conflictGroups {
+		owners := map[string]string{}
+		for _, name := range expandActions(group.actions, actions) {
+			for _, k := range actions[name].Keys() {
+				if owner, ok := owners[k]; ok && owner != name {
+					return fmt.Errorf("key %q is bound to both %s and %s in the %s", keyHelp([]string{k}), owner, name, group.name)
+				}
+				owners[k] = name
+			}
+		}
+	}
+	return nil
+}
+
+// expandActions resolves "scope.*" patterns to the sorted action names of that scope
+func expandActions(patterns []string, actions map[string]*key.Binding) []string {
+	var names []string
+	for _, pattern := range patterns {
+		prefix, ok := strings.CutSuffix(pattern, "*")
+		if !ok {
+			names = append(names, pattern)
+			continue
+		}
+		var scoped []string
+		for name := range actions {
+			if strings.HasPrefix(name, prefix) {
+				scoped = append(scoped, name)
+			}
+		}
+		sort.Strings(scoped)
+		names = append(names, scoped...)
+	}
+	return names
+}
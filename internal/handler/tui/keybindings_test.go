@@ -0,0 +1,128 @@
+package tui
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"expense-tracker/internal/config"
+)
+
+type KeybindingsTestSuite struct {
+	suite.Suite
+}
+
+func TestKeybindingsSuite(t *testing.T) {
+	suite.Run(t, new(KeybindingsTestSuite))
+}
+
+func (suite *KeybindingsTestSuite) TearDownTest() {
+	keys = DefaultKeyMap()
+}
+
+func (suite *KeybindingsTestSuite) TestParseKey() {
+	cases := []struct {
+		raw, expected string
+	}{
+		{"g", "g"},
+		{"G", "G"},
+		{" ", " "},
+		{"space", " "},
+		{"Space", " "},
+		{"ESCAPE", "esc"},
+		{"Return", "enter"},
+		{"CTRL+W", "ctrl+w"},
+		{" pgdown ", "pgdown"},
+		{"F5", "f5"},
+		{"alt+x", "alt+x"},
+		{"Alt+X", "alt+X"},
+		{"ä", "ä"},
+	}
+	for _, c := range cases {
+		parsed, err := parseKey(c.raw)
+		suite.Require().NoError(err, c.raw)
+		assert.Equal(suite.T(), c.expected, parsed, c.raw)
+	}
+}
+
+func (suite *KeybindingsTestSuite) TestNewKeyMap_Errors() {
+	cases := []struct {
+		name      string
+		overrides map[string][]string
+		message   string
+	}{
+		{"unknown action", map[string][]string{"form.submit": {"ctrl+w"}}, `unknown keybinding action "form.submit"`},
+		{"unknown key", map[string][]string{"form.save": {"ctrl+shift+w"}}, `keybinding "form.save": unknown key "ctrl+shift+w"`},
+		{"word that is not a key", map[string][]string{"list.up": {"up", "upward"}}, `unknown key "upward"`},
+		{"no keys", map[string][]string{"form.save": {}}, `keybinding "form.save" has no keys`},
+		{"conflict with a default", map[string][]string{"list.delete": {"k"}}, `key "k" is bound to both list.delete and list.up in the transaction list`},
+		{"conflict between overrides", map[string][]string{"rules.new": {"z"}, "rules.edit": {"z"}}, `key "z" is bound to both rules.edit and rules.new in the rules`},
+		{"conflict with a global", map[string][]string{"trash.empty": {"?"}}, `key "?" is bound to both global.help and trash.empty in the trash`},
+	}
+	for _, c := range cases {
+		suite.Run(c.name, func() {
+			_, err := NewKeyMap(c.overrides)
+			assert.ErrorContains(suite.T(), err, c.message)
+		})
+	}
+}
+
+func (suite *KeybindingsTestSuite) TestNewKeyMap_SameKeyInDifferentGroups() {
+	keyMap, err := NewKeyMap(map[string][]string{
+		"rules.new":     {"ctrl+n"},
+		"trash.restore": {"ctrl+n"},
+	})
+
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), []string{"ctrl+n"}, keyMap.Rules.New.Keys())
+	assert.Equal(suite.T(), []string{"ctrl+n"}, keyMap.Trash.Restore.Keys())
+}
+
+func (suite *KeybindingsTestSuite) TestNewKeyMap_Defaults() {
+	keyMap, err := NewKeyMap(nil)
+
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), DefaultKeyMap(), keyMap)
+}
+
+func (suite *KeybindingsTestSuite) TestNewKeyMap_FromConfig() {
+	assert := assert.New(suite.T())
+
+	cfg, err := config.Parse([]byte(`
+keybindings:
+  form.save: ctrl+w
+  list.up: [Up, CTRL+P]
+  list.last_page: G
+`))
+	suite.Require().NoError(err)
+	keyMap, err := NewKeyMap(cfg.KeybindingOverrides())
+	suite.Require().NoError(err)
+
+	assert.Equal([]string{"ctrl+w"}, keyMap.Form.Save.Keys())
+	assert.Equal([]string{"up", "ctrl+p"}, keyMap.List.Up.Keys())
+	assert.Equal([]string{"G"}, keyMap.List.LastPage.Keys(), "single characters keep their case")
+	assert.Equal([]string{"ctrl+s"}, DefaultKeyMap().Form.Save.Keys(), "the defaults stay as they were")
+}
+
+func (suite *KeybindingsTestSuite) TestConfigureKeybindings_Help() {
+	assert := assert.New(suite.T())
+
+	suite.Require().NoError(ConfigureKeybindings(map[string][]string{
+		"form.save": {"ctrl+w"},
+		"list.up":   {"up", "ctrl+p"},
+		"dialog.no": {"space"},
+	}))
+
+	assert.Equal(key.NewBinding(key.WithKeys("ctrl+w"), key.WithHelp("ctrl+w", "Save")).Help(), keys.Form.Save.Help())
+	assert.Equal("↑/ctrl+p", keys.List.Up.Help().Key)
+
+	footer := renderShortHelp([]key.Binding{keys.Form.Save, withHelp(keys.Dialog.No, "Cancel")}, 0)
+	assert.Contains(footer, "ctrl+w")
+	assert.Contains(footer, "space")
+	assert.NotContains(footer, "Ctrl+S")
+
+	assert.Error(ConfigureKeybindings(map[string][]string{"form.submit": {"x"}}))
+	assert.Equal([]string{"ctrl+w"}, keys.Form.Save.Keys(), "a failed configuration leaves the key map as it was")
+}
@@ -0,0 +1,402 @@
+package tui
+
+import "github.com/charmbracelet/bubbles/key"
+
+// KeyMap is the central registry of every key binding in the TUI. Views match key
+// presses against it and build their footers and the help overlay from it, so the
+// help shown to the user is always what the code actually handles.
+type KeyMap struct {
+	Global     GlobalKeyMap
+	Dashboard  DashboardKeyMap
+	List       ListKeyMap
+	Rules      RulesKeyMap
+	Duplicates DuplicatesKeyMap
+	Reconcile  ReconcileKeyMap
+	Changes    ChangesKeyMap
+	Trash      TrashKeyMap
+	Form       FormKeyMap
+	Dialog     DialogKeyMap
+	Input      InputKeyMap
+}
+
+// GlobalKeyMap holds the bindings the app model handles in every view
+type GlobalKeyMap struct {
+	Help      key.Binding
+	Back      key.Binding
+	ForceQuit key.Binding
+	Undo      key.Binding // not in forms and reconciliation, which have their own state
+	Redo      key.Binding
+}
+
+// DashboardKeyMap holds the bindings of the dashboard
+type DashboardKeyMap struct {
+	AddExpense key.Binding
+	AddIncome  key.Binding
+	List       key.Binding
+	Refresh    key.Binding
+	PrevPeriod key.Binding
+	NextPeriod key.Binding
+	PeriodType key.Binding
+	DateRange  key.Binding
+	Today      key.Binding
+	Breakdown  key.Binding
+	Payees     key.Binding
+	QuickAdd   key.Binding
+	Rules      key.Binding
+	Duplicates key.Binding
+	Reconcile  key.Binding
+	Changes    key.Binding
+	Trash      key.Binding
+}
+
+// ListKeyMap holds the bindings of the transaction list
+type ListKeyMap struct {
+	Up             key.Binding
+	Down           key.Binding
+	PrevPage       key.Binding
+	NextPage       key.Binding
+	FirstPage      key.Binding
+	LastPage       key.Binding
+	Toggle         key.Binding
+	SelectPage     key.Binding
+	ClearSelection key.Binding
+	Delete         key.Binding
+	BulkEdit       key.Binding
+	Search         key.Binding
+	ClearSearch    key.Binding
+	Cleared        key.Binding
+	Details        key.Binding
+}
+
+// RulesKeyMap holds the bindings of the rules screen
+type RulesKeyMap struct {
+	Up       key.Binding
+	Down     key.Binding
+	New      key.Binding
+	Edit     key.Binding
+	Delete   key.Binding
+	MoveUp   key.Binding
+	MoveDown key.Binding
+	Apply    key.Binding
+}
+
+// DuplicatesKeyMap holds the bindings of the duplicate review screen
+type DuplicatesKeyMap struct {
+	Up           key.Binding
+	Down         key.Binding
+	Merge        key.Binding
+	MergeNewer   key.Binding
+	KeepBoth     key.Binding
+	NotDuplicate key.Binding
+	DateRange    key.Binding
+}
+
+// ReconcileKeyMap holds the bindings of the reconciliation screen
+type ReconcileKeyMap struct {
+	Up        key.Binding
+	Down      key.Binding
+	Toggle    key.Binding
+	ToggleAll key.Binding
+	Statement key.Binding
+	Finish    key.Binding
+}
+
+// ChangesKeyMap holds the bindings of the recent changes screen
+type ChangesKeyMap struct {
+	Up   key.Binding
+	Down key.Binding
+}
+
+// TrashKeyMap holds the bindings of the trash
+type TrashKeyMap struct {
+	Up      key.Binding
+	Down    key.Binding
+	Restore key.Binding
+	Purge   key.Binding
+	Empty   key.Binding
+}
+
+// FormKeyMap holds the bindings of the add transaction form
+type FormKeyMap struct {
+	Up          key.Binding
+	Down        key.Binding
+	Edit        key.Binding
+	Save        key.Binding
+	SaveAndNew  key.Binding
+	Reset       key.Binding
+	Cancel      key.Binding
+	Confirm     key.Binding
+	ClearField  key.Binding
+	StopEditing key.Binding
+
+	NextSuggestion   key.Binding
+	PrevSuggestion   key.Binding
+	AcceptSuggestion key.Binding
+	AcceptCategory   key.Binding
+
+	RemoveSplit key.Binding
+}
+
+// DialogKeyMap holds the bindings shared by popups, menus and confirmations
+type DialogKeyMap struct {
+	Up     key.Binding
+	Down   key.Binding
+	Select key.Binding
+	Cancel key.Binding
+	Filter key.Binding
+	Yes    key.Binding
+	No     key.Binding
+}
+
+// InputKeyMap holds the bindings of single line prompts such as search; every other key is typed
+type InputKeyMap struct {
+	Apply  key.Binding
+	Cancel key.Binding
+}
+
+// keys is the key map used by all views
+var keys = DefaultKeyMap()
+
+// DefaultKeyMap returns the built-in key bindings
+func DefaultKeyMap() KeyMap {
+	return KeyMap{
+		Global: GlobalKeyMap{
+			Help:      key.NewBinding(key.WithKeys("?", "h"), key.WithHelp("?", "Help")),
+			Back:      key.NewBinding(key.WithKeys("q", "esc"), key.WithHelp("q", "Back")),
+			ForceQuit: key.NewBinding(key.WithKeys("ctrl+c"), key.WithHelp("ctrl+c", "Force quit")),
+			Undo:      key.NewBinding(key.WithKeys("u"), key.WithHelp("u", "Undo")),
+			Redo:      key.NewBinding(key.WithKeys("ctrl+r"), key.WithHelp("ctrl+r", "Redo")),
+		},
+		Dashboard: DashboardKeyMap{
+			AddExpense: key.NewBinding(key.WithKeys("a"), key.WithHelp("a", "Add Expense")),
+			AddIncome:  key.NewBinding(key.WithKeys("i"), key.WithHelp("i", "Add Income")),
+			List:       key.NewBinding(key.WithKeys("l"), key.WithHelp("l", "List All")),
+			Refresh:    key.NewBinding(key.WithKeys("r"), key.WithHelp("r", "Refresh")),
+			PrevPeriod: key.NewBinding(key.WithKeys("left", "["), key.WithHelp("←/[", "Prev Period")),
+			NextPeriod: key.NewBinding(key.WithKeys("right", "]"), key.WithHelp("→/]", "Next Period")),
+			PeriodType: key.NewBinding(key.WithKeys("t"), key.WithHelp("t", "Period Type")),
+			DateRange:  key.NewBinding(key.WithKeys("g"), key.WithHelp("g", "Date Range")),
+			Today:      key.NewBinding(key.WithKeys("."), key.WithHelp(".", "Today")),
+			Breakdown:  key.NewBinding(key.WithKeys("c"), key.WithHelp("c", "Income/Expense Breakdown")),
+			Payees:     key.NewBinding(key.WithKeys("p"), key.WithHelp("p", "Category/Payee Breakdown")),
+			QuickAdd:   key.NewBinding(key.WithKeys("n"), key.WithHelp("n", "Quick Add")),
+			Rules:      key.NewBinding(key.WithKeys("R"), key.WithHelp("R", "Rules")),
+			Duplicates: key.NewBinding(key.WithKeys("D"), key.WithHelp("D", "Duplicates")),
+			Reconcile:  key.NewBinding(key.WithKeys("B"), key.WithHelp("B", "Reconcile")),
+			Changes:    key.NewBinding(key.WithKeys("H"), key.WithHelp("H", "Recent changes")),
+			Trash:      key.NewBinding(key.WithKeys("T"), key.WithHelp("T", "Trash")),
+		},
+		List: ListKeyMap{
+			Up:             key.NewBinding(key.WithKeys("up", "k"), key.WithHelp("↑/k", "Up")),
+			Down:           key.NewBinding(key.WithKeys("down", "j"), key.WithHelp("↓/j", "Down")),
+			PrevPage:       key.NewBinding(key.WithKeys("p", "pgup"), key.WithHelp("p/PgUp", "Previous")),
+			NextPage:       key.NewBinding(key.WithKeys("n", "pgdown"), key.WithHelp("n/PgDn", "Next")),
+			FirstPage:      key.NewBinding(key.WithKeys("home", "ctrl+home"), key.WithHelp("Home", "First")),
+			LastPage:       key.NewBinding(key.WithKeys("end", "ctrl+end"), key.WithHelp("End", "Last")),
+			Toggle:         key.NewBinding(key.WithKeys("x", " "), key.WithHelp("x", "Select")),
+			SelectPage:     key.NewBinding(key.WithKeys("ctrl+a"), key.WithHelp("Ctrl+A", "Select page")),
+			ClearSelection: key.NewBinding(key.WithKeys("esc"), key.WithHelp("Esc", "Clear selection")),
+			Delete:         key.NewBinding(key.WithKeys("d", "delete"), key.WithHelp("d", "Move to trash")),
+			BulkEdit:       key.NewBinding(key.WithKeys("e"), key.WithHelp("e", "Bulk edit")),
+			Search:         key.NewBinding(key.WithKeys("/"), key.WithHelp("/", "Search")),
+			ClearSearch:    key.NewBinding(key.WithKeys("c"), key.WithHelp("c", "Clear")),
+			Cleared:        key.NewBinding(key.WithKeys("C"), key.WithHelp("C", "Toggle cleared")),
+			Details:        key.NewBinding(key.WithKeys("enter"), key.WithHelp("Enter", "Details")),
+		},
+		Rules: RulesKeyMap{
+			Up:       key.NewBinding(key.WithKeys("up", "k"), key.WithHelp("↑/k", "Up")),
+			Down:     key.NewBinding(key.WithKeys("down", "j"), key.WithHelp("↓/j", "Down")),
+			New:      key.NewBinding(key.WithKeys("n"), key.WithHelp("n", "New rule")),
+			Edit:     key.NewBinding(key.WithKeys("e", "enter"), key.WithHelp("e", "Edit")),
+			Delete:   key.NewBinding(key.WithKeys("d", "delete"), key.WithHelp("d", "Delete")),
+			MoveUp:   key.NewBinding(key.WithKeys("K", "shift+up"), key.WithHelp("K", "Move up")),
+			MoveDown: key.NewBinding(key.WithKeys("J", "shift+down"), key.WithHelp("J", "Move down")),
+			Apply:    key.NewBinding(key.WithKeys("a"), key.WithHelp("a", "Re-apply to range")),
+		},
+		Duplicates: DuplicatesKeyMap{
+			Up:           key.NewBinding(key.WithKeys("up", "k"), key.WithHelp("↑/k", "Up")),
+			Down:         key.NewBinding(key.WithKeys("down", "j"), key.WithHelp("↓/j", "Down")),
+			Merge:        key.NewBinding(key.WithKeys("m"), key.WithHelp("m", "Merge into older")),
+			MergeNewer:   key.NewBinding(key.WithKeys("M"), key.WithHelp("M", "Merge into newer")),
+			KeepBoth:     key.NewBinding(key.WithKeys("b"), key.WithHelp("b", "Keep both")),
+			NotDuplicate: key.NewBinding(key.WithKeys("x"), key.WithHelp("x", "Not duplicate")),
+			DateRange:    key.NewBinding(key.WithKeys("g"), key.WithHelp("g", "Date range")),
+		},
+		Reconcile: ReconcileKeyMap{
+			Up:        key.NewBinding(key.WithKeys("up", "k"), key.WithHelp("↑/k", "Up")),
+			Down:      key.NewBinding(key.WithKeys("down", "j"), key.WithHelp("↓/j", "Down")),
+			Toggle:    key.NewBinding(key.WithKeys("x", " "), key.WithHelp("x", "Cleared")),
+			ToggleAll: key.NewBinding(key.WithKeys("ctrl+a"), key.WithHelp("Ctrl+A", "Clear all/none")),
+			Statement: key.NewBinding(key.WithKeys("s"), key.WithHelp("s", "Statement")),
+			Finish:    key.NewBinding(key.WithKeys("f"), key.WithHelp("f", "Finish")),
+		},
+		Changes: ChangesKeyMap{
+			Up:   key.NewBinding(key.WithKeys("up", "k"), key.WithHelp("↑/k", "Up")),
+			Down: key.NewBinding(key.WithKeys("down", "j"), key.WithHelp("↓/j", "Down")),
+		},
+		Trash: TrashKeyMap{
+			Up:      key.NewBinding(key.WithKeys("up", "k"), key.WithHelp("↑/k", "Up")),
+			Down:    key.NewBinding(key.WithKeys("down", "j"), key.WithHelp("↓/j", "Down")),
+			Restore: key.NewBinding(key.WithKeys("r"), key.WithHelp("r", "Restore")),
+			Purge:   key.NewBinding(key.WithKeys("d", "delete"), key.WithHelp("d", "Delete for good")),
+			Empty:   key.NewBinding(key.WithKeys("E"), key.WithHelp("E", "Empty trash")),
+		},
+		Form: FormKeyMap{
+			Up:          key.NewBinding(key.WithKeys("up", "k"), key.WithHelp("↑/k", "Previous field")),
+			Down:        key.NewBinding(key.WithKeys("down", "j"), key.WithHelp("↓/j", "Next field")),
+			Edit:        key.NewBinding(key.WithKeys("enter"), key.WithHelp("Enter", "Edit/Select")),
+			Save:        key.NewBinding(key.WithKeys("ctrl+s"), key.WithHelp("Ctrl+S", "Save")),
+			SaveAndNew:  key.NewBinding(key.WithKeys("ctrl+n", "alt+enter"), key.WithHelp("Ctrl+N", "Save & New")),
+			Reset:       key.NewBinding(key.WithKeys("ctrl+r"), key.WithHelp("Ctrl+R", "Reset")),
+			Cancel:      key.NewBinding(key.WithKeys("esc"), key.WithHelp("Esc", "Cancel")),
+			Confirm:     key.NewBinding(key.WithKeys("enter"), key.WithHelp("Enter", "Confirm")),
+			ClearField:  key.NewBinding(key.WithKeys("ctrl+u"), key.WithHelp("Ctrl+U", "Clear")),
+			StopEditing: key.NewBinding(key.WithKeys("esc"), key.WithHelp("Esc", "Stop editing")),
+
+			NextSuggestion:   key.NewBinding(key.WithKeys("down", "ctrl+n"), key.WithHelp("↓", "Next suggestion")),
+			PrevSuggestion:   key.NewBinding(key.WithKeys("up", "ctrl+p"), key.WithHelp("↑", "Previous suggestion")),
+			AcceptSuggestion: key.NewBinding(key.WithKeys("tab"), key.WithHelp("Tab", "Use suggestion")),
+			AcceptCategory:   key.NewBinding(key.WithKeys("tab"), key.WithHelp("Tab", "Use suggested category")),
+
+			RemoveSplit: key.NewBinding(key.WithKeys("ctrl+d"), key.WithHelp("Ctrl+D", "Remove line")),
+		},
+		Dialog: DialogKeyMap{
+			Up:     key.NewBinding(key.WithKeys("up", "k"), key.WithHelp("↑/k", "Up")),
+			Down:   key.NewBinding(key.WithKeys("down", "j"), key.WithHelp("↓/j", "Down")),
+			Select: key.NewBinding(key.WithKeys("enter"), key.WithHelp("Enter", "Select")),
+			Cancel: key.NewBinding(key.WithKeys("esc", "q"), key.WithHelp("Esc", "Cancel")),
+			Filter: key.NewBinding(key.WithKeys("/"), key.WithHelp("/", "Filter")),
+			Yes:    key.NewBinding(key.WithKeys("y", "Y", "enter"), key.WithHelp("y", "Confirm")),
+			No:     key.NewBinding(key.WithKeys("n", "N", "esc", "q"), key.WithHelp("n/Esc", "Cancel")),
+		},
+		Input: InputKeyMap{
+			Apply:  key.NewBinding(key.WithKeys("enter"), key.WithHelp("Enter", "Apply")),
+			Cancel: key.NewBinding(key.WithKeys("esc"), key.WithHelp("Esc", "Cancel")),
+		},
+	}
+}
+
+func (k GlobalKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.Help, k.Back}
+}
+
+func (k GlobalKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{{k.Help, k.Back, k.ForceQuit, k.Undo, k.Redo}}
+}
+
+func (k DashboardKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.AddExpense, k.AddIncome, k.QuickAdd, k.List, k.PrevPeriod, k.NextPeriod, k.PeriodType}
+}
+
+func (k DashboardKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.AddExpense, k.AddIncome, k.QuickAdd, k.List, k.Rules, k.Duplicates, k.Reconcile, k.Changes, k.Trash, k.Refresh},
+		{k.PrevPeriod, k.NextPeriod, k.PeriodType, k.DateRange, k.Today},
+		{k.Breakdown, k.Payees},
+	}
+}
+
+func (k ListKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.Up, k.Down, k.Toggle, k.Delete, k.BulkEdit, k.Search, k.NextPage, k.PrevPage}
+}
+
+func (k ListKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.Up, k.Down, k.PrevPage, k.NextPage, k.FirstPage, k.LastPage},
+		{k.Details, k.Toggle, k.SelectPage, k.ClearSelection, k.Delete, k.BulkEdit, k.Cleared},
+		{k.Search, k.ClearSearch},
+	}
+}
+
+func (k RulesKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.Up, k.Down, k.New, k.Edit, k.Delete, k.MoveUp, k.MoveDown, k.Apply}
+}
+
+func (k RulesKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.Up, k.Down, k.MoveUp, k.MoveDown},
+		{k.New, k.Edit, k.Delete, k.Apply},
+	}
+}
+
+func (k DuplicatesKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.Up, k.Down, k.Merge, k.MergeNewer, k.KeepBoth, k.NotDuplicate, k.DateRange}
+}
+
+func (k DuplicatesKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.Up, k.Down, k.DateRange},
+		{k.Merge, k.MergeNewer, k.KeepBoth, k.NotDuplicate},
+	}
+}
+
+func (k ReconcileKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.Up, k.Down, k.Toggle, k.ToggleAll, k.Statement, k.Finish}
+}
+
+func (k ReconcileKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.Up, k.Down, k.Toggle, k.ToggleAll},
+		{k.Statement, k.Finish},
+	}
+}
+
+func (k ChangesKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.Up, k.Down}
+}
+
+func (k ChangesKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{{k.Up, k.Down}}
+}
+
+func (k TrashKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.Up, k.Down, k.Restore, k.Purge, k.Empty}
+}
+
+func (k TrashKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.Up, k.Down},
+		{k.Restore, k.Purge, k.Empty},
+	}
+}
+
+func (k FormKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.Up, k.Down, k.Edit, k.Save, k.SaveAndNew, k.Cancel}
+}
+
+func (k FormKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.Up, k.Down, k.Edit, k.Save, k.SaveAndNew, k.Reset, k.Cancel},
+		{k.Confirm, k.ClearField, k.StopEditing},
+		{k.NextSuggestion, k.PrevSuggestion, k.AcceptSuggestion, k.AcceptCategory},
+		{k.RemoveSplit},
+	}
+}
+
+func (k DialogKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.Up, k.Down, k.Select, k.Cancel}
+}
+
+func (k DialogKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{{k.Up, k.Down, k.Select, k.Cancel, k.Filter}, {k.Yes, k.No}}
+}
+
+func (k InputKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.Apply, k.Cancel}
+}
+
+func (k InputKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{{k.Apply, k.Cancel}}
+}
+
+// enabledIf returns a copy of binding that only shows up in help when condition holds
+func enabledIf(binding key.Binding, condition bool) key.Binding {
+	binding.SetEnabled(condition)
+	return binding
+}
+
+// withHelp returns a copy of binding with a different help text for one view
+func withHelp(binding key.Binding, desc string) key.Binding {
+	binding.SetHelp(binding.Help().Key, desc)
+	return binding
+}
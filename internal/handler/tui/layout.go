@@ -233,7 +233,7 @@ func CreateTableHeader(columns []TableColumn) string {
 	// Apply style without padding that could cause wrapping
 	style := lipgloss.NewStyle().
 		Bold(true).
-		Foreground(colorTextPrimary).
+		Foreground(colorTextOnAccent).
 		Background(colorPrimary)
 	
 	return style.Render(headerRow)
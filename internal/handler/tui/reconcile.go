@@ -0,0 +1,481 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"expense-tracker/internal/core/domain"
+	"expense-tracker/internal/core/usecase"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+type reconcileMode int
+
+const (
+	reconcileModeNone reconcileMode = iota
+	reconcileModeStatement
+	reconcileModeConfirmFinish
+)
+
+// statementStep is the statement detail the prompt is asking for
+type statementStep int
+
+const (
+	statementStepDate statementStep = iota
+	statementStepClosing
+	statementStepOpening // only before the first reconciliation
+)
+
+type lastStatementMsg struct {
+	statement *domain.Statement
+	err       error
+}
+
+type reconciliationMsg struct {
+	reconciliation *domain.Reconciliation
+	err            error
+}
+
+type reconciledMsg struct {
+	statement *domain.Statement
+	count     int
+	err       error
+}
+
+// ReconcileModel works through a bank statement: the user enters its end date and closing
+// balance, ticks off the transactions it lists until the difference is zero, and then
+// locks them as reconciled
+type ReconcileModel struct {
+	reconciliationUseCase *usecase.ReconciliationUseCase
+	last                  *domain.Statement
+	reconciliation        *domain.Reconciliation
+	cursor                int
+	mode                  reconcileMode
+	step                  statementStep
+	statementDate         time.Time
+	closingBalance        float64
+	input                 textinput.Model
+	inputErr              string
+	loading               bool
+	err                   error
+	statusMsg             string
+	width                 int
+	height                int
+}
+
+func NewReconcileModel(reconciliationUseCase *usecase.ReconciliationUseCase) *ReconcileModel {
+	input := textinput.New()
+	input.CharLimit = 30
+	input.Width = 30
+
+	return &ReconcileModel{
+		reconciliationUseCase: reconciliationUseCase,
+		input:                 input,
+	}
+}
+
+// Init looks up the last reconciled statement and then asks for the next one
+func (m *ReconcileModel) Init() tea.Cmd {
+	m.reconciliation = nil
+	m.mode = reconcileModeNone
+	m.cursor = 0
+	m.err = nil
+	m.statusMsg = ""
+	m.loading = true
+	return func() tea.Msg {
+		statement, err := m.reconciliationUseCase.LastStatement(context.Background())
+		return lastStatementMsg{statement: statement, err: err}
+	}
+}
+
+// SetDimensions updates the model's width and height for responsive layout
+func (m *ReconcileModel) SetDimensions(width, height int) {
+	m.width = width
+	m.height = height
+}
+
+func (m *ReconcileModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case lastStatementMsg:
+		m.loading = false
+		if msg.err != nil {
+			m.err = msg.err
+			return m, nil
+		}
+		m.last = msg.statement
+		return m, m.promptStatement()
+
+	case reconciliationMsg:
+		m.loading = false
+		if msg.err != nil {
+			m.err = msg.err
+			return m, nil
+		}
+		m.reconciliation = msg.reconciliation
+		m.cursor = min(m.cursor, max(len(m.reconciliation.Transactions)-1, 0))
+		return m, nil
+
+	case reconciledMsg:
+		m.loading = false
+		if msg.err != nil {
+			m.err = msg.err
+			return m, nil
+		}
+		m.last = msg.statement
+		m.reconciliation = nil
+		m.statusMsg = fmt.Sprintf("Reconciled %d transaction(s) against the statement of %s", msg.count, locale.FormatDate(msg.statement.Date))
+		return m, nil
+
+	case tea.KeyMsg:
+		switch m.mode {
+		case reconcileModeStatement:
+			return m, m.updateStatementInput(msg)
+		case reconcileModeConfirmFinish:
+			return m, m.updateConfirmFinish(msg)
+		}
+
+		m.err = nil
+		m.statusMsg = ""
+		if key.Matches(msg, keys.Reconcile.Statement) {
+			return m, m.promptStatement()
+		}
+		if m.reconciliation == nil {
+			return m, nil
+		}
+
+		transactions := m.reconciliation.Transactions
+		switch {
+		case key.Matches(msg, keys.Reconcile.Up):
+			if m.cursor > 0 {
+				m.cursor--
+			}
+		case key.Matches(msg, keys.Reconcile.Down):
+			if m.cursor < len(transactions)-1 {
+				m.cursor++
+			}
+		case key.Matches(msg, keys.Reconcile.Toggle):
+			if m.cursor < len(transactions) {
+				transaction := transactions[m.cursor]
+				if m.cursor < len(transactions)-1 {
+					m.cursor++
+				}
+				return m, m.setCleared([]int{transaction.ID}, !transaction.IsCleared())
+			}
+		case key.Matches(msg, keys.Reconcile.ToggleAll):
+			ids := make([]int, len(transactions))
+			allCleared := true
+			for i, transaction := range transactions {
+				ids[i] = transaction.ID
+				allCleared = allCleared && transaction.IsCleared()
+			}
+			return m, m.setCleared(ids, !allCleared)
+		case key.Matches(msg, keys.Reconcile.Finish):
+			if m.reconciliation.IsBalanced() {
+				m.mode = reconcileModeConfirmFinish
+			} else {
+				m.err = fmt.Errorf("the difference has to be zero to finish")
+			}
+		}
+	}
+	return m, nil
+}
+
+// capturesKey reports whether the reconciliation screen needs a key that would otherwise take the user back
+func (m *ReconcileModel) capturesKey(msg tea.KeyMsg) bool {
+	return m.mode != reconcileModeNone
+}
+
+// promptStatement asks for the details of the next statement, starting with its end date
+func (m *ReconcileModel) promptStatement() tea.Cmd {
+	m.mode = reconcileModeStatement
+	m.step = statementStepDate
+	m.inputErr = ""
+	m.input.SetValue("")
+	m.input.Placeholder = locale.FormatInputDate(domain.Now())
+	return m.input.Focus()
+}
+
+// updateStatementInput handles keys while the statement date and balances are typed
+func (m *ReconcileModel) updateStatementInput(msg tea.KeyMsg) tea.Cmd {
+	switch {
+	case key.Matches(msg, keys.Input.Cancel):
+		m.mode = reconcileModeNone
+		m.input.Blur()
+		return nil
+	case key.Matches(msg, keys.Input.Apply):
+		return m.submitStatementInput()
+	}
+
+	var cmd tea.Cmd
+	m.input, cmd = m.input.Update(msg)
+	return cmd
+}
+
+func (m *ReconcileModel) submitStatementInput() tea.Cmd {
+	m.inputErr = ""
+	value := m.input.Value()
+
+	switch m.step {
+	case statementStepDate:
+		date, err := newDateParser().Parse(value)
+		if err != nil {
+			m.inputErr = err.Error()
+			return nil
+		}
+		m.statementDate = date
+		m.step = statementStepClosing
+		m.input.SetValue("")
+		m.input.Placeholder = locale.FormatAmount(0)
+		return nil
+
+	case statementStepClosing:
+		balance, err := locale.ParseAmount(value)
+		if err != nil {
+			m.inputErr = err.Error()
+			return nil
+		}
+		m.closingBalance = balance
+		if m.last == nil {
+			m.step = statementStepOpening
+			m.input.SetValue("")
+			return nil
+		}
+		return m.start(0)
+
+	case statementStepOpening:
+		balance, err := locale.ParseAmount(value)
+		if err != nil {
+			m.inputErr = err.Error()
+			return nil
+		}
+		return m.start(balance)
+	}
+	return nil
+}
+
+// start loads the transactions of the statement that was just entered
+func (m *ReconcileModel) start(openingBalance float64) tea.Cmd {
+	m.mode = reconcileModeNone
+	m.input.Blur()
+	m.cursor = 0
+	m.loading = true
+	date, closingBalance := m.statementDate, m.closingBalance
+	return func() tea.Msg {
+		reconciliation, err := m.reconciliationUseCase.Start(context.Background(), date, closingBalance, openingBalance)
+		return reconciliationMsg{reconciliation: reconciliation, err: err}
+	}
+}
+
+// setCleared ticks transactions off, or takes them back, on a copy of the reconciliation
+// that replaces the shown one once it is saved
+func (m *ReconcileModel) setCleared(ids []int, cleared bool) tea.Cmd {
+	working := m.reconciliation.Clone()
+	return func() tea.Msg {
+		if err := m.reconciliationUseCase.SetCleared(context.Background(), working, ids, cleared); err != nil {
+			return reconciliationMsg{err: err}
+		}
+		return reconciliationMsg{reconciliation: working}
+	}
+}
+
+func (m *ReconcileModel) updateConfirmFinish(msg tea.KeyMsg) tea.Cmd {
+	switch {
+	case key.Matches(msg, keys.Dialog.Yes):
+		m.mode = reconcileModeNone
+		m.loading = true
+		working := m.reconciliation.Clone()
+		return func() tea.Msg {
+			if err := m.reconciliationUseCase.Finish(context.Background(), working); err != nil {
+				return reconciledMsg{err: err}
+			}
+			return reconciledMsg{statement: working.Statement, count: len(working.ClearedIDs())}
+		}
+	case key.Matches(msg, keys.Dialog.No):
+		m.mode = reconcileModeNone
+	}
+	return nil
+}
+
+func (m *ReconcileModel) View() string {
+	config := NewCenterConfig(m.width, m.height)
+
+	if m.loading {
+		content := loadingStyle.Render("Loading statement...")
+		return lipgloss.Place(config.Width, config.Height,
+			lipgloss.Center, lipgloss.Center, content)
+	}
+
+	helpPanel := m.createHelpPanel()
+
+	if m.mode == reconcileModeConfirmFinish {
+		popup := lipgloss.JoinVertical(lipgloss.Center, m.createFinishPopup(), "", helpPanel)
+		return lipgloss.Place(config.Width, config.Height,
+			lipgloss.Center, lipgloss.Center, popup)
+	}
+
+	panel := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(colorNeutral).
+		Width(config.CalculateContentWidth()-4).
+		Height(max(config.Height-10, 6)).
+		Padding(1, 2).
+		Align(lipgloss.Left).
+		Render(m.createReconcilePanel())
+
+	fullContent := lipgloss.JoinVertical(
+		lipgloss.Center,
+		titleStyle.Render("⚖  Reconcile"),
+		"",
+		panel,
+		"",
+		helpPanel,
+	)
+
+	return lipgloss.Place(config.Width, config.Height,
+		lipgloss.Center, lipgloss.Center, fullContent)
+}
+
+// createReconcilePanel renders the statement prompt, the balances and the transactions to tick off
+func (m *ReconcileModel) createReconcilePanel() string {
+	var b strings.Builder
+
+	if m.reconciliation != nil {
+		statement := m.reconciliation.Statement
+		b.WriteString(summaryHeaderStyle.Render("⚖  Statement ending "+locale.FormatDate(statement.Date)) + "\n")
+		b.WriteString(helpStyle.Render(fmt.Sprintf("Opening %s · Cleared %s · Statement %s",
+			locale.FormatAmount(statement.OpeningBalance),
+			locale.FormatAmount(m.reconciliation.ClearedBalance()),
+			locale.FormatAmount(statement.ClosingBalance))) + "\n")
+		difference := "Difference " + locale.FormatSignedAmount(m.reconciliation.Difference())
+		if m.reconciliation.IsBalanced() {
+			b.WriteString(successStyle.Render(difference+" · ready to finish") + "\n\n")
+		} else {
+			b.WriteString(warningStyle.Render(difference) + "\n\n")
+		}
+	} else {
+		b.WriteString(summaryHeaderStyle.Render("⚖  Reconcile with a statement") + "\n")
+		if m.last != nil {
+			b.WriteString(helpStyle.Render(fmt.Sprintf("Last reconciled: statement of %s, closing balance %s",
+				locale.FormatDate(m.last.Date), locale.FormatAmount(m.last.ClosingBalance))) + "\n\n")
+		} else {
+			b.WriteString(helpStyle.Render("Nothing reconciled yet") + "\n\n")
+		}
+	}
+
+	if m.mode == reconcileModeStatement {
+		labels := map[statementStep]string{
+			statementStepDate:    "Statement end date: ",
+			statementStepClosing: "Closing balance: ",
+			statementStepOpening: "Opening balance, before the first transaction: ",
+		}
+		b.WriteString(labels[m.step] + m.input.View())
+		if m.inputErr != "" {
+			b.WriteString("  " + errorStyle.Render(m.inputErr))
+		}
+		b.WriteString("\n\n")
+	}
+
+	if m.err != nil {
+		b.WriteString(errorStyle.Render("❌ "+m.err.Error()) + "\n\n")
+	} else if m.statusMsg != "" {
+		b.WriteString(successStyle.Render("✅ "+m.statusMsg) + "\n\n")
+	}
+
+	if m.reconciliation == nil {
+		if m.mode != reconcileModeStatement {
+			b.WriteString(helpStyle.Render("Press '" + keys.Reconcile.Statement.Help().Key + "' to enter the next statement."))
+		}
+		return b.String()
+	}
+
+	transactions := m.reconciliation.Transactions
+	if len(transactions) == 0 {
+		b.WriteString(helpStyle.Render("No unreconciled transactions up to the statement date."))
+		return b.String()
+	}
+
+	panelWidth := NewCenterConfig(m.width, m.height).CalculateContentWidth() - 8
+	descriptionWidth := max(panelWidth-30, 15)
+	columns := []TableColumn{
+		{Header: "R", Width: 1, Alignment: lipgloss.Left},
+		{Header: "Date", Width: 12, Alignment: lipgloss.Left},
+		{Header: "Description", Width: descriptionWidth, Alignment: lipgloss.Left},
+		{Header: "Amount", Width: 13, Alignment: lipgloss.Right},
+	}
+
+	totalWidth := 0
+	for _, col := range columns {
+		totalWidth += col.Width + 1
+	}
+	b.WriteString(CreateTableHeader(columns) + "\n" + CreateTableSeparator(totalWidth-1) + "\n")
+
+	// Keep the cursor in view when there are more transactions than rows
+	visibleRows := max(NewCenterConfig(m.width, m.height).Height-22, 3)
+	start := min(max(m.cursor-visibleRows/2, 0), max(len(transactions)-visibleRows, 0))
+	for i := start; i < min(start+visibleRows, len(transactions)); i++ {
+		transaction := transactions[i]
+		mark := ""
+		if transaction.IsCleared() {
+			mark = "c"
+		}
+		row := FormatTableRow(columns, []string{
+			mark,
+			locale.FormatDate(transaction.Date),
+			TruncateWithEllipsis(transaction.Description, descriptionWidth),
+			locale.FormatSignedAmount(transaction.SignedAmount()),
+		})
+		if i == m.cursor {
+			b.WriteString(tableRowSelectedStyle.Render(row))
+		} else if i%2 == 0 {
+			b.WriteString(tableRowStyle.Render(row))
+		} else {
+			b.WriteString(tableRowAltStyle.Render(row))
+		}
+		b.WriteString("\n")
+	}
+	if len(transactions) > visibleRows {
+		b.WriteString(infoStyle.Render(fmt.Sprintf("%d of %d", m.cursor+1, len(transactions))) + "\n")
+	}
+
+	return b.String()
+}
+
+// createFinishPopup asks to confirm locking the cleared transactions
+func (m *ReconcileModel) createFinishPopup() string {
+	var b strings.Builder
+	b.WriteString(modalHeaderStyle.Render("Finish Reconciliation") + "\n\n")
+	b.WriteString(fmt.Sprintf("Lock %d cleared transaction(s) as reconciled against the statement of %s?\n\n",
+		len(m.reconciliation.ClearedIDs()), locale.FormatDate(m.reconciliation.Statement.Date)))
+	b.WriteString(helpDescStyle.Render("Changing them later asks to unlock them first") + "\n\n")
+	b.WriteString(renderShortHelp([]key.Binding{withHelp(keys.Dialog.Yes, "Finish"), withHelp(keys.Dialog.No, "Cancel")}, 0))
+	return modalStyle.Render(b.String())
+}
+
+// createHelpPanel renders the footer for the current mode
+func (m *ReconcileModel) createHelpPanel() string {
+	width := NewCenterConfig(m.width, m.height).CalculateContentWidth()
+
+	switch m.mode {
+	case reconcileModeStatement:
+		return renderShortHelp(keys.Input.ShortHelp(), width)
+	case reconcileModeConfirmFinish:
+		return renderShortHelp([]key.Binding{withHelp(keys.Dialog.Yes, "Finish"), keys.Dialog.No}, width)
+	}
+
+	started := m.reconciliation != nil
+	hasTransactions := started && len(m.reconciliation.Transactions) > 0
+	reconcile := keys.Reconcile
+	return renderFooterHelp([]key.Binding{
+		enabledIf(reconcile.Up, hasTransactions),
+		enabledIf(reconcile.Down, hasTransactions),
+		enabledIf(reconcile.Toggle, hasTransactions),
+		enabledIf(reconcile.ToggleAll, hasTransactions),
+		reconcile.Statement,
+		enabledIf(reconcile.Finish, started && m.reconciliation.IsBalanced()),
+	}, keys.Global.Back, width)
+}
@@ -0,0 +1,505 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"expense-tracker/internal/core/domain"
+	"expense-tracker/internal/core/usecase"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+type rulesMode int
+
+const (
+	rulesModeNone rulesMode = iota
+	rulesModeEdit
+	rulesModeConfirmDelete
+	rulesModeRange
+	rulesModePreview
+)
+
+// previewRows is how many changes the preview shows at once
+const previewRows = 10
+
+type rulesMsg struct {
+	rules []*domain.Rule
+	err   error
+}
+
+type ruleSavedMsg struct {
+	err error
+}
+
+type rulePreviewMsg struct {
+	changes []*domain.RuleChange
+	err     error
+}
+
+type rulesAppliedMsg struct {
+	count int
+	err   error
+}
+
+// RulesModel lists the categorization rules in the order they run and lets the user edit
+// them and re-apply them to earlier transactions
+type RulesModel struct {
+	ruleUseCase   *usecase.RuleUseCase
+	rules         []*domain.Rule
+	cursor        int
+	mode          rulesMode
+	editingID     int
+	ruleInput     textinput.Model
+	rangeInput    textinput.Model
+	inputErr      string
+	changes       []*domain.RuleChange
+	previewCursor int
+	loading       bool
+	err           error
+	statusMsg     string
+	width         int
+	height        int
+}
+
+func NewRulesModel(ruleUseCase *usecase.RuleUseCase) *RulesModel {
+	ruleInput := textinput.New()
+	ruleInput.Placeholder = `contains:amzn then category:shopping payee:Amazon`
+	ruleInput.CharLimit = 300
+
+	rangeInput := textinput.New()
+	rangeInput.Placeholder = locale.FormatInputDate(time.Date(2026, 1, 1, 0, 0, 0, 0, time.Local)) + ".." + locale.FormatInputDate(time.Date(2026, 3, 31, 0, 0, 0, 0, time.Local))
+	rangeInput.CharLimit = 30
+	rangeInput.Width = 30
+
+	return &RulesModel{
+		ruleUseCase: ruleUseCase,
+		ruleInput:   ruleInput,
+		rangeInput:  rangeInput,
+	}
+}
+
+func (m *RulesModel) Init() tea.Cmd {
+	m.mode = rulesModeNone
+	m.statusMsg = ""
+	return m.fetchRules()
+}
+
+// SetDimensions updates the model's width and height for responsive layout
+func (m *RulesModel) SetDimensions(width, height int) {
+	m.width = width
+	m.height = height
+	m.ruleInput.Width = max(NewCenterConfig(width, height).CalculateContentWidth()-12, 20)
+}
+
+func (m *RulesModel) fetchRules() tea.Cmd {
+	m.loading = true
+	return func() tea.Msg {
+		rules, err := m.ruleUseCase.GetRules(context.Background())
+		return rulesMsg{rules: rules, err: err}
+	}
+}
+
+func (m *RulesModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case rulesMsg:
+		m.loading = false
+		m.err = msg.err
+		if msg.err == nil {
+			m.rules = msg.rules
+			m.cursor = min(m.cursor, max(len(m.rules)-1, 0))
+		}
+		return m, nil
+
+	case ruleSavedMsg:
+		if msg.err != nil {
+			if m.mode == rulesModeEdit {
+				m.inputErr = msg.err.Error()
+			} else {
+				m.err = msg.err
+			}
+			return m, nil
+		}
+		if m.mode == rulesModeEdit {
+			m.statusMsg = "Rule saved"
+		}
+		m.mode = rulesModeNone
+		m.ruleInput.Blur()
+		return m, m.fetchRules()
+
+	case rulePreviewMsg:
+		m.loading = false
+		if msg.err != nil {
+			m.err = msg.err
+			m.mode = rulesModeNone
+			return m, nil
+		}
+		if len(msg.changes) == 0 {
+			m.statusMsg = "The rules change nothing in that range"
+			m.mode = rulesModeNone
+			return m, nil
+		}
+		m.changes = msg.changes
+		m.previewCursor = 0
+		m.mode = rulesModePreview
+		return m, nil
+
+	case rulesAppliedMsg:
+		m.loading = false
+		m.changes = nil
+		if msg.err != nil {
+			m.err = msg.err
+			return m, nil
+		}
+		m.statusMsg = fmt.Sprintf("Updated %d transactions", msg.count)
+		return m, nil
+
+	case tea.KeyMsg:
+		switch m.mode {
+		case rulesModeEdit:
+			return m, m.updateRuleInput(msg)
+		case rulesModeRange:
+			return m, m.updateRangeInput(msg)
+		case rulesModeConfirmDelete:
+			return m, m.updateConfirmDelete(msg)
+		case rulesModePreview:
+			return m, m.updatePreview(msg)
+		}
+
+		m.err = nil
+		m.statusMsg = ""
+		switch {
+		case key.Matches(msg, keys.Rules.Up):
+			if m.cursor > 0 {
+				m.cursor--
+			}
+		case key.Matches(msg, keys.Rules.Down):
+			if m.cursor < len(m.rules)-1 {
+				m.cursor++
+			}
+		case key.Matches(msg, keys.Rules.New):
+			return m, m.openRuleInput(0, "")
+		case key.Matches(msg, keys.Rules.Edit):
+			if rule := m.selectedRule(); rule != nil {
+				return m, m.openRuleInput(rule.ID, domain.FormatRule(rule, locale))
+			}
+		case key.Matches(msg, keys.Rules.Delete):
+			if m.selectedRule() != nil {
+				m.mode = rulesModeConfirmDelete
+			}
+		case key.Matches(msg, keys.Rules.MoveUp):
+			return m, m.moveRule(-1)
+		case key.Matches(msg, keys.Rules.MoveDown):
+			return m, m.moveRule(1)
+		case key.Matches(msg, keys.Rules.Apply):
+			if len(m.rules) > 0 {
+				m.mode = rulesModeRange
+				m.inputErr = ""
+				m.rangeInput.SetValue("")
+				return m, m.rangeInput.Focus()
+			}
+		}
+	}
+	return m, nil
+}
+
+// capturesKey reports whether the rules screen needs a key that would otherwise take the user back
+func (m *RulesModel) capturesKey(msg tea.KeyMsg) bool {
+	return m.mode != rulesModeNone
+}
+
+func (m *RulesModel) selectedRule() *domain.Rule {
+	if m.cursor < len(m.rules) {
+		return m.rules[m.cursor]
+	}
+	return nil
+}
+
+func (m *RulesModel) openRuleInput(id int, value string) tea.Cmd {
+	m.mode = rulesModeEdit
+	m.editingID = id
+	m.inputErr = ""
+	m.ruleInput.SetValue(value)
+	m.ruleInput.CursorEnd()
+	return m.ruleInput.Focus()
+}
+
+// updateRuleInput handles keys while a rule is typed; the rule is parsed and saved in one go
+func (m *RulesModel) updateRuleInput(msg tea.KeyMsg) tea.Cmd {
+	switch {
+	case key.Matches(msg, keys.Input.Cancel):
+		m.mode = rulesModeNone
+		m.ruleInput.Blur()
+		return nil
+	case key.Matches(msg, keys.Input.Apply):
+		input, id := m.ruleInput.Value(), m.editingID
+		return func() tea.Msg {
+			ctx := context.Background()
+			rule, err := m.ruleUseCase.ParseRule(ctx, input, locale)
+			if err != nil {
+				return ruleSavedMsg{err: err}
+			}
+			rule.ID = id
+			return ruleSavedMsg{err: m.ruleUseCase.SaveRule(ctx, rule)}
+		}
+	}
+
+	var cmd tea.Cmd
+	m.ruleInput, cmd = m.ruleInput.Update(msg)
+	return cmd
+}
+
+func (m *RulesModel) updateConfirmDelete(msg tea.KeyMsg) tea.Cmd {
+	switch {
+	case key.Matches(msg, keys.Dialog.Yes):
+		m.mode = rulesModeNone
+		id := m.selectedRule().ID
+		return func() tea.Msg {
+			return ruleSavedMsg{err: m.ruleUseCase.DeleteRule(context.Background(), id)}
+		}
+	case key.Matches(msg, keys.Dialog.No):
+		m.mode = rulesModeNone
+	}
+	return nil
+}
+
+// moveRule moves the rule under the cursor and keeps the cursor on it
+func (m *RulesModel) moveRule(offset int) tea.Cmd {
+	rule := m.selectedRule()
+	if rule == nil {
+		return nil
+	}
+	m.cursor = min(max(m.cursor+offset, 0), len(m.rules)-1)
+	return func() tea.Msg {
+		rules, err := m.ruleUseCase.MoveRule(context.Background(), rule.ID, offset)
+		return rulesMsg{rules: rules, err: err}
+	}
+}
+
+// updateRangeInput handles keys while the date range to re-apply the rules to is typed
+func (m *RulesModel) updateRangeInput(msg tea.KeyMsg) tea.Cmd {
+	switch {
+	case key.Matches(msg, keys.Input.Cancel):
+		m.mode = rulesModeNone
+		m.rangeInput.Blur()
+		return nil
+	case key.Matches(msg, keys.Input.Apply):
+		dateRange, err := domain.ParseDateRange(m.rangeInput.Value(), locale)
+		if err != nil {
+			m.inputErr = err.Error()
+			return nil
+		}
+		m.rangeInput.Blur()
+		m.loading = true
+		return func() tea.Msg {
+			changes, err := m.ruleUseCase.PreviewRules(context.Background(), dateRange.Start, dateRange.End)
+			return rulePreviewMsg{changes: changes, err: err}
+		}
+	}
+
+	var cmd tea.Cmd
+	m.rangeInput, cmd = m.rangeInput.Update(msg)
+	return cmd
+}
+
+// updatePreview scrolls through the previewed changes until they are applied or dropped
+func (m *RulesModel) updatePreview(msg tea.KeyMsg) tea.Cmd {
+	switch {
+	case key.Matches(msg, keys.Dialog.Up):
+		if m.previewCursor > 0 {
+			m.previewCursor--
+		}
+	case key.Matches(msg, keys.Dialog.Down):
+		if m.previewCursor < len(m.changes)-1 {
+			m.previewCursor++
+		}
+	case key.Matches(msg, keys.Dialog.Yes):
+		m.mode = rulesModeNone
+		m.loading = true
+		changes := m.changes
+		return func() tea.Msg {
+			err := m.ruleUseCase.ApplyRuleChanges(context.Background(), changes)
+			return rulesAppliedMsg{count: len(changes), err: err}
+		}
+	case key.Matches(msg, keys.Dialog.No):
+		m.mode = rulesModeNone
+		m.changes = nil
+	}
+	return nil
+}
+
+func (m *RulesModel) View() string {
+	config := NewCenterConfig(m.width, m.height)
+
+	if m.loading {
+		content := loadingStyle.Render("Loading rules...")
+		return lipgloss.Place(config.Width, config.Height,
+			lipgloss.Center, lipgloss.Center, content)
+	}
+
+	helpPanel := m.createHelpPanel()
+
+	// The delete confirmation and the preview replace the list while they are open
+	if m.mode == rulesModeConfirmDelete || m.mode == rulesModePreview {
+		popup := lipgloss.JoinVertical(lipgloss.Center, m.createPopup(), "", helpPanel)
+		return lipgloss.Place(config.Width, config.Height,
+			lipgloss.Center, lipgloss.Center, popup)
+	}
+
+	panel := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(colorNeutral).
+		Width(config.CalculateContentWidth()-4).
+		Height(max(config.Height-10, 6)).
+		Padding(1, 2).
+		Align(lipgloss.Left).
+		Render(m.createRulesPanel())
+
+	fullContent := lipgloss.JoinVertical(
+		lipgloss.Center,
+		titleStyle.Render("⚙  Rules"),
+		"",
+		panel,
+		"",
+		helpPanel,
+	)
+
+	return lipgloss.Place(config.Width, config.Height,
+		lipgloss.Center, lipgloss.Center, fullContent)
+}
+
+// createRulesPanel renders the prompts, messages and the rule table
+func (m *RulesModel) createRulesPanel() string {
+	var b strings.Builder
+
+	b.WriteString(summaryHeaderStyle.Render(fmt.Sprintf("⚙  Rules (%d)", len(m.rules))) + "\n")
+	b.WriteString(helpStyle.Render("Rules run top to bottom when a transaction is added; later rules win") + "\n\n")
+
+	switch m.mode {
+	case rulesModeEdit:
+		title := "New rule"
+		if m.editingID > 0 {
+			title = "Edit rule"
+		}
+		b.WriteString(formFieldLabelStyle.Render(title+":") + "\n" + inputFocusedStyle.Render(m.ruleInput.View()) + "\n")
+		if m.inputErr != "" {
+			b.WriteString(errorStyle.Render("❌ "+m.inputErr) + "\n")
+		}
+		b.WriteString("\n")
+	case rulesModeRange:
+		b.WriteString("Re-apply to: " + m.rangeInput.View())
+		if m.inputErr != "" {
+			b.WriteString("  " + errorStyle.Render(m.inputErr))
+		}
+		b.WriteString("\n\n")
+	}
+
+	if m.err != nil {
+		b.WriteString(errorStyle.Render("❌ "+m.err.Error()) + "\n\n")
+	} else if m.statusMsg != "" {
+		b.WriteString(successStyle.Render("✅ "+m.statusMsg) + "\n\n")
+	}
+
+	if len(m.rules) == 0 {
+		b.WriteString(helpStyle.Render("No rules yet. Press '" + keys.Rules.New.Help().Key + "' to add one."))
+		return b.String()
+	}
+
+	panelWidth := NewCenterConfig(m.width, m.height).CalculateContentWidth() - 8
+	columns := []TableColumn{
+		{Header: "#", Width: 3, Alignment: lipgloss.Right},
+		{Header: "Name", Width: max(panelWidth*20/100, 10), Alignment: lipgloss.Left},
+		{Header: "Rule", Width: max(panelWidth*80/100-6, 20), Alignment: lipgloss.Left},
+	}
+
+	totalWidth := 0
+	for _, col := range columns {
+		totalWidth += col.Width + 1
+	}
+	b.WriteString(CreateTableHeader(columns) + "\n" + CreateTableSeparator(totalWidth-1) + "\n")
+
+	for i, rule := range m.rules {
+		row := FormatTableRow(columns, []string{
+			strconv.Itoa(i + 1),
+			TruncateWithEllipsis(rule.Name, columns[1].Width),
+			TruncateWithEllipsis(domain.FormatRule(rule, locale), columns[2].Width),
+		})
+		if i == m.cursor {
+			b.WriteString(tableRowSelectedStyle.Render(row))
+		} else if i%2 == 0 {
+			b.WriteString(tableRowStyle.Render(row))
+		} else {
+			b.WriteString(tableRowAltStyle.Render(row))
+		}
+		b.WriteString("\n")
+	}
+
+	return b.String()
+}
+
+// createPopup renders the delete confirmation or the preview of re-applied rules
+func (m *RulesModel) createPopup() string {
+	var b strings.Builder
+
+	switch m.mode {
+	case rulesModeConfirmDelete:
+		b.WriteString(modalHeaderStyle.Render("Delete Rule") + "\n\n")
+		b.WriteString(TruncateWithEllipsis(domain.FormatRule(m.selectedRule(), locale), 60) + "\n\n")
+		b.WriteString(renderShortHelp([]key.Binding{withHelp(keys.Dialog.Yes, "Delete"), withHelp(keys.Dialog.No, "Cancel")}, 0))
+
+	case rulesModePreview:
+		b.WriteString(modalHeaderStyle.Render(fmt.Sprintf("Apply Rules to %d Transactions?", len(m.changes))) + "\n\n")
+		start := min(max(m.previewCursor-previewRows/2, 0), max(len(m.changes)-previewRows, 0))
+		for i := start; i < min(start+previewRows, len(m.changes)); i++ {
+			change := m.changes[i]
+			line := fmt.Sprintf("%s  %s: %s",
+				locale.FormatShortDate(change.Before.Date),
+				TruncateWithEllipsis(change.Before.Description, 24),
+				strings.Join(change.Differences(), ", "))
+			line = TruncateWithEllipsis(line, 72)
+			if i == m.previewCursor {
+				b.WriteString(dropdownItemSelectedStyle.Render("▶ " + line))
+			} else {
+				b.WriteString(dropdownItemStyle.Render("  " + line))
+			}
+			b.WriteString("\n")
+		}
+		if len(m.changes) > previewRows {
+			b.WriteString(infoStyle.Render(fmt.Sprintf("%d of %d", m.previewCursor+1, len(m.changes))) + "\n")
+		}
+	}
+
+	return modalStyle.Render(b.String())
+}
+
+// createHelpPanel renders the footer for the current mode
+func (m *RulesModel) createHelpPanel() string {
+	width := NewCenterConfig(m.width, m.height).CalculateContentWidth()
+
+	switch m.mode {
+	case rulesModeEdit, rulesModeRange:
+		return renderShortHelp(keys.Input.ShortHelp(), width)
+	case rulesModeConfirmDelete:
+		return renderShortHelp([]key.Binding{keys.Dialog.Yes, keys.Dialog.No}, width)
+	case rulesModePreview:
+		return renderShortHelp([]key.Binding{keys.Dialog.Up, keys.Dialog.Down, withHelp(keys.Dialog.Yes, "Apply"), keys.Dialog.No}, width)
+	}
+
+	hasRules := len(m.rules) > 0
+	rules := keys.Rules
+	return renderFooterHelp([]key.Binding{
+		rules.Up,
+		rules.Down,
+		rules.New,
+		enabledIf(rules.Edit, hasRules),
+		enabledIf(rules.Delete, hasRules),
+		enabledIf(rules.MoveUp, len(m.rules) > 1),
+		enabledIf(rules.MoveDown, len(m.rules) > 1),
+		enabledIf(rules.Apply, hasRules),
+	}, keys.Global.Back, width)
+}
@@ -2,26 +2,144 @@ package tui
 
 import "github.com/charmbracelet/lipgloss"
 
-// Color palette constants following the design system
-const (
-	colorPrimary   = lipgloss.Color("#0066cc")
-	colorSuccess   = lipgloss.Color("#22c55e")
-	colorWarning   = lipgloss.Color("#eab308")
-	colorError     = lipgloss.Color("#ef4444")
-	colorNeutral   = lipgloss.Color("#6b7280")
-	
+// Color palette following the design system, set from the active theme
+var (
+	colorPrimary lipgloss.TerminalColor
+	colorSuccess lipgloss.TerminalColor
+	colorWarning lipgloss.TerminalColor
+	colorError   lipgloss.TerminalColor
+	colorNeutral lipgloss.TerminalColor
+
 	// Text colors
-	colorTextPrimary   = lipgloss.Color("#FAFAFA")
-	colorTextSecondary = lipgloss.Color("#626262")
-	colorTextMuted     = lipgloss.Color("#404040")
-	
+	colorTextPrimary   lipgloss.TerminalColor
+	colorTextSecondary lipgloss.TerminalColor
+	colorTextMuted     lipgloss.TerminalColor
+	colorTextOnAccent  lipgloss.TerminalColor
+
 	// Background colors
-	colorBackgroundInput    = lipgloss.Color("#333333")
-	colorBackgroundSelected = lipgloss.Color("#0066cc")
+	colorBackgroundInput    lipgloss.TerminalColor
+	colorBackgroundSelected lipgloss.TerminalColor
+	colorBackgroundAlt      lipgloss.TerminalColor
 )
 
+// categoryPalette holds the colors used for category breakdown segments.
+// Categories are mapped onto it by name so each keeps its color between refreshes.
+var categoryPalette []lipgloss.TerminalColor
+
+func init() {
+	applyTheme(DarkTheme())
+}
+
 // Application-level styles
 var (
+	appContainerStyle lipgloss.Style
+	titleStyle        lipgloss.Style
+	panelStyle        lipgloss.Style
+	panelHeaderStyle  lipgloss.Style
+)
+
+// Dashboard-specific styles
+var (
+	summaryBoxStyle         lipgloss.Style
+	summaryHeaderStyle      lipgloss.Style
+	incomeStyle             lipgloss.Style
+	expenseStyle            lipgloss.Style
+	balancePositiveStyle    lipgloss.Style
+	balanceNegativeStyle    lipgloss.Style
+	expenseBarStyle         lipgloss.Style
+	expenseBarCategoryStyle lipgloss.Style
+)
+
+// Table styles
+var (
+	tableHeaderStyle      lipgloss.Style
+	tableRowStyle         lipgloss.Style
+	tableRowSelectedStyle lipgloss.Style
+	tableRowAltStyle      lipgloss.Style
+	tableSeparatorStyle   lipgloss.Style
+)
+
+// Form styles
+var (
+	formStyle           lipgloss.Style
+	formFieldLabelStyle lipgloss.Style
+	formFieldStyle      lipgloss.Style
+)
+
+// Input styles
+var (
+	inputStyle            lipgloss.Style
+	inputFocusedStyle     lipgloss.Style
+	inputErrorStyle       lipgloss.Style
+	inputPlaceholderStyle lipgloss.Style
+)
+
+// Selection and dropdown styles
+var (
+	selectedItemStyle         lipgloss.Style
+	highlightedItemStyle      lipgloss.Style
+	dropdownStyle             lipgloss.Style
+	dropdownItemStyle         lipgloss.Style
+	dropdownItemSelectedStyle lipgloss.Style
+)
+
+// Status and message styles
+var (
+	successStyle lipgloss.Style
+	errorStyle   lipgloss.Style
+	warningStyle lipgloss.Style
+	infoStyle    lipgloss.Style
+	loadingStyle lipgloss.Style
+)
+
+// Help and navigation styles
+var (
+	helpStyle       lipgloss.Style
+	helpKeyStyle    lipgloss.Style
+	helpDescStyle   lipgloss.Style
+	navigationStyle lipgloss.Style
+)
+
+// Modal and dialog styles
+var (
+	modalOverlayStyle       lipgloss.Style
+	modalStyle              lipgloss.Style
+	modalHeaderStyle        lipgloss.Style
+	modalButtonStyle        lipgloss.Style
+	modalButtonFocusedStyle lipgloss.Style
+)
+
+// Search and filter styles
+var (
+	searchBoxStyle        lipgloss.Style
+	searchBoxFocusedStyle lipgloss.Style
+	filterTagStyle        lipgloss.Style
+	activeFilterTagStyle  lipgloss.Style
+)
+
+// Responsive utility styles
+var (
+	compactStyle  lipgloss.Style
+	expandedStyle lipgloss.Style
+)
+
+// applyTheme sets the palette from theme and rebuilds every style from it
+func applyTheme(theme Theme) {
+	colorPrimary = theme.Primary
+	colorSuccess = theme.Success
+	colorWarning = theme.Warning
+	colorError = theme.Error
+	colorNeutral = theme.Neutral
+	colorTextPrimary = theme.TextPrimary
+	colorTextSecondary = theme.TextSecondary
+	colorTextMuted = theme.TextMuted
+	colorTextOnAccent = theme.TextOnAccent
+	colorBackgroundInput = theme.BackgroundInput
+	colorBackgroundSelected = theme.BackgroundSelected
+	colorBackgroundAlt = theme.BackgroundAlt
+	categoryPalette = theme.CategoryPalette
+
+	// Application-level styles
 	// Main application container
 	appContainerStyle = lipgloss.NewStyle().
 		Border(lipgloss.RoundedBorder()).
@@ -32,7 +150,7 @@ var (
 	// Application title
 	titleStyle = lipgloss.NewStyle().
 		Bold(true).
-		Foreground(colorTextPrimary).
+		Foreground(colorTextOnAccent).
 		Background(colorPrimary).
 		Padding(0, 1)
 
@@ -44,13 +162,11 @@ var (
 
 	panelHeaderStyle = lipgloss.NewStyle().
 		Bold(true).
-		Foreground(colorTextPrimary).
+		Foreground(colorTextOnAccent).
 		Background(colorNeutral).
 		Padding(0, 1)
-)
 
-// Dashboard-specific styles
-var (
+	// Dashboard-specific styles
 	// Summary panel styles
 	summaryBoxStyle = lipgloss.NewStyle().
 		Border(lipgloss.RoundedBorder()).
@@ -84,19 +200,17 @@ var (
 	// Expense breakdown bar styles
 	expenseBarStyle = lipgloss.NewStyle().
 		Background(colorError).
-		Foreground(colorTextPrimary)
+		Foreground(colorTextOnAccent)
 
 	expenseBarCategoryStyle = lipgloss.NewStyle().
 		Background(colorNeutral).
-		Foreground(colorTextPrimary).
+		Foreground(colorTextOnAccent).
 		Padding(0, 1)
-)
 
-// Table styles
-var (
+	// Table styles
 	tableHeaderStyle = lipgloss.NewStyle().
 		Bold(true).
-		Foreground(colorTextPrimary).
+		Foreground(colorTextOnAccent).
 		Background(colorPrimary).
 		Padding(0, 1)
 
@@ -104,20 +218,17 @@ var (
 		Foreground(colorTextPrimary)
 
 	tableRowSelectedStyle = lipgloss.NewStyle().
-		Foreground(colorTextPrimary).
-		Background(colorBackgroundSelected).
-		Padding(0, 1)
+		Foreground(colorTextOnAccent).
+		Background(colorBackgroundSelected)
 
 	tableRowAltStyle = lipgloss.NewStyle().
 		Foreground(colorTextPrimary).
-		Background(lipgloss.Color("#1a1a1a"))
+		Background(colorBackgroundAlt)
 
 	tableSeparatorStyle = lipgloss.NewStyle().
 		Foreground(colorNeutral)
-)
 
-// Form styles
-var (
+	// Form styles
 	formStyle = lipgloss.NewStyle().
 		Border(lipgloss.RoundedBorder()).
 		BorderForeground(colorPrimary).
@@ -131,39 +242,35 @@ var (
 	formFieldStyle = lipgloss.NewStyle().
 		Padding(0, 1).
 		MarginBottom(1)
-)
 
-// Input styles
-var (
+	// Input styles
 	inputStyle = lipgloss.NewStyle().
 		Foreground(colorTextPrimary).
 		Background(colorBackgroundInput).
 		Padding(0, 1)
 
 	inputFocusedStyle = lipgloss.NewStyle().
-		Foreground(colorTextPrimary).
+		Foreground(colorTextOnAccent).
 		Background(colorPrimary).
 		Padding(0, 1)
 
 	inputErrorStyle = lipgloss.NewStyle().
-		Foreground(colorTextPrimary).
+		Foreground(colorTextOnAccent).
 		Background(colorError).
 		Padding(0, 1)
 
 	inputPlaceholderStyle = lipgloss.NewStyle().
 		Foreground(colorTextMuted).
 		Italic(true)
-)
 
-// Selection and dropdown styles
-var (
+	// Selection and dropdown styles
 	selectedItemStyle = lipgloss.NewStyle().
-		Foreground(colorTextPrimary).
+		Foreground(colorTextOnAccent).
 		Background(colorPrimary).
 		Padding(0, 1)
 
 	highlightedItemStyle = lipgloss.NewStyle().
-		Foreground(colorTextPrimary).
+		Foreground(colorTextOnAccent).
 		Background(colorBackgroundSelected).
 		Padding(0, 1)
 
@@ -178,13 +285,11 @@ var (
 		Padding(0, 1)
 
 	dropdownItemSelectedStyle = lipgloss.NewStyle().
-		Foreground(colorTextPrimary).
+		Foreground(colorTextOnAccent).
 		Background(colorPrimary).
 		Padding(0, 1)
-)
 
-// Status and message styles
-var (
+	// Status and message styles
 	successStyle = lipgloss.NewStyle().
 		Foreground(colorSuccess).
 		Bold(true)
@@ -204,10 +309,8 @@ var (
 	loadingStyle = lipgloss.NewStyle().
 		Foreground(colorTextSecondary).
 		Italic(true)
-)
 
-// Help and navigation styles
-var (
+	// Help and navigation styles
 	helpStyle = lipgloss.NewStyle().
 		Foreground(colorTextSecondary).
 		MarginTop(1)
@@ -222,24 +325,22 @@ var (
 	navigationStyle = lipgloss.NewStyle().
 		Foreground(colorTextSecondary).
 		Align(lipgloss.Center)
-)
 
-// Modal and dialog styles
-var (
+	// Modal and dialog styles
 	modalOverlayStyle = lipgloss.NewStyle().
-		Background(lipgloss.Color("#000000")).
+		Background(colorBackgroundAlt).
 		Foreground(colorTextPrimary)
 
 	modalStyle = lipgloss.NewStyle().
 		Border(lipgloss.RoundedBorder()).
 		BorderForeground(colorPrimary).
-		Background(lipgloss.Color("#1a1a1a")).
+		Background(colorBackgroundAlt).
 		Padding(2, 3).
 		Align(lipgloss.Center)
 
 	modalHeaderStyle = lipgloss.NewStyle().
 		Bold(true).
-		Foreground(colorTextPrimary).
+		Foreground(colorTextOnAccent).
 		Background(colorPrimary).
 		Padding(0, 1).
 		Align(lipgloss.Center)
@@ -254,12 +355,10 @@ var (
 		Border(lipgloss.RoundedBorder()).
 		BorderForeground(colorPrimary).
 		Background(colorPrimary).
-		Foreground(colorTextPrimary).
+		Foreground(colorTextOnAccent).
 		Padding(0, 2)
-)
 
-// Search and filter styles
-var (
+	// Search and filter styles
 	searchBoxStyle = lipgloss.NewStyle().
 		Border(lipgloss.RoundedBorder()).
 		BorderForeground(colorPrimary).
@@ -269,29 +368,38 @@ var (
 		Border(lipgloss.RoundedBorder()).
 		BorderForeground(colorPrimary).
 		Background(colorPrimary).
-		Foreground(colorTextPrimary).
+		Foreground(colorTextOnAccent).
 		Padding(0, 1)
 
 	filterTagStyle = lipgloss.NewStyle().
 		Background(colorNeutral).
-		Foreground(colorTextPrimary).
+		Foreground(colorTextOnAccent).
 		Padding(0, 1).
 		MarginRight(1)
 
 	activeFilterTagStyle = lipgloss.NewStyle().
 		Background(colorPrimary).
-		Foreground(colorTextPrimary).
+		Foreground(colorTextOnAccent).
 		Padding(0, 1).
 		MarginRight(1)
-)
 
-// Responsive utility styles
-var (
+	// Responsive utility styles
 	// For narrow terminals
 	compactStyle = lipgloss.NewStyle().
 		Padding(0, 1)
 
-	// For wide terminals  
+	// For wide terminals
 	expandedStyle = lipgloss.NewStyle().
 		Padding(1, 3)
-)
+
+	if theme.Monochrome {
+		// Without colors, focus and selection are shown in reverse video
+		for _, style := range []*lipgloss.Style{
+			&titleStyle, &tableHeaderStyle, &tableRowSelectedStyle, &inputFocusedStyle,
+			&selectedItemStyle, &highlightedItemStyle, &dropdownItemSelectedStyle,
+			&modalHeaderStyle, &modalButtonFocusedStyle, &searchBoxFocusedStyle, &activeFilterTagStyle,
+		} {
+			*style = style.Reverse(true)
+		}
+	}
+}
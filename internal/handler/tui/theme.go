@@ -0,0 +1,254 @@
+package tui
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Theme is the set of colors every style in the TUI is built from
+type Theme struct {
+	Name string
+
+	Primary lipgloss.TerminalColor
+	Success lipgloss.TerminalColor
+	Warning lipgloss.TerminalColor
+	Error   lipgloss.TerminalColor
+	Neutral lipgloss.TerminalColor
+
+	TextPrimary   lipgloss.TerminalColor
+	TextSecondary lipgloss.TerminalColor
+	TextMuted     lipgloss.TerminalColor
+	TextOnAccent  lipgloss.TerminalColor // text drawn on primary, selected or error backgrounds
+
+	BackgroundInput    lipgloss.TerminalColor
+	BackgroundSelected lipgloss.TerminalColor
+	BackgroundAlt      lipgloss.TerminalColor // alternating rows and dialogs
+
+	CategoryPalette []lipgloss.TerminalColor
+
+	// Monochrome themes mark focus and selection with reverse video instead of colors
+	Monochrome bool
+}
+
+// Built-in theme names
+const (
+	ThemeAuto         = "auto"
+	ThemeDark         = "dark"
+	ThemeLight        = "light"
+	ThemeHighContrast = "high-contrast"
+	ThemeNoColor      = "no-color"
+)
+
+// DarkTheme is the original palette, tuned for dark terminals
+func DarkTheme() Theme {
+	return Theme{
+		Name:               ThemeDark,
+		Primary:            lipgloss.Color("#0066cc"),
+		Success:            lipgloss.Color("#22c55e"),
+		Warning:            lipgloss.Color("#eab308"),
+		Error:              lipgloss.Color("#ef4444"),
+		Neutral:            lipgloss.Color("#6b7280"),
+		TextPrimary:        lipgloss.Color("#FAFAFA"),
+		TextSecondary:      lipgloss.Color("#626262"),
+		TextMuted:          lipgloss.Color("#404040"),
+		TextOnAccent:       lipgloss.Color("#FAFAFA"),
+		BackgroundInput:    lipgloss.Color("#333333"),
+		BackgroundSelected: lipgloss.Color("#0066cc"),
+		BackgroundAlt:      lipgloss.Color("#1a1a1a"),
+		CategoryPalette: []lipgloss.TerminalColor{
+			lipgloss.Color("#ef4444"),
+			lipgloss.Color("#f97316"),
+			lipgloss.Color("#eab308"),
+			lipgloss.Color("#22c55e"),
+			lipgloss.Color("#14b8a6"),
+			lipgloss.Color("#3b82f6"),
+			lipgloss.Color("#8b5cf6"),
+			lipgloss.Color("#ec4899"),
+		},
+	}
+}
+
+// LightTheme uses dark text and deeper accents for light terminals
+func LightTheme() Theme {
+	return Theme{
+		Name:               ThemeLight,
+		Primary:            lipgloss.Color("#0052a3"),
+		Success:            lipgloss.Color("#15803d"),
+		Warning:            lipgloss.Color("#a16207"),
+		Error:              lipgloss.Color("#b91c1c"),
+		Neutral:            lipgloss.Color("#6b7280"),
+		TextPrimary:        lipgloss.Color("#1f2937"),
+		TextSecondary:      lipgloss.Color("#4b5563"),
+		TextMuted:          lipgloss.Color("#9ca3af"),
+		TextOnAccent:       lipgloss.Color("#ffffff"),
+		BackgroundInput:    lipgloss.Color("#e5e7eb"),
+		BackgroundSelected: lipgloss.Color("#0052a3"),
+		BackgroundAlt:      lipgloss.Color("#f3f4f6"),
+		CategoryPalette: []lipgloss.TerminalColor{
+			lipgloss.Color("#dc2626"),
+			lipgloss.Color("#ea580c"),
+			lipgloss.Color("#ca8a04"),
+			lipgloss.Color("#16a34a"),
+			lipgloss.Color("#0d9488"),
+			lipgloss.Color("#2563eb"),
+			lipgloss.Color("#7c3aed"),
+			lipgloss.Color("#db2777"),
+		},
+	}
+}
+
+// HighContrastTheme uses the basic ANSI colors so the terminal's own palette decides contrast
+func HighContrastTheme() Theme {
+	return Theme{
+		Name:               ThemeHighContrast,
+		Primary:            lipgloss.Color("12"),
+		Success:            lipgloss.Color("10"),
+		Warning:            lipgloss.Color("11"),
+		Error:              lipgloss.Color("9"),
+		Neutral:            lipgloss.Color("7"),
+		TextPrimary:        lipgloss.Color("15"),
+		TextSecondary:      lipgloss.Color("7"),
+		TextMuted:          lipgloss.Color("8"),
+		TextOnAccent:       lipgloss.Color("0"),
+		BackgroundInput:    lipgloss.Color("8"),
+		BackgroundSelected: lipgloss.Color("11"),
+		BackgroundAlt:      lipgloss.Color("0"),
+		CategoryPalette: []lipgloss.TerminalColor{
+			lipgloss.Color("9"),
+			lipgloss.Color("10"),
+			lipgloss.Color("11"),
+			lipgloss.Color("12"),
+			lipgloss.Color("13"),
+			lipgloss.Color("14"),
+		},
+	}
+}
+
+// NoColorTheme draws no colors at all, as requested by the NO_COLOR convention
+func NoColorTheme() Theme {
+	none := lipgloss.NoColor{}
+	return Theme{
+		Name:               ThemeNoColor,
+		Primary:            none,
+		Success:            none,
+		Warning:            none,
+		Error:              none,
+		Neutral:            none,
+		TextPrimary:        none,
+		TextSecondary:      none,
+		TextMuted:          none,
+		TextOnAccent:       none,
+		BackgroundInput:    none,
+		BackgroundSelected: none,
+		BackgroundAlt:      none,
+		CategoryPalette:    []lipgloss.TerminalColor{none},
+		Monochrome:         true,
+	}
+}
+
+// BuiltinTheme returns the built-in theme with the given name
+func BuiltinTheme(name string) (Theme, bool) {
+	switch name {
+	case ThemeDark:
+		return DarkTheme(), true
+	case ThemeLight:
+		return LightTheme(), true
+	case ThemeHighContrast:
+		return HighContrastTheme(), true
+	case ThemeNoColor:
+		return NoColorTheme(), true
+	}
+	return Theme{}, false
+}
+
+// DetectTheme picks a theme for the terminal: no colors when NO_COLOR is set,
+// otherwise dark or light depending on the terminal background
+func DetectTheme() Theme {
+	if os.Getenv("NO_COLOR") != "" {
+		return NoColorTheme()
+	}
+	if lipgloss.HasDarkBackground() {
+		return DarkTheme()
+	}
+	return LightTheme()
+}
+
+// hexColorPattern matches "#rgb" and "#rrggbb"
+var hexColorPattern = regexp.MustCompile(`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+
+// parseColor accepts a hex color, an ANSI color number (0-255) or "none"
+func parseColor(value string) (lipgloss.TerminalColor, error) {
+	value = strings.TrimSpace(value)
+	if strings.EqualFold(value, "none") {
+		return lipgloss.NoColor{}, nil
+	}
+	if hexColorPattern.MatchString(value) {
+		return lipgloss.Color(value), nil
+	}
+	if n, err := strconv.Atoi(value); err == nil && n >= 0 && n <= 255 {
+		return lipgloss.Color(value), nil
+	}
+	return nil, fmt.Errorf("invalid color %q (use #rrggbb, an ANSI number 0-255 or none)", value)
+}
+
+// CustomTheme derives a theme from base, replacing the named colors and, when given, the category palette
+func CustomTheme(name string, base Theme, colors map[string]string, palette []string) (Theme, error) {
+	theme := base
+	theme.Name = name
+	fields := map[string]*lipgloss.TerminalColor{
+		"primary":             &theme.Primary,
+		"success":             &theme.Success,
+		"warning":             &theme.Warning,
+		"error":               &theme.Error,
+		"neutral":             &theme.Neutral,
+		"text_primary":        &theme.TextPrimary,
+		"text_secondary":      &theme.TextSecondary,
+		"text_muted":          &theme.TextMuted,
+		"text_on_accent":      &theme.TextOnAccent,
+		"background_input":    &theme.BackgroundInput,
+		"background_selected": &theme.BackgroundSelected,
+		"background_alt":      &theme.BackgroundAlt,
+	}
+
+	names := make([]string, 0, len(colors))
+	for colorName := range colors {
+		names = append(names, colorName)
+	}
+	sort.Strings(names)
+
+	for _, colorName := range names {
+		field, ok := fields[colorName]
+		if !ok {
+			return Theme{}, fmt.Errorf("unknown theme color %q", colorName)
+		}
+		color, err := parseColor(colors[colorName])
+		if err != nil {
+			return Theme{}, fmt.Errorf("theme color %q: %w", colorName, err)
+		}
+		*field = color
+	}
+
+	if len(palette) > 0 {
+		theme.CategoryPalette = make([]lipgloss.TerminalColor, len(palette))
+		for i, value := range palette {
+			color, err := parseColor(value)
+			if err != nil {
+				return Theme{}, fmt.Errorf("category palette: %w", err)
+			}
+			theme.CategoryPalette[i] = color
+		}
+	}
+
+	return theme, nil
+}
+
+// UseTheme rebuilds every style from theme
+func UseTheme(theme Theme) {
+	applyTheme(theme)
+}
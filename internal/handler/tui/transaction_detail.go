@@ -0,0 +1,188 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"expense-tracker/internal/core/domain"
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+type transactionHistoryMsg struct {
+	id      int
+	entries []*domain.AuditEntry
+	err     error
+}
+
+// openDetail shows the highlighted transaction with the changes recorded for it
+func (m *TransactionsModel) openDetail() tea.Cmd {
+	if m.cursor >= len(m.transactions) {
+		return nil
+	}
+
+	m.detail = m.transactions[m.cursor]
+	m.history = nil
+	m.historyLoading = true
+	m.historyErr = nil
+	m.historyOffset = 0
+	id := m.detail.ID
+	return func() tea.Msg {
+		entries, err := m.auditUseCase.TransactionHistory(context.Background(), id)
+		return transactionHistoryMsg{id: id, entries: entries, err: err}
+	}
+}
+
+// updateDetail handles the history arriving and the keys of the detail view
+func (m *TransactionsModel) updateDetail(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case transactionHistoryMsg:
+		// The user may have closed the view or opened another transaction meanwhile
+		if m.detail == nil || m.detail.ID != msg.id {
+			return m, nil
+		}
+		m.history = msg.entries
+		m.historyLoading = false
+		m.historyErr = msg.err
+
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, keys.Global.Back):
+			m.detail = nil
+		case key.Matches(msg, keys.List.Up):
+			if m.historyOffset > 0 {
+				m.historyOffset--
+			}
+		case key.Matches(msg, keys.List.Down):
+			if m.historyOffset < len(m.historyLines())-1 {
+				m.historyOffset++
+			}
+		}
+	}
+	return m, nil
+}
+
+// historyLines renders the recorded changes, newest first, one line per changed field
+func (m *TransactionsModel) historyLines() []string {
+	var lines []string
+	for _, entry := range m.history {
+		when := locale.FormatDate(entry.At) + " " + entry.At.Format("15:04")
+		header := helpKeyStyle.Render(when) + helpDescStyle.Render(" · "+auditActor(entry)+" · ")
+		switch entry.Action {
+		case domain.AuditCreate:
+			lines = append(lines, header+successStyle.Render("created"))
+			continue
+		case domain.AuditDelete:
+			lines = append(lines, header+errorStyle.Render("deleted"))
+			continue
+		}
+
+		lines = append(lines, header+"changed "+entry.Summary())
+		changes, err := entry.Changes()
+		if err != nil {
+			lines = append(lines, "   "+errorStyle.Render(err.Error()))
+			continue
+		}
+		for _, change := range changes {
+			lines = append(lines, "   "+helpDescStyle.Render(change.Field+": ")+
+				auditChangeValue(change.Before)+helpDescStyle.Render(" → ")+auditChangeValue(change.After))
+		}
+	}
+	return lines
+}
+
+// createDetailView renders the transaction, its history and the footer
+func (m *TransactionsModel) createDetailView(config CenterConfig) string {
+	transaction := m.detail
+	var b strings.Builder
+
+	b.WriteString(summaryHeaderStyle.Render("🧾 "+transaction.Description) + "\n\n")
+	field := func(label, value string) {
+		b.WriteString(formFieldLabelStyle.Render(fmt.Sprintf("%-12s", label)) + " " + value + "\n")
+	}
+	field("Date", locale.FormatDate(transaction.Date))
+	field("Amount", m.formatAmountColored(transaction.Amount, transaction.Type))
+	category := transaction.CategoryName()
+	if transaction.Transfer {
+		category += " (transfer)"
+	}
+	field("Category", category)
+	for _, split := range transaction.Splits {
+		line := "  " + split.Category.Name + " " + locale.FormatAmount(split.Amount)
+		if split.Memo != "" {
+			line += " · " + split.Memo
+		}
+		b.WriteString(helpDescStyle.Render(fmt.Sprintf("%-12s", "")) + " " + line + "\n")
+	}
+	if transaction.Payee != nil {
+		field("Payee", transaction.Payee.Name)
+	}
+	if len(transaction.Tags) > 0 {
+		field("Tags", strings.Join(transaction.Tags, ", "))
+	}
+	switch transaction.Status {
+	case domain.StatusCleared:
+		field("Status", "cleared")
+	case domain.StatusReconciled:
+		field("Status", "reconciled, locked")
+	}
+
+	b.WriteString("\n" + summaryHeaderStyle.Render("🕘 History") + "\n")
+	lines := m.historyLines()
+	switch {
+	case m.historyErr != nil:
+		b.WriteString(errorStyle.Render("❌ " + m.historyErr.Error()))
+	case m.historyLoading:
+		b.WriteString(loadingStyle.Render("Loading history..."))
+	case len(lines) == 0:
+		b.WriteString(helpStyle.Render("No changes recorded. Changes made before the audit log existed are not known."))
+	default:
+		visibleRows := max(config.Height-22-strings.Count(b.String(), "\n"), 3)
+		start := min(m.historyOffset, max(len(lines)-visibleRows, 0))
+		b.WriteString(strings.Join(lines[start:min(start+visibleRows, len(lines))], "\n"))
+		if len(lines) > visibleRows {
+			b.WriteString("\n" + infoStyle.Render(fmt.Sprintf("lines %d-%d of %d", start+1, min(start+visibleRows, len(lines)), len(lines))))
+		}
+	}
+
+	panel := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(colorNeutral).
+		Width(config.CalculateContentWidth()-4).
+		Height(max(config.Height-10, 6)).
+		Padding(1, 2).
+		Align(lipgloss.Left).
+		Render(b.String())
+
+	scrollable := len(lines) > 1
+	footer := renderFooterHelp([]key.Binding{
+		enabledIf(withHelp(keys.List.Up, "Scroll up"), scrollable),
+		enabledIf(withHelp(keys.List.Down, "Scroll down"), scrollable),
+	}, withHelp(keys.Global.Back, "Close"), config.CalculateContentWidth())
+
+	return lipgloss.JoinVertical(lipgloss.Center,
+		titleStyle.Render("📋 Transaction Details"),
+		"",
+		panel,
+		"",
+		footer,
+	)
+}
+
+// auditActor names who made a change, if the user name could be determined
+func auditActor(entry *domain.AuditEntry) string {
+	if entry.Actor == "" {
+		return "unknown"
+	}
+	return entry.Actor
+}
+
+// auditChangeValue shows an empty value as a dash so removals stay visible
+func auditChangeValue(value string) string {
+	if value == "" {
+		return helpDescStyle.Render("—")
+	}
+	return value
+}
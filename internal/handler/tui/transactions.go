@@ -7,44 +7,72 @@ import (
 
 	"expense-tracker/internal/core/domain"
 	"expense-tracker/internal/core/usecase"
+	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
 
 type transactionsMsg struct {
-	transactions []*domain.Transaction
-	err          error
+	page    *domain.TransactionPage
+	request *domain.PageRequest
+	number  int // the page's number, counted from the first
+	err     error
 }
 
 type TransactionsModel struct {
-	summaryUseCase *usecase.SummaryUseCase
-	transactions   []*domain.Transaction
-	searchInput    textinput.Model
-	isSearching    bool
-	loading        bool
-	err            error
-	currentPage    int
-	itemsPerPage   int
-	width          int
-	height         int
+	transactionUseCase *usecase.TransactionUseCase
+	summaryUseCase     *usecase.SummaryUseCase
+	auditUseCase       *usecase.AuditUseCase
+	transactions       []*domain.Transaction
+	searchInput        textinput.Model
+	isSearching        bool
+	loading            bool
+	err                error
+	currentPage        int
+	itemsPerPage       int
+	totalCount         int
+	pageRequest        *domain.PageRequest
+	cursor             int
+	selected           map[int]*domain.Transaction
+	bulkMode           bulkMode
+	unlockNext         bulkMode // the dialog to open once reconciled targets are unlocked
+	bulkInput          textinput.Model
+	bulkInputKind      bulkInputKind
+	bulkMenuIndex      int
+	bulkCategories     []*domain.Category
+	bulkCategoryIndex  int
+	detail             *domain.Transaction // shown with its history in place of the list
+	history            []*domain.AuditEntry
+	historyLoading     bool
+	historyErr         error
+	historyOffset      int
+	statusMsg          string
+	width              int
+	height             int
 }
 
-func NewTransactionsModel(summaryUseCase *usecase.SummaryUseCase) *TransactionsModel {
+func NewTransactionsModel(transactionUseCase *usecase.TransactionUseCase, summaryUseCase *usecase.SummaryUseCase, auditUseCase *usecase.AuditUseCase) *TransactionsModel {
 	searchInput := textinput.New()
-	searchInput.Placeholder = "Search transactions..."
+	searchInput.Placeholder = "Search transactions... (payee:name)"
+
+	bulkInput := textinput.New()
+	bulkInput.CharLimit = 100
 
 	return &TransactionsModel{
-		summaryUseCase: summaryUseCase,
-		searchInput:    searchInput,
-		itemsPerPage:   20,
-		currentPage:    0,
+		transactionUseCase: transactionUseCase,
+		summaryUseCase:     summaryUseCase,
+		auditUseCase:       auditUseCase,
+		searchInput:        searchInput,
+		bulkInput:          bulkInput,
+		selected:           map[int]*domain.Transaction{},
+		itemsPerPage:       20,
+		currentPage:        0,
 	}
 }
 
 func (m *TransactionsModel) Init() tea.Cmd {
-	m.loading = true
-	return m.fetchTransactions()
+	return m.firstPage()
 }
 
 // SetDimensions updates the model's width and height for responsive layout
@@ -53,30 +81,64 @@ func (m *TransactionsModel) SetDimensions(width, height int) {
 	m.height = height
 }
 
-func (m *TransactionsModel) fetchTransactions() tea.Cmd {
+// fetchPage loads page number of the listing; the current page only changes once it arrives
+func (m *TransactionsModel) fetchPage(request *domain.PageRequest, number int) tea.Cmd {
+	m.loading = true
+	request.Query, request.Payee = domain.ParsePayeeFilter(m.searchInput.Value())
 	return tea.Cmd(func() tea.Msg {
 		ctx := context.Background()
+		page, err := m.summaryUseCase.GetTransactionPage(ctx, request)
+		return transactionsMsg{page: page, request: request, number: number, err: err}
+	})
+}
 
-		var transactions []*domain.Transaction
-		var err error
+// firstPage loads the page holding the newest transactions
+func (m *TransactionsModel) firstPage() tea.Cmd {
+	return m.fetchPage(&domain.PageRequest{Limit: m.itemsPerPage}, 0)
+}
 
-		if m.searchInput.Value() != "" {
-			transactions, err = m.summaryUseCase.SearchTransactions(
-				ctx,
-				m.searchInput.Value(),
-				m.currentPage*m.itemsPerPage,
-				m.itemsPerPage,
-			)
-		} else {
-			transactions, err = m.summaryUseCase.GetAllTransactions(
-				ctx,
-				m.currentPage*m.itemsPerPage,
-				m.itemsPerPage,
-			)
-		}
+// lastPage loads the page holding the oldest transactions, sized so it lines up
+// with the pages counted from the start
+func (m *TransactionsModel) lastPage() tea.Cmd {
+	return m.fetchPage(&domain.PageRequest{
+		FromEnd: true,
+		Limit:   domain.LastPageSize(m.totalCount, m.itemsPerPage),
+	}, m.totalPages()-1)
+}
 
-		return transactionsMsg{transactions: transactions, err: err}
-	})
+func (m *TransactionsModel) nextPage() tea.Cmd {
+	last := m.transactions[len(m.transactions)-1]
+	return m.fetchPage(&domain.PageRequest{After: last.Cursor(), Limit: m.itemsPerPage}, m.currentPage+1)
+}
+
+func (m *TransactionsModel) previousPage() tea.Cmd {
+	if m.currentPage == 1 {
+		return m.firstPage()
+	}
+	first := m.transactions[0]
+	return m.fetchPage(&domain.PageRequest{Before: first.Cursor(), Limit: m.itemsPerPage}, m.currentPage-1)
+}
+
+// reloadPage fetches the current page again, e.g. after a bulk action changed it.
+// Keyset cursors stay valid when rows around them are deleted.
+func (m *TransactionsModel) reloadPage() tea.Cmd {
+	if m.pageRequest == nil {
+		return m.firstPage()
+	}
+	request := *m.pageRequest
+	return m.fetchPage(&request, m.currentPage)
+}
+
+func (m *TransactionsModel) totalPages() int {
+	return domain.PageCount(m.totalCount, m.itemsPerPage)
+}
+
+func (m *TransactionsModel) hasNextPage() bool {
+	return len(m.transactions) > 0 && m.currentPage+1 < m.totalPages()
+}
+
+func (m *TransactionsModel) hasPreviousPage() bool {
+	return len(m.transactions) > 0 && m.currentPage > 0
 }
 
 func (m *TransactionsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
@@ -86,59 +148,128 @@ func (m *TransactionsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if msg.err != nil {
 			m.err = msg.err
 		} else {
-			m.transactions = msg.transactions
+			m.transactions = msg.page.Transactions
+			m.totalCount = msg.page.TotalCount
+			m.pageRequest = msg.request
+			m.currentPage = msg.number
 			m.err = nil
+			// Selected rows on this page may have changed, e.g. their cleared status
+			for _, transaction := range m.transactions {
+				if _, ok := m.selected[transaction.ID]; ok {
+					m.selected[transaction.ID] = transaction
+				}
+			}
+			if len(m.transactions) == 0 && m.currentPage > 0 {
+				return m, m.firstPage()
+			}
+			if m.cursor >= len(m.transactions) {
+				m.cursor = max(len(m.transactions)-1, 0)
+			}
 		}
 		return m, nil
 
+	case bulkCategoriesMsg, bulkResultMsg, bulkUnlockMsg, clearedMsg:
+		return m.updateBulk(msg)
+
+	case transactionHistoryMsg:
+		return m.updateDetail(msg)
+
 	case tea.KeyMsg:
-		switch msg.String() {
-		case "ctrl+c", "q", "esc":
-			return m, nil
+		if m.bulkMode != bulkModeNone {
+			return m.handleBulkKey(msg)
+		}
+		if m.detail != nil {
+			return m.updateDetail(msg)
+		}
 
-		case "/":
-			if !m.isSearching {
-				m.isSearching = true
-				m.searchInput.Focus()
+		if m.isSearching {
+			switch {
+			case key.Matches(msg, keys.Input.Cancel):
+				m.isSearching = false
+				m.searchInput.Blur()
 				return m, nil
-			}
 
-		case "enter":
-			if m.isSearching {
+			case key.Matches(msg, keys.Input.Apply):
 				m.isSearching = false
 				m.searchInput.Blur()
-				m.currentPage = 0
-				m.loading = true
-				return m, m.fetchTransactions()
+				return m, m.firstPage()
 			}
 
-		case "c":
-			if !m.isSearching {
-				m.searchInput.SetValue("")
-				m.currentPage = 0
-				m.loading = true
-				return m, m.fetchTransactions()
+			var cmd tea.Cmd
+			m.searchInput, cmd = m.searchInput.Update(msg)
+			return m, cmd
+		}
+
+		switch {
+		case key.Matches(msg, keys.List.ClearSelection):
+			m.clearSelection()
+			return m, nil
+
+		case key.Matches(msg, keys.List.Up):
+			if m.cursor > 0 {
+				m.cursor--
 			}
+			return m, nil
 
-		case "n":
-			if !m.isSearching && len(m.transactions) == m.itemsPerPage {
-				m.currentPage++
-				m.loading = true
-				return m, m.fetchTransactions()
+		case key.Matches(msg, keys.List.Down):
+			if m.cursor < len(m.transactions)-1 {
+				m.cursor++
 			}
+			return m, nil
 
-		case "p":
-			if !m.isSearching && m.currentPage > 0 {
-				m.currentPage--
-				m.loading = true
-				return m, m.fetchTransactions()
+		case key.Matches(msg, keys.List.Toggle):
+			m.toggleSelection()
+			return m, nil
+
+		case key.Matches(msg, keys.List.SelectPage):
+			m.toggleSelectAllVisible()
+			return m, nil
+
+		case key.Matches(msg, keys.List.Delete):
+			m.openBulkMode(bulkModeConfirmDelete)
+			return m, nil
+
+		case key.Matches(msg, keys.List.BulkEdit):
+			m.openBulkMode(bulkModeMenu)
+			return m, nil
+
+		case key.Matches(msg, keys.List.Cleared):
+			if len(m.targetIDs()) > 0 {
+				return m, m.toggleCleared()
 			}
-		}
+			return m, nil
 
-		if m.isSearching {
-			var cmd tea.Cmd
-			m.searchInput, cmd = m.searchInput.Update(msg)
-			return m, cmd
+		case key.Matches(msg, keys.List.Details):
+			return m, m.openDetail()
+
+		case key.Matches(msg, keys.List.Search):
+			m.isSearching = true
+			m.searchInput.Focus()
+			return m, nil
+
+		case key.Matches(msg, keys.List.ClearSearch):
+			m.searchInput.SetValue("")
+			return m, m.firstPage()
+
+		case key.Matches(msg, keys.List.NextPage):
+			if m.hasNextPage() {
+				return m, m.nextPage()
+			}
+
+		case key.Matches(msg, keys.List.PrevPage):
+			if m.hasPreviousPage() {
+				return m, m.previousPage()
+			}
+
+		case key.Matches(msg, keys.List.FirstPage):
+			if m.hasPreviousPage() {
+				return m, m.firstPage()
+			}
+
+		case key.Matches(msg, keys.List.LastPage):
+			if m.hasNextPage() {
+				return m, m.lastPage()
+			}
 		}
 	}
 
@@ -161,6 +292,18 @@ func (m *TransactionsModel) View() string {
 
 	title := titleStyle.Render("📋 All Transactions")
 	
+	if m.detail != nil {
+		return lipgloss.Place(config.Width, config.Height,
+			lipgloss.Center, lipgloss.Center, m.createDetailView(config))
+	}
+
+	// Bulk action dialogs replace the list while they are open
+	if m.bulkMode != bulkModeNone {
+		popup := lipgloss.JoinVertical(lipgloss.Center, m.createBulkPopup(), "", helpPanel)
+		return lipgloss.Place(config.Width, config.Height,
+			lipgloss.Center, lipgloss.Center, popup)
+	}
+	
 	// Create layout without outer borders, help panel without border
 	fullContent := lipgloss.JoinVertical(
 		lipgloss.Center,
@@ -198,7 +341,7 @@ func (m *TransactionsModel) createSearchPanel() string {
 	} else {
 		searchValue := m.searchInput.Value()
 		if searchValue == "" {
-			searchValue = inputPlaceholderStyle.Render("Press '/' to search transactions...")
+			searchValue = inputPlaceholderStyle.Render("Press '" + keys.List.Search.Help().Key + "' to search transactions...")
 		}
 		searchField = searchBoxStyle.Render(searchValue)
 	}
@@ -218,8 +361,7 @@ func (m *TransactionsModel) createTransactionsPanel() string {
 	var b strings.Builder
 	
 	// Header with count
-	transactionCount := len(m.transactions)
-	header := summaryHeaderStyle.Render(fmt.Sprintf("📊 Transactions (%d)", transactionCount))
+	header := summaryHeaderStyle.Render(fmt.Sprintf("📊 Transactions (%d)", m.totalCount))
 	b.WriteString(header + "\n\n")
 	
 	if len(m.transactions) == 0 {
@@ -227,7 +369,7 @@ func (m *TransactionsModel) createTransactionsPanel() string {
 		if m.searchInput.Value() != "" {
 			emptyMessage = "No transactions found matching your search."
 		} else {
-			emptyMessage = "No transactions found. Press 'q' to go back and add some!"
+			emptyMessage = "No transactions found. Press '" + keys.Global.Back.Help().Key + "' to go back and add some!"
 		}
 		b.WriteString(helpStyle.Render(emptyMessage))
 		return b.String()
@@ -237,41 +379,63 @@ func (m *TransactionsModel) createTransactionsPanel() string {
 	b.WriteString(m.renderEnhancedTransactionTable())
 	
 	// Pagination info
-	if m.currentPage > 0 || len(m.transactions) == m.itemsPerPage {
-		b.WriteString("\n" + m.renderPaginationInfo())
+	b.WriteString("\n" + m.renderPaginationInfo())
+	if len(m.selected) > 0 {
+		b.WriteString(" " + warningStyle.Render(fmt.Sprintf("· %d selected", len(m.selected))))
+	}
+	if m.statusMsg != "" {
+		b.WriteString("\n" + successStyle.Render(m.statusMsg))
 	}
 	
 	return b.String()
 }
 
+// capturesKey reports whether the list wants a key that would otherwise take the user back to the dashboard
+func (m *TransactionsModel) capturesKey(msg tea.KeyMsg) bool {
+	if m.isSearching || m.bulkMode != bulkModeNone {
+		return true
+	}
+	if m.detail != nil {
+		return key.Matches(msg, keys.Global.Back)
+	}
+	return key.Matches(msg, keys.List.ClearSelection) && len(m.selected) > 0
+}
+
 // createTransactionsHelpPanel creates the bottom panel with keybindings
 func (m *TransactionsModel) createTransactionsHelpPanel() string {
-	var helpTexts []string
+	var bindings []key.Binding
 	
 	if m.isSearching {
-		helpTexts = []string{
-			helpKeyStyle.Render("Type") + " to search",
-			helpKeyStyle.Render("Enter") + " Apply",
-			helpKeyStyle.Render("Esc") + " Cancel",
-		}
+		bindings = []key.Binding{keys.Input.Apply, keys.Input.Cancel}
+	} else if m.bulkMode != bulkModeNone {
+		bindings = m.bulkHelpBindings()
 	} else {
-		helpTexts = []string{
-			helpKeyStyle.Render("/") + " Search",
-			helpKeyStyle.Render("c") + " Clear",
-		}
-		
-		if m.currentPage > 0 {
-			helpTexts = append(helpTexts, helpKeyStyle.Render("p") + " Previous")
-		}
-		if len(m.transactions) == m.itemsPerPage {
-			helpTexts = append(helpTexts, helpKeyStyle.Render("n") + " Next")
+		list := keys.List
+		bindings = []key.Binding{
+			list.Up,
+			list.Down,
+			list.Details,
+			list.Toggle,
+			list.SelectPage,
+			list.Delete,
+			list.BulkEdit,
+			list.Cleared,
+			list.Search,
+			list.ClearSearch,
+			enabledIf(list.ClearSelection, len(m.selected) > 0),
+			enabledIf(list.PrevPage, m.hasPreviousPage()),
+			enabledIf(list.FirstPage, m.hasPreviousPage()),
+			enabledIf(list.NextPage, m.hasNextPage()),
+			enabledIf(list.LastPage, m.hasNextPage()),
 		}
-		
-		helpTexts = append(helpTexts, helpKeyStyle.Render("q") + " Back")
 	}
 	
 	// No border styling - just return plain text
-	return strings.Join(helpTexts, " • ")
+	width := NewCenterConfig(m.width, m.height).CalculateContentWidth()
+	if m.isSearching || m.bulkMode != bulkModeNone {
+		return renderShortHelp(bindings, width)
+	}
+	return renderFooterHelp(bindings, keys.Global.Back, width)
 }
 
 // createPanelWithBorder creates a bordered panel that scales with terminal size
@@ -304,16 +468,18 @@ func (m *TransactionsModel) renderEnhancedTransactionTable() string {
 	
 	// Always use the same column layout, just scale widths proportionally
 	columns := []TableColumn{
+		{Header: " ", Width: 1, Alignment: lipgloss.Left},
 		{Header: "Date", Width: 12, Alignment: lipgloss.Left},
 		{Header: "Category", Width: panelWidth * 25 / 100, Alignment: lipgloss.Left},
-		{Header: "Description", Width: panelWidth * 50 / 100, Alignment: lipgloss.Left},  
+		{Header: "Description", Width: panelWidth*50/100 - 2, Alignment: lipgloss.Left},  
 		{Header: "Amount", Width: panelWidth * 25 / 100, Alignment: lipgloss.Right},
+		{Header: "R", Width: 1, Alignment: lipgloss.Left},
 	}
 	
 	// Ensure minimum widths
-	if columns[1].Width < 12 { columns[1].Width = 12 }
-	if columns[2].Width < 15 { columns[2].Width = 15 }
-	if columns[3].Width < 10 { columns[3].Width = 10 }
+	if columns[2].Width < 12 { columns[2].Width = 12 }
+	if columns[3].Width < 15 { columns[3].Width = 15 }
+	if columns[4].Width < 10 { columns[4].Width = 10 }
 	
 	var b strings.Builder
 	
@@ -335,8 +501,10 @@ func (m *TransactionsModel) renderEnhancedTransactionTable() string {
 		values := m.getTransactionRowValues(transaction, columns)
 		row := FormatTableRow(columns, values)
 		
-		// Apply alternating row styles for better readability
-		if i%2 == 0 {
+		// Highlight the cursor row, otherwise alternate row styles for better readability
+		if i == m.cursor {
+			b.WriteString(tableRowSelectedStyle.Render(row))
+		} else if i%2 == 0 {
 			b.WriteString(tableRowStyle.Render(row))
 		} else {
 			b.WriteString(tableRowAltStyle.Render(row))
@@ -349,9 +517,9 @@ func (m *TransactionsModel) renderEnhancedTransactionTable() string {
 
 // getTransactionRowValues formats transaction data for table display
 func (m *TransactionsModel) getTransactionRowValues(transaction *domain.Transaction, columns []TableColumn) []string {
-	categoryName := "Uncategorized"
-	if transaction.Category != nil {
-		categoryName = transaction.Category.Name
+	categoryName := transaction.CategoryName()
+	if transaction.Transfer {
+		categoryName = "⇄ " + categoryName
 	}
 	
 	// Format values based on available columns
@@ -359,19 +527,35 @@ func (m *TransactionsModel) getTransactionRowValues(transaction *domain.Transact
 	
 	for i, col := range columns {
 		switch col.Header {
+		case " ":
+			if _, ok := m.selected[transaction.ID]; ok {
+				values[i] = "*"
+			}
 		case "Date":
 			if col.Width <= 8 {
-				values[i] = transaction.Date.Format("Jan 02")
+				values[i] = locale.FormatShortDate(transaction.Date)
 			} else {
-				values[i] = transaction.Date.Format("Jan 02, 2006")
+				values[i] = locale.FormatDate(transaction.Date)
 			}
 		case "Category":
 			values[i] = TruncateWithEllipsis(categoryName, col.Width)
 		case "Description":
-			values[i] = TruncateWithEllipsis(transaction.Description, col.Width)
+			description := transaction.Description
+			for _, tag := range transaction.Tags {
+				description += " #" + tag
+			}
+			values[i] = TruncateWithEllipsis(description, col.Width)
 		case "Amount":
 			// Color-coded amounts without separate Type column
 			values[i] = m.formatAmountColored(transaction.Amount, transaction.Type)
+		case "R":
+			// Marked like a check register: c for cleared, R for reconciled
+			switch transaction.Status {
+			case domain.StatusCleared:
+				values[i] = "c"
+			case domain.StatusReconciled:
+				values[i] = "R"
+			}
 		}
 	}
 	
@@ -389,17 +573,18 @@ func (m *TransactionsModel) formatTransactionTypeColored(transactionType string)
 // formatAmountColored returns a colored amount based on transaction type
 func (m *TransactionsModel) formatAmountColored(amount float64, transactionType string) string {
 	if transactionType == "income" {
-		return incomeStyle.Render(fmt.Sprintf("+$%.2f", amount))
+		return incomeStyle.Render(locale.FormatSignedAmount(amount))
 	}
-	return expenseStyle.Render(fmt.Sprintf("-$%.2f", amount))
+	return expenseStyle.Render(locale.FormatAmount(-amount))
 }
 
 // renderPaginationInfo creates pagination information display
 func (m *TransactionsModel) renderPaginationInfo() string {
-	pageInfo := fmt.Sprintf("Page %d", m.currentPage+1)
-	if len(m.transactions) == m.itemsPerPage {
-		pageInfo += " (more available)"
+	results := "results"
+	if m.totalCount == 1 {
+		results = "result"
 	}
+	pageInfo := fmt.Sprintf("page %d of %d · %d %s", m.currentPage+1, m.totalPages(), m.totalCount, results)
 	return infoStyle.Render(pageInfo)
 }
 
@@ -0,0 +1,481 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"expense-tracker/internal/core/domain"
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type bulkMode int
+
+const (
+	bulkModeNone bulkMode = iota
+	bulkModeConfirmDelete
+	bulkModeMenu
+	bulkModeCategory
+	bulkModeInput
+	bulkModeConfirmUnlock
+)
+
+type bulkInputKind int
+
+const (
+	bulkInputTags bulkInputKind = iota
+	bulkInputShiftDate
+)
+
+// bulkMenuItems are the choices offered by the bulk edit menu, in display order
+var bulkMenuItems = []string{"Change category", "Replace tags", "Shift date"}
+
+type bulkCategoriesMsg struct {
+	categories []*domain.Category
+	err        error
+}
+
+type bulkResultMsg struct {
+	result *domain.BulkResult
+	err    error
+}
+
+type bulkUnlockMsg struct {
+	ids      []int
+	unlocked int
+	err      error
+}
+
+type clearedMsg struct {
+	changed int
+	cleared bool
+	err     error
+}
+
+// targetIDs returns the transactions a bulk action applies to: the selection if there
+// is one, otherwise the transaction under the cursor
+func (m *TransactionsModel) targetIDs() []int {
+	if len(m.selected) > 0 {
+		ids := make([]int, 0, len(m.selected))
+		for id := range m.selected {
+			ids = append(ids, id)
+		}
+		sort.Ints(ids)
+		return ids
+	}
+	if m.cursor < len(m.transactions) {
+		return []int{m.transactions[m.cursor].ID}
+	}
+	return nil
+}
+
+// targets returns the transactions behind targetIDs
+func (m *TransactionsModel) targets() []*domain.Transaction {
+	targets := make([]*domain.Transaction, 0, len(m.selected))
+	for _, transaction := range m.selected {
+		targets = append(targets, transaction)
+	}
+	if len(targets) == 0 && m.cursor < len(m.transactions) {
+		targets = append(targets, m.transactions[m.cursor])
+	}
+	return targets
+}
+
+// targetType returns the shared type of the targeted transactions, or "" when they are mixed
+func (m *TransactionsModel) targetType() string {
+	transactionType := ""
+	for _, transaction := range m.targets() {
+		if transactionType != "" && transaction.Type != transactionType {
+			return ""
+		}
+		transactionType = transaction.Type
+	}
+	return transactionType
+}
+
+func (m *TransactionsModel) toggleSelection() {
+	if m.cursor >= len(m.transactions) {
+		return
+	}
+	transaction := m.transactions[m.cursor]
+	if _, ok := m.selected[transaction.ID]; ok {
+		delete(m.selected, transaction.ID)
+	} else {
+		m.selected[transaction.ID] = transaction
+	}
+	if m.cursor < len(m.transactions)-1 {
+		m.cursor++
+	}
+}
+
+// toggleSelectAllVisible selects every transaction on the page, or deselects them if they already are
+func (m *TransactionsModel) toggleSelectAllVisible() {
+	allSelected := true
+	for _, transaction := range m.transactions {
+		if _, ok := m.selected[transaction.ID]; !ok {
+			allSelected = false
+			break
+		}
+	}
+
+	for _, transaction := range m.transactions {
+		if allSelected {
+			delete(m.selected, transaction.ID)
+		} else {
+			m.selected[transaction.ID] = transaction
+		}
+	}
+}
+
+func (m *TransactionsModel) clearSelection() {
+	m.selected = map[int]*domain.Transaction{}
+}
+
+// lockedTargets counts the reconciled transactions among the targets
+func (m *TransactionsModel) lockedTargets() int {
+	locked := 0
+	for _, transaction := range m.targets() {
+		if transaction.IsReconciled() {
+			locked++
+		}
+	}
+	return locked
+}
+
+// openBulkMode opens a bulk dialog, first asking to unlock reconciled targets since
+// changing them is never done silently
+func (m *TransactionsModel) openBulkMode(mode bulkMode) {
+	if len(m.targetIDs()) == 0 {
+		return
+	}
+	m.bulkMenuIndex = 0
+	if m.lockedTargets() > 0 {
+		m.bulkMode = bulkModeConfirmUnlock
+		m.unlockNext = mode
+		return
+	}
+	m.bulkMode = mode
+}
+
+// toggleCleared marks the targets as cleared, or uncleared when all of them already are.
+// Reconciled transactions stay as they are.
+func (m *TransactionsModel) toggleCleared() tea.Cmd {
+	var ids []int
+	cleared := false
+	for _, transaction := range m.targets() {
+		if transaction.IsReconciled() {
+			continue
+		}
+		ids = append(ids, transaction.ID)
+		if !transaction.IsCleared() {
+			cleared = true
+		}
+	}
+	if len(ids) == 0 {
+		m.err = fmt.Errorf("reconciled transactions are locked")
+		return nil
+	}
+
+	m.err = nil
+	return func() tea.Msg {
+		changed, err := m.transactionUseCase.SetCleared(context.Background(), ids, cleared)
+		return clearedMsg{changed: changed, cleared: cleared, err: err}
+	}
+}
+
+// unlockTargets sets the reconciled targets back to cleared so the pending bulk dialog can open
+func (m *TransactionsModel) unlockTargets() tea.Cmd {
+	ids := m.targetIDs()
+	return func() tea.Msg {
+		unlocked, err := m.transactionUseCase.UnlockTransactions(context.Background(), ids)
+		return bulkUnlockMsg{ids: ids, unlocked: unlocked, err: err}
+	}
+}
+
+func (m *TransactionsModel) handleBulkKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch m.bulkMode {
+	case bulkModeConfirmUnlock:
+		switch {
+		case key.Matches(msg, keys.Dialog.Yes):
+			m.bulkMode = bulkModeNone
+			return m, m.unlockTargets()
+		case key.Matches(msg, keys.Dialog.No):
+			m.bulkMode = bulkModeNone
+		}
+		return m, nil
+
+	case bulkModeConfirmDelete:
+		switch {
+		case key.Matches(msg, keys.Dialog.Yes):
+			m.bulkMode = bulkModeNone
+			return m, m.runBulk(func(ctx context.Context, ids []int) (*domain.BulkResult, error) {
+				return m.transactionUseCase.BulkDelete(ctx, ids)
+			})
+		case key.Matches(msg, keys.Dialog.No):
+			m.bulkMode = bulkModeNone
+		}
+		return m, nil
+
+	case bulkModeMenu:
+		switch {
+		case key.Matches(msg, keys.Dialog.Cancel):
+			m.bulkMode = bulkModeNone
+		case key.Matches(msg, keys.Dialog.Up):
+			if m.bulkMenuIndex > 0 {
+				m.bulkMenuIndex--
+			}
+		case key.Matches(msg, keys.Dialog.Down):
+			if m.bulkMenuIndex < len(bulkMenuItems)-1 {
+				m.bulkMenuIndex++
+			}
+		case key.Matches(msg, keys.Dialog.Select):
+			return m.openBulkMenuItem()
+		}
+		return m, nil
+
+	case bulkModeCategory:
+		switch {
+		case key.Matches(msg, keys.Dialog.Cancel):
+			m.bulkMode = bulkModeNone
+		case key.Matches(msg, keys.Dialog.Up):
+			if m.bulkCategoryIndex > 0 {
+				m.bulkCategoryIndex--
+			}
+		case key.Matches(msg, keys.Dialog.Down):
+			if m.bulkCategoryIndex < len(m.bulkCategories)-1 {
+				m.bulkCategoryIndex++
+			}
+		case key.Matches(msg, keys.Dialog.Select):
+			if len(m.bulkCategories) == 0 {
+				return m, nil
+			}
+			m.bulkMode = bulkModeNone
+			categoryID := m.bulkCategories[m.bulkCategoryIndex].ID
+			return m, m.runBulk(func(ctx context.Context, ids []int) (*domain.BulkResult, error) {
+				return m.transactionUseCase.BulkRecategorize(ctx, ids, categoryID)
+			})
+		}
+		return m, nil
+
+	case bulkModeInput:
+		switch {
+		case key.Matches(msg, keys.Input.Cancel):
+			m.bulkMode = bulkModeNone
+			m.bulkInput.Blur()
+			return m, nil
+		case key.Matches(msg, keys.Input.Apply):
+			return m.submitBulkInput()
+		}
+		var cmd tea.Cmd
+		m.bulkInput, cmd = m.bulkInput.Update(msg)
+		return m, cmd
+	}
+
+	return m, nil
+}
+
+func (m *TransactionsModel) openBulkMenuItem() (tea.Model, tea.Cmd) {
+	m.err = nil
+	switch m.bulkMenuIndex {
+	case 0:
+		transactionType := m.targetType()
+		if transactionType == "" {
+			m.bulkMode = bulkModeNone
+			m.err = fmt.Errorf("cannot re-categorize income and expenses together")
+			return m, nil
+		}
+		m.bulkMode = bulkModeCategory
+		m.bulkCategories = nil
+		m.bulkCategoryIndex = 0
+		return m, func() tea.Msg {
+			categories, err := m.transactionUseCase.GetCategories(context.Background(), transactionType)
+			return bulkCategoriesMsg{categories: categories, err: err}
+		}
+	case 1:
+		m.openBulkInput(bulkInputTags, "groceries, shared (empty removes all tags)")
+	case 2:
+		m.openBulkInput(bulkInputShiftDate, "days, e.g. -3 or +7")
+	}
+	return m, nil
+}
+
+func (m *TransactionsModel) openBulkInput(kind bulkInputKind, placeholder string) {
+	m.bulkMode = bulkModeInput
+	m.bulkInputKind = kind
+	m.bulkInput.SetValue("")
+	m.bulkInput.Placeholder = placeholder
+	m.bulkInput.Focus()
+}
+
+func (m *TransactionsModel) submitBulkInput() (tea.Model, tea.Cmd) {
+	value := strings.TrimSpace(m.bulkInput.Value())
+
+	switch m.bulkInputKind {
+	case bulkInputTags:
+		tags := domain.ParseTags(value)
+		m.bulkMode = bulkModeNone
+		m.bulkInput.Blur()
+		return m, m.runBulk(func(ctx context.Context, ids []int) (*domain.BulkResult, error) {
+			return m.transactionUseCase.BulkRetag(ctx, ids, tags)
+		})
+
+	case bulkInputShiftDate:
+		days, err := strconv.Atoi(strings.TrimPrefix(value, "+"))
+		if err != nil || days == 0 {
+			m.err = fmt.Errorf("enter a non-zero number of days, e.g. -3 or +7")
+			return m, nil
+		}
+		m.bulkMode = bulkModeNone
+		m.bulkInput.Blur()
+		return m, m.runBulk(func(ctx context.Context, ids []int) (*domain.BulkResult, error) {
+			return m.transactionUseCase.BulkShiftDate(ctx, ids, days)
+		})
+	}
+
+	return m, nil
+}
+
+// runBulk executes a bulk action against the current targets
+func (m *TransactionsModel) runBulk(action func(ctx context.Context, ids []int) (*domain.BulkResult, error)) tea.Cmd {
+	ids := m.targetIDs()
+	m.err = nil
+	m.loading = true
+	return func() tea.Msg {
+		result, err := action(context.Background(), ids)
+		return bulkResultMsg{result: result, err: err}
+	}
+}
+
+func (m *TransactionsModel) updateBulk(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case bulkCategoriesMsg:
+		if msg.err != nil {
+			m.bulkMode = bulkModeNone
+			m.err = msg.err
+			return m, nil
+		}
+		m.bulkCategories = msg.categories
+		return m, nil
+
+	case bulkResultMsg:
+		m.loading = false
+		if msg.err != nil {
+			m.err = msg.err
+			return m, nil
+		}
+		m.statusMsg = msg.result.Summary() + " · press " + keys.Global.Undo.Help().Key + " to undo"
+		m.clearSelection()
+		return m, m.reloadPage()
+
+	case bulkUnlockMsg:
+		if msg.err != nil {
+			m.err = msg.err
+			return m, nil
+		}
+		unlocked := map[int]bool{}
+		for _, id := range msg.ids {
+			unlocked[id] = true
+		}
+		for _, transaction := range m.transactions {
+			if unlocked[transaction.ID] && transaction.IsReconciled() {
+				transaction.Status = domain.StatusCleared
+			}
+		}
+		for _, transaction := range m.selected {
+			if transaction.IsReconciled() {
+				transaction.Status = domain.StatusCleared
+			}
+		}
+		m.statusMsg = fmt.Sprintf("Unlocked %d reconciled transaction(s)", msg.unlocked)
+		m.bulkMode = m.unlockNext
+		return m, nil
+
+	case clearedMsg:
+		if msg.err != nil {
+			m.err = msg.err
+			return m, nil
+		}
+		if msg.cleared {
+			m.statusMsg = fmt.Sprintf("Marked %d transaction(s) as cleared", msg.changed)
+		} else {
+			m.statusMsg = fmt.Sprintf("Marked %d transaction(s) as not cleared", msg.changed)
+		}
+		return m, m.reloadPage()
+	}
+
+	return m, nil
+}
+
+// createBulkPopup renders the dialog for the active bulk mode
+func (m *TransactionsModel) createBulkPopup() string {
+	var b strings.Builder
+	count := len(m.targetIDs())
+
+	switch m.bulkMode {
+	case bulkModeConfirmUnlock:
+		b.WriteString(modalHeaderStyle.Render("Unlock Reconciled") + "\n\n")
+		b.WriteString(fmt.Sprintf("%d of the %d transaction(s) are reconciled.\n", m.lockedTargets(), count))
+		b.WriteString(warningStyle.Render("Changing them unlocks them; they will need reconciling again.") + "\n\n")
+		b.WriteString(renderShortHelp([]key.Binding{withHelp(keys.Dialog.Yes, "Unlock"), withHelp(keys.Dialog.No, "Cancel")}, 0))
+
+	case bulkModeConfirmDelete:
+		b.WriteString(modalHeaderStyle.Render("Move to Trash") + "\n\n")
+		b.WriteString(fmt.Sprintf("Move %d transaction(s) to the trash?\n", count))
+		b.WriteString(helpDescStyle.Render("They can be restored from the trash ("+keys.Dashboard.Trash.Help().Key+" on the dashboard)") + "\n\n")
+		b.WriteString(renderShortHelp([]key.Binding{withHelp(keys.Dialog.Yes, "Move"), withHelp(keys.Dialog.No, "Cancel")}, 0))
+
+	case bulkModeMenu:
+		b.WriteString(modalHeaderStyle.Render(fmt.Sprintf("Bulk Edit (%d)", count)) + "\n\n")
+		for i, item := range bulkMenuItems {
+			if i == m.bulkMenuIndex {
+				b.WriteString(dropdownItemSelectedStyle.Render("▶ " + item))
+			} else {
+				b.WriteString(dropdownItemStyle.Render("  " + item))
+			}
+			b.WriteString("\n")
+		}
+
+	case bulkModeCategory:
+		b.WriteString(modalHeaderStyle.Render(fmt.Sprintf("Move %d to Category", count)) + "\n\n")
+		if len(m.bulkCategories) == 0 {
+			b.WriteString(loadingStyle.Render("Loading categories..."))
+		}
+		for i, category := range m.bulkCategories {
+			if i == m.bulkCategoryIndex {
+				b.WriteString(dropdownItemSelectedStyle.Render("▶ " + category.Name))
+			} else {
+				b.WriteString(dropdownItemStyle.Render("  " + category.Name))
+			}
+			b.WriteString("\n")
+		}
+
+	case bulkModeInput:
+		title := "Replace Tags"
+		if m.bulkInputKind == bulkInputShiftDate {
+			title = "Shift Date"
+		}
+		b.WriteString(modalHeaderStyle.Render(fmt.Sprintf("%s (%d)", title, count)) + "\n\n")
+		if m.err != nil {
+			b.WriteString(errorStyle.Render("❌ "+m.err.Error()) + "\n\n")
+		}
+		b.WriteString(inputFocusedStyle.Render(m.bulkInput.View()))
+	}
+
+	return modalStyle.Render(b.String())
+}
+
+func (m *TransactionsModel) bulkHelpBindings() []key.Binding {
+	switch m.bulkMode {
+	case bulkModeConfirmDelete:
+		return []key.Binding{keys.Dialog.Yes, keys.Dialog.No}
+	case bulkModeConfirmUnlock:
+		return []key.Binding{withHelp(keys.Dialog.Yes, "Unlock"), keys.Dialog.No}
+	case bulkModeInput:
+		return keys.Input.ShortHelp()
+	default:
+		return keys.Dialog.ShortHelp()
+	}
+}
@@ -0,0 +1,309 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"expense-tracker/internal/core/domain"
+	"expense-tracker/internal/core/usecase"
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+type trashMode int
+
+const (
+	trashModeNone trashMode = iota
+	trashModeConfirmPurge
+	trashModeConfirmEmpty
+)
+
+type trashMsg struct {
+	transactions []*domain.Transaction
+	err          error
+}
+
+type trashChangedMsg struct {
+	status string
+	err    error
+}
+
+// TrashModel lists the deleted transactions and lets the user restore them or delete them
+// for good before the retention period runs out
+type TrashModel struct {
+	trashUseCase *usecase.TrashUseCase
+	transactions []*domain.Transaction
+	cursor       int
+	mode         trashMode
+	loading      bool
+	err          error
+	statusMsg    string
+	width        int
+	height       int
+}
+
+func NewTrashModel(trashUseCase *usecase.TrashUseCase) *TrashModel {
+	return &TrashModel{trashUseCase: trashUseCase}
+}
+
+// Init loads the trash
+func (m *TrashModel) Init() tea.Cmd {
+	m.mode = trashModeNone
+	m.statusMsg = ""
+	return m.fetchTrash()
+}
+
+// SetDimensions updates the model's width and height for responsive layout
+func (m *TrashModel) SetDimensions(width, height int) {
+	m.width = width
+	m.height = height
+}
+
+func (m *TrashModel) fetchTrash() tea.Cmd {
+	m.loading = true
+	return func() tea.Msg {
+		transactions, err := m.trashUseCase.GetTrash(context.Background())
+		return trashMsg{transactions: transactions, err: err}
+	}
+}
+
+func (m *TrashModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case trashMsg:
+		m.loading = false
+		m.err = msg.err
+		if msg.err == nil {
+			m.transactions = msg.transactions
+			m.cursor = min(m.cursor, max(len(m.transactions)-1, 0))
+		}
+		return m, nil
+
+	case trashChangedMsg:
+		if msg.err != nil {
+			m.err = msg.err
+			return m, nil
+		}
+		m.statusMsg = msg.status
+		return m, m.fetchTrash()
+
+	case tea.KeyMsg:
+		if m.mode != trashModeNone {
+			return m, m.updateConfirm(msg)
+		}
+
+		m.err = nil
+		m.statusMsg = ""
+		transaction := m.selectedTransaction()
+		switch {
+		case key.Matches(msg, keys.Trash.Up):
+			if m.cursor > 0 {
+				m.cursor--
+			}
+		case key.Matches(msg, keys.Trash.Down):
+			if m.cursor < len(m.transactions)-1 {
+				m.cursor++
+			}
+		case key.Matches(msg, keys.Trash.Restore):
+			if transaction != nil {
+				return m, func() tea.Msg {
+					if _, err := m.trashUseCase.Restore(context.Background(), []int{transaction.ID}); err != nil {
+						return trashChangedMsg{err: err}
+					}
+					return trashChangedMsg{status: fmt.Sprintf("Restored %q", transaction.Description)}
+				}
+			}
+		case key.Matches(msg, keys.Trash.Purge):
+			if transaction != nil {
+				m.mode = trashModeConfirmPurge
+			}
+		case key.Matches(msg, keys.Trash.Empty):
+			if len(m.transactions) > 0 {
+				m.mode = trashModeConfirmEmpty
+			}
+		}
+	}
+	return m, nil
+}
+
+// updateConfirm handles the answer to deleting one or all transactions for good
+func (m *TrashModel) updateConfirm(msg tea.KeyMsg) tea.Cmd {
+	switch {
+	case key.Matches(msg, keys.Dialog.Yes):
+		ids := make([]int, 0, len(m.transactions))
+		if m.mode == trashModeConfirmPurge {
+			ids = append(ids, m.selectedTransaction().ID)
+		} else {
+			for _, transaction := range m.transactions {
+				ids = append(ids, transaction.ID)
+			}
+		}
+		m.mode = trashModeNone
+		return func() tea.Msg {
+			purged, err := m.trashUseCase.Purge(context.Background(), ids)
+			if err != nil {
+				return trashChangedMsg{err: err}
+			}
+			return trashChangedMsg{status: fmt.Sprintf("Deleted %d transaction(s) for good", purged)}
+		}
+	case key.Matches(msg, keys.Dialog.No):
+		m.mode = trashModeNone
+	}
+	return nil
+}
+
+// capturesKey reports whether the trash needs a key that would otherwise take the user back
+func (m *TrashModel) capturesKey(msg tea.KeyMsg) bool {
+	return m.mode != trashModeNone
+}
+
+func (m *TrashModel) selectedTransaction() *domain.Transaction {
+	if m.cursor < len(m.transactions) {
+		return m.transactions[m.cursor]
+	}
+	return nil
+}
+
+func (m *TrashModel) View() string {
+	config := NewCenterConfig(m.width, m.height)
+
+	if m.loading && m.transactions == nil {
+		content := loadingStyle.Render("Loading trash...")
+		return lipgloss.Place(config.Width, config.Height,
+			lipgloss.Center, lipgloss.Center, content)
+	}
+
+	helpPanel := m.createHelpPanel()
+
+	// The confirmation replaces the list while it is open
+	if m.mode != trashModeNone {
+		popup := lipgloss.JoinVertical(lipgloss.Center, m.createPopup(), "", helpPanel)
+		return lipgloss.Place(config.Width, config.Height,
+			lipgloss.Center, lipgloss.Center, popup)
+	}
+
+	panel := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(colorNeutral).
+		Width(config.CalculateContentWidth()-4).
+		Height(max(config.Height-10, 6)).
+		Padding(1, 2).
+		Align(lipgloss.Left).
+		Render(m.createTrashPanel())
+
+	fullContent := lipgloss.JoinVertical(
+		lipgloss.Center,
+		titleStyle.Render("🗑  Trash"),
+		"",
+		panel,
+		"",
+		helpPanel,
+	)
+
+	return lipgloss.Place(config.Width, config.Height,
+		lipgloss.Center, lipgloss.Center, fullContent)
+}
+
+// createTrashPanel renders the messages and the table of deleted transactions
+func (m *TrashModel) createTrashPanel() string {
+	var b strings.Builder
+
+	retention := m.trashUseCase.RetentionDays()
+	b.WriteString(summaryHeaderStyle.Render(fmt.Sprintf("🗑  Trash (%d)", len(m.transactions))) + "\n")
+	b.WriteString(helpStyle.Render(fmt.Sprintf("Deleted transactions are kept for %d days, then purged for good.", retention)) + "\n\n")
+
+	if m.err != nil {
+		b.WriteString(errorStyle.Render("❌ "+m.err.Error()) + "\n\n")
+	} else if m.statusMsg != "" {
+		b.WriteString(successStyle.Render("✅ "+m.statusMsg) + "\n\n")
+	}
+
+	if len(m.transactions) == 0 {
+		b.WriteString(helpStyle.Render("The trash is empty."))
+		return b.String()
+	}
+
+	panelWidth := NewCenterConfig(m.width, m.height).CalculateContentWidth() - 8
+	descriptionWidth := max(panelWidth-56, 15)
+	columns := []TableColumn{
+		{Header: "Deleted", Width: 12, Alignment: lipgloss.Left},
+		{Header: "Date", Width: 12, Alignment: lipgloss.Left},
+		{Header: "Description", Width: descriptionWidth, Alignment: lipgloss.Left},
+		{Header: "Amount", Width: 13, Alignment: lipgloss.Right},
+		{Header: "Purged on", Width: 12, Alignment: lipgloss.Left},
+	}
+
+	totalWidth := 0
+	for _, col := range columns {
+		totalWidth += col.Width + 1
+	}
+	b.WriteString(CreateTableHeader(columns) + "\n" + CreateTableSeparator(totalWidth-1) + "\n")
+
+	// Keep the cursor in view when there are more transactions than rows
+	visibleRows := max(NewCenterConfig(m.width, m.height).Height-24, 3)
+	start := min(max(m.cursor-visibleRows/2, 0), max(len(m.transactions)-visibleRows, 0))
+	for i := start; i < min(start+visibleRows, len(m.transactions)); i++ {
+		transaction := m.transactions[i]
+		row := FormatTableRow(columns, []string{
+			locale.FormatDate(*transaction.DeletedAt),
+			locale.FormatDate(transaction.Date),
+			TruncateWithEllipsis(transaction.Description, descriptionWidth),
+			locale.FormatSignedAmount(transaction.SignedAmount()),
+			locale.FormatDate(transaction.PurgeDate(retention)),
+		})
+		if i == m.cursor {
+			b.WriteString(tableRowSelectedStyle.Render(row))
+		} else if i%2 == 0 {
+			b.WriteString(tableRowStyle.Render(row))
+		} else {
+			b.WriteString(tableRowAltStyle.Render(row))
+		}
+		b.WriteString("\n")
+	}
+	if len(m.transactions) > visibleRows {
+		b.WriteString(infoStyle.Render(fmt.Sprintf("%d of %d", m.cursor+1, len(m.transactions))) + "\n")
+	}
+
+	return b.String()
+}
+
+// createPopup asks to confirm deleting the highlighted or every transaction for good
+func (m *TrashModel) createPopup() string {
+	var b strings.Builder
+
+	if m.mode == trashModeConfirmEmpty {
+		b.WriteString(modalHeaderStyle.Render("Empty Trash") + "\n\n")
+		b.WriteString(fmt.Sprintf("Delete all %d transaction(s) in the trash for good?", len(m.transactions)) + "\n")
+	} else {
+		transaction := m.selectedTransaction()
+		b.WriteString(modalHeaderStyle.Render("Delete for Good") + "\n\n")
+		b.WriteString(TruncateWithEllipsis(fmt.Sprintf("%s  %s  %s",
+			locale.FormatDate(transaction.Date),
+			transaction.Description,
+			locale.FormatSignedAmount(transaction.SignedAmount())), 60) + "\n")
+	}
+	b.WriteString(warningStyle.Render("This cannot be undone.") + "\n\n")
+	b.WriteString(renderShortHelp([]key.Binding{withHelp(keys.Dialog.Yes, "Delete"), withHelp(keys.Dialog.No, "Cancel")}, 0))
+
+	return modalStyle.Render(b.String())
+}
+
+// createHelpPanel renders the footer for the current mode
+func (m *TrashModel) createHelpPanel() string {
+	width := NewCenterConfig(m.width, m.height).CalculateContentWidth()
+
+	if m.mode != trashModeNone {
+		return renderShortHelp([]key.Binding{keys.Dialog.Yes, keys.Dialog.No}, width)
+	}
+
+	hasTransactions := len(m.transactions) > 0
+	trash := keys.Trash
+	return renderFooterHelp([]key.Binding{
+		enabledIf(trash.Up, hasTransactions),
+		enabledIf(trash.Down, hasTransactions),
+		enabledIf(trash.Restore, hasTransactions),
+		enabledIf(trash.Purge, hasTransactions),
+		enabledIf(trash.Empty, hasTransactions),
+	}, keys.Global.Back, width)
+}
@@ -0,0 +1,110 @@
+package tui
+
+import (
+	"context"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// statusBarHeight is the line below every view that shows what undo and redo would do
+const statusBarHeight = 1
+
+type historyMsg struct {
+	description string
+	redo        bool
+	err         error
+}
+
+// undoAvailable reports whether undo and redo work in the current view. Forms and the
+// reconciliation hold transactions of their own that an undo would leave outdated.
+func (m Model) undoAvailable() bool {
+	switch m.state {
+	case addExpenseView, addIncomeView, reconcileView:
+		return false
+	}
+	return true
+}
+
+// undo reverts the latest change made in this session
+func (m Model) undo() tea.Cmd {
+	return func() tea.Msg {
+		description, err := m.history.Undo(context.Background())
+		return historyMsg{description: description, err: err}
+	}
+}
+
+// redo makes the latest undone change again
+func (m Model) redo() tea.Cmd {
+	return func() tea.Msg {
+		description, err := m.history.Redo(context.Background())
+		return historyMsg{description: description, redo: true, err: err}
+	}
+}
+
+// updateHistory reports an undo or redo and reloads the view so it shows the result
+func (m Model) updateHistory(msg historyMsg) (tea.Model, tea.Cmd) {
+	m.historyErr = msg.err
+	m.historyStatus = ""
+	if msg.err != nil {
+		return m, nil
+	}
+
+	m.historyStatus = "Undid: " + msg.description
+	if msg.redo {
+		m.historyStatus = "Redid: " + msg.description
+	}
+	return m, m.refreshView()
+}
+
+// refreshView reloads the current view after its transactions changed underneath it
+func (m Model) refreshView() tea.Cmd {
+	switch m.state {
+	case dashboardView:
+		return m.dashboardModel.Refresh()
+	case listTransactionsView:
+		m.transactionsModel.clearSelection()
+		m.transactionsModel.statusMsg = ""
+		return m.transactionsModel.reloadPage()
+	case rulesView:
+		return m.rulesModel.Init()
+	case duplicatesView:
+		return m.duplicatesModel.Init()
+	case changesView:
+		return m.changesModel.Init()
+	case trashView:
+		return m.trashModel.Init()
+	}
+	return nil
+}
+
+// renderStatusBar shows the outcome of the last undo or redo and what the next ones would
+// do. It stays empty where undo and redo are not available.
+func (m Model) renderStatusBar() string {
+	if !m.undoAvailable() {
+		return ""
+	}
+
+	var parts []string
+	switch {
+	case m.historyErr != nil:
+		parts = append(parts, errorStyle.Render("❌ "+m.historyErr.Error()))
+	case m.historyStatus != "":
+		parts = append(parts, successStyle.Render("✅ "+m.historyStatus))
+	}
+
+	undo, redo := m.history.Pending()
+	if undo == "" && redo == "" {
+		parts = append(parts, helpStyle.Render("Nothing to undo"))
+	} else {
+		parts = append(parts, renderShortHelp([]key.Binding{
+			enabledIf(withHelp(keys.Global.Undo, "Undo: "+undo), undo != ""),
+			enabledIf(withHelp(keys.Global.Redo, "Redo: "+redo), redo != ""),
+		}, 0))
+	}
+
+	line := lipgloss.NewStyle().MaxWidth(m.width).Render(strings.Join(parts, helpDescStyle.Render("  ·  ")))
+	return lipgloss.PlaceHorizontal(m.width, lipgloss.Center, line)
+}
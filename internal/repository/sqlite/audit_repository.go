@@ -0,0 +1,120 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"expense-tracker/internal/core/domain"
+)
+
+type AuditRepository struct {
+	db *Database
+}
+
+func NewAuditRepository(db *Database) *AuditRepository {
+	return &AuditRepository{db: db}
+}
+
+// GetEntityHistory returns the audit entries of one entity, newest first
+func (r *AuditRepository) GetEntityHistory(ctx context.Context, entity string, id int) ([]*domain.AuditEntry, error) {
+	query := `
+		SELECT id, at, actor, entity, entity_id, action, before, after
+		FROM audit_log
+		WHERE entity = ? AND entity_id = ?
+		ORDER BY id DESC
+	`
+
+	rows, err := r.db.conn().QueryContext(ctx, query, entity, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get entity history: %w", err)
+	}
+	defer rows.Close()
+
+	return scanAuditEntries(rows)
+}
+
+// GetRecentChanges returns the latest audit entries across all entities, newest first
+func (r *AuditRepository) GetRecentChanges(ctx context.Context, limit int) ([]*domain.AuditEntry, error) {
+	query := `
+		SELECT id, at, actor, entity, entity_id, action, before, after
+		FROM audit_log
+		ORDER BY id DESC
+		LIMIT ?
+	`
+
+	rows, err := r.db.conn().QueryContext(ctx, query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get recent changes: %w", err)
+	}
+	defer rows.Close()
+
+	return scanAuditEntries(rows)
+}
+
+func scanAuditEntries(rows *sql.Rows) ([]*domain.AuditEntry, error) {
+	var entries []*domain.AuditEntry
+	for rows.Next() {
+		var entry domain.AuditEntry
+		var atStr string
+		var before, after sql.NullString
+		if err := rows.Scan(&entry.ID, &atStr, &entry.Actor, &entry.Entity, &entry.EntityID, &entry.Action, &before, &after); err != nil {
+			return nil, fmt.Errorf("failed to scan audit entry: %w", err)
+		}
+
+		at, err := parseTime(atStr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse audit time: %w", err)
+		}
+		entry.At = at
+		if before.Valid {
+			entry.Before = []byte(before.String)
+		}
+		if after.Valid {
+			entry.After = []byte(after.String)
+		}
+		entries = append(entries, &entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate audit rows: %w", err)
+	}
+
+	return entries, nil
+}
+
+// audit records the change of an entity from before to after, either nil when the entity
+// did not exist, inside the SQL transaction that made the change. Nothing is written when
+// the snapshots are equal.
+func (d *Database) audit(ctx context.Context, tx *sql.Tx, entity string, id int, before, after interface{}) error {
+	entry, err := domain.NewAuditEntry(entity, id, before, after)
+	if err != nil || entry == nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO audit_log (at, actor, entity, entity_id, action, before, after)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
+	`,
+		formatTime(time.Now()),
+		d.actor,
+		entry.Entity,
+		entry.EntityID,
+		entry.Action,
+		nullJSON(entry.Before),
+		nullJSON(entry.After),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to write audit log: %w", err)
+	}
+	return nil
+}
+
+// nullJSON stores a missing snapshot as NULL
+func nullJSON(snapshot []byte) interface{} {
+	if snapshot == nil {
+		return nil
+	}
+	return string(snapshot)
+}
@@ -2,6 +2,7 @@ package sqlite
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 
 	"expense-tracker/internal/core/domain"
@@ -17,23 +18,40 @@ func NewCategoryRepository(db *Database) *CategoryRepository {
 
 func (r *CategoryRepository) CreateCategory(ctx context.Context, category *domain.Category, categoryType string) error {
 	query := `INSERT INTO categories (name, type) VALUES (?, ?)`
-	result, err := r.db.DB().ExecContext(ctx, query, category.Name, categoryType)
-	if err != nil {
-		return fmt.Errorf("failed to create category: %w", err)
-	}
 
-	id, err := result.LastInsertId()
+	var id int64
+	err := r.db.withTx(ctx, func(tx *sql.Tx) error {
+		result, err := tx.ExecContext(ctx, query, category.Name, categoryType)
+		if err != nil {
+			return fmt.Errorf("failed to create category: %w", err)
+		}
+
+		id, err = result.LastInsertId()
+		if err != nil {
+			return fmt.Errorf("failed to get last insert id: %w", err)
+		}
+
+		created := categorySnapshot{ID: int(id), Name: category.Name, Type: categoryType}
+		return r.db.audit(ctx, tx, domain.AuditCategory, int(id), nil, created)
+	})
 	if err != nil {
-		return fmt.Errorf("failed to get last insert id: %w", err)
+		return err
 	}
 
 	category.ID = int(id)
 	return nil
 }
 
+// categorySnapshot is how the audit log records a category, which is stored with its type
+type categorySnapshot struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
 func (r *CategoryRepository) GetCategories(ctx context.Context, categoryType string) ([]*domain.Category, error) {
 	query := `SELECT id, name FROM categories WHERE type = ? ORDER BY name`
-	rows, err := r.db.DB().QueryContext(ctx, query, categoryType)
+	rows, err := r.db.conn().QueryContext(ctx, query, categoryType)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get categories: %w", err)
 	}
@@ -54,7 +72,7 @@ func (r *CategoryRepository) GetCategories(ctx context.Context, categoryType str
 func (r *CategoryRepository) GetCategoryByID(ctx context.Context, id int, categoryType string) (*domain.Category, error) {
 	query := `SELECT id, name FROM categories WHERE id = ? AND type = ?`
 	var category domain.Category
-	err := r.db.DB().QueryRowContext(ctx, query, id, categoryType).Scan(&category.ID, &category.Name)
+	err := r.db.conn().QueryRowContext(ctx, query, id, categoryType).Scan(&category.ID, &category.Name)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get category by id: %w", err)
 	}
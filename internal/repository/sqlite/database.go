@@ -1,8 +1,10 @@
 package sqlite
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
+	"strings"
 
 	_ "github.com/mattn/go-sqlite3"
 )
@@ -25,6 +27,27 @@ CREATE TABLE IF NOT EXISTS transactions (
     FOREIGN KEY(category_id) REFERENCES categories(id)
 );
 
+CREATE TABLE IF NOT EXISTS transaction_tags (
+    transaction_id INTEGER NOT NULL,
+    tag TEXT NOT NULL,
+    PRIMARY KEY(transaction_id, tag),
+    FOREIGN KEY(transaction_id) REFERENCES transactions(id)
+);
+
+CREATE TABLE IF NOT EXISTS transaction_splits (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    transaction_id INTEGER NOT NULL,
+    category_id INTEGER NOT NULL,
+    amount REAL NOT NULL,
+    memo TEXT NOT NULL DEFAULT '',
+    FOREIGN KEY(transaction_id) REFERENCES transactions(id),
+    FOREIGN KEY(category_id) REFERENCES categories(id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_transaction_splits_transaction ON transaction_splits(transaction_id);
+
+CREATE INDEX IF NOT EXISTS idx_transactions_description ON transactions(description, type);
+
 INSERT OR IGNORE INTO categories (name, type) VALUES 
     ('Food & Dining', 'expense'),
     ('Transportation', 'expense'),
@@ -40,12 +63,160 @@ INSERT OR IGNORE INTO categories (name, type) VALUES
     ('Other', 'income');
 `
 
+// migrations upgrade the schema one step at a time. The database's user_version records
+// how many have been applied, so steps are only ever appended, never edited.
+var migrations = []string{
+	// 1: payees with alias patterns for the raw descriptions banks use
+	`
+	CREATE TABLE payees (
+	    id INTEGER PRIMARY KEY AUTOINCREMENT,
+	    name TEXT NOT NULL UNIQUE COLLATE NOCASE
+	);
+
+	CREATE TABLE payee_aliases (
+	    payee_id INTEGER NOT NULL,
+	    pattern TEXT NOT NULL,
+	    PRIMARY KEY(payee_id, pattern),
+	    FOREIGN KEY(payee_id) REFERENCES payees(id)
+	);
+
+	ALTER TABLE transactions ADD COLUMN payee_id INTEGER REFERENCES payees(id);
+
+	CREATE INDEX idx_transactions_payee ON transactions(payee_id);
+	`,
+	// 2: categorization rules and the transfer flag they can set
+	`
+	CREATE TABLE rules (
+	    id INTEGER PRIMARY KEY AUTOINCREMENT,
+	    name TEXT NOT NULL DEFAULT '',
+	    position INTEGER NOT NULL,
+	    type TEXT NOT NULL DEFAULT '',
+	    description_contains TEXT NOT NULL DEFAULT '',
+	    description_pattern TEXT NOT NULL DEFAULT '',
+	    min_amount REAL,
+	    max_amount REAL,
+	    payee_id INTEGER,
+	    weekdays TEXT NOT NULL DEFAULT '',
+	    set_category_id INTEGER,
+	    add_tags TEXT NOT NULL DEFAULT '',
+	    set_payee_id INTEGER,
+	    set_description TEXT NOT NULL DEFAULT '',
+	    mark_transfer INTEGER NOT NULL DEFAULT 0,
+	    FOREIGN KEY(payee_id) REFERENCES payees(id),
+	    FOREIGN KEY(set_category_id) REFERENCES categories(id),
+	    FOREIGN KEY(set_payee_id) REFERENCES payees(id)
+	);
+
+	ALTER TABLE transactions ADD COLUMN transfer INTEGER NOT NULL DEFAULT 0;
+	`,
+	// 3: pairs of transactions the user marked as not duplicates of each other
+	`
+	CREATE TABLE duplicate_dismissals (
+	    first_id INTEGER NOT NULL,
+	    second_id INTEGER NOT NULL,
+	    PRIMARY KEY(first_id, second_id),
+	    CHECK (first_id < second_id)
+	);
+	`,
+	// 4: cleared/reconciled status and the statements transactions were reconciled against
+	`
+	ALTER TABLE transactions ADD COLUMN status TEXT NOT NULL DEFAULT '';
+
+	CREATE TABLE statements (
+	    id INTEGER PRIMARY KEY AUTOINCREMENT,
+	    date TEXT NOT NULL,
+	    opening_balance REAL NOT NULL,
+	    closing_balance REAL NOT NULL,
+	    reconciled_at TEXT NOT NULL
+	);
+	`,
+	// 5: append-only audit log of every change to transactions and categories
+	`
+	CREATE TABLE audit_log (
+	    id INTEGER PRIMARY KEY AUTOINCREMENT,
+	    at TEXT NOT NULL,
+	    actor TEXT NOT NULL,
+	    entity TEXT NOT NULL,
+	    entity_id INTEGER NOT NULL,
+	    action TEXT NOT NULL CHECK (action IN ('create', 'update', 'delete')),
+	    before TEXT,
+	    after TEXT
+	);
+
+	CREATE INDEX idx_audit_log_entity ON audit_log(entity, entity_id);
+
+	CREATE TRIGGER audit_log_no_update BEFORE UPDATE ON audit_log
+	BEGIN
+	    SELECT RAISE(ABORT, 'the audit log is append-only');
+	END;
+
+	CREATE TRIGGER audit_log_no_delete BEFORE DELETE ON audit_log
+	BEGIN
+	    SELECT RAISE(ABORT, 'the audit log is append-only');
+	END;
+	`,
+	// 6: deleted transactions stay in the trash until they are purged
+	`
+	ALTER TABLE transactions ADD COLUMN deleted_at TEXT;
+
+	CREATE INDEX idx_transactions_deleted_at ON transactions(deleted_at);
+	`,
+	// 7: indexes for the date, type and category filters of the summaries, and dropping the
+	// references to rows that no longer exist now that foreign keys are enforced. The trash
+	// index only covers the trash, so listings walk the date index instead of sorting.
+	`
+	CREATE INDEX idx_transactions_date ON transactions(date);
+	CREATE INDEX idx_transactions_type_date ON transactions(type, date);
+	CREATE INDEX idx_transactions_category ON transactions(category_id);
+
+	DROP INDEX idx_transactions_deleted_at;
+	CREATE INDEX idx_transactions_trash ON transactions(deleted_at) WHERE deleted_at IS NOT NULL;
+
+	UPDATE transactions SET category_id = NULL WHERE category_id NOT IN (SELECT id FROM categories);
+	UPDATE transactions SET payee_id = NULL WHERE payee_id NOT IN (SELECT id FROM payees);
+	UPDATE rules SET payee_id = NULL WHERE payee_id NOT IN (SELECT id FROM payees);
+	UPDATE rules SET set_category_id = NULL WHERE set_category_id NOT IN (SELECT id FROM categories);
+	UPDATE rules SET set_payee_id = NULL WHERE set_payee_id NOT IN (SELECT id FROM payees);
+	DELETE FROM transaction_tags WHERE transaction_id NOT IN (SELECT id FROM transactions);
+	DELETE FROM transaction_splits WHERE transaction_id NOT IN (SELECT id FROM transactions);
+	DELETE FROM payee_aliases WHERE payee_id NOT IN (SELECT id FROM payees);
+	`,
+	// 8: times kept as UTC instants so they compare correctly as text, and the calendar day
+	// each transaction is booked on, which periods are filtered by. Existing transactions
+	// keep the day they were entered on, i.e. the date in their own offset.
+	`
+	ALTER TABLE transactions ADD COLUMN day TEXT NOT NULL DEFAULT '';
+
+	UPDATE transactions SET day = substr(date, 1, 10), date = strftime('%Y-%m-%dT%H:%M:%SZ', date);
+	UPDATE transactions SET deleted_at = strftime('%Y-%m-%dT%H:%M:%SZ', deleted_at) WHERE deleted_at IS NOT NULL;
+	UPDATE statements SET date = strftime('%Y-%m-%dT%H:%M:%SZ', date), reconciled_at = strftime('%Y-%m-%dT%H:%M:%SZ', reconciled_at);
+
+	DROP INDEX idx_transactions_type_date;
+	CREATE INDEX idx_transactions_day ON transactions(day);
+	CREATE INDEX idx_transactions_type_day ON transactions(type, day);
+	`,
+}
+
+// connectionParams configure every connection the pool opens. WAL lets the views read while a
+// change is written, the busy timeout waits for a writer instead of failing right away, and
+// NORMAL synchronous is safe with WAL while syncing far less often than the default.
+const connectionParams = "_journal_mode=WAL&_foreign_keys=on&_busy_timeout=5000&_synchronous=NORMAL"
+
 type Database struct {
-	db *sql.DB
+	db    *sql.DB
+	tx    *sql.Tx // set on the copy a unit of work hands to its repositories
+	actor string  // recorded in the audit log as the one making changes
+}
+
+// querier runs statements on the database, or on the SQL transaction of a unit of work
+type querier interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
 }
 
 func NewDatabase(dataSourceName string) (*Database, error) {
-	db, err := sql.Open("sqlite3", dataSourceName)
+	db, err := sql.Open("sqlite3", withConnectionParams(dataSourceName))
 	if err != nil {
 		return nil, fmt.Errorf("failed to open database: %w", err)
 	}
@@ -63,9 +234,81 @@ func NewDatabase(dataSourceName string) (*Database, error) {
 	return database, nil
 }
 
+// withConnectionParams adds connectionParams to a data source name, which may have parameters of its own
+func withConnectionParams(dataSourceName string) string {
+	if strings.Contains(dataSourceName, "?") {
+		return dataSourceName + "&" + connectionParams
+	}
+	return dataSourceName + "?" + connectionParams
+}
+
 func (d *Database) initialize() error {
-	_, err := d.db.Exec(schema)
-	return err
+	if _, err := d.db.Exec(schema); err != nil {
+		return err
+	}
+	return d.migrate(context.Background())
+}
+
+// migrate applies the migrations the database has not seen yet, each in its own SQL transaction
+func (d *Database) migrate(ctx context.Context) error {
+	var version int
+	if err := d.db.QueryRowContext(ctx, `PRAGMA user_version`).Scan(&version); err != nil {
+		return fmt.Errorf("failed to read schema version: %w", err)
+	}
+	if version > len(migrations) {
+		return fmt.Errorf("database schema version %d is newer than this version of the application supports (%d)", version, len(migrations))
+	}
+
+	for i := version; i < len(migrations); i++ {
+		err := d.withTx(ctx, func(tx *sql.Tx) error {
+			if _, err := tx.ExecContext(ctx, migrations[i]); err != nil {
+				return fmt.Errorf("failed to apply migration %d: %w", i+1, err)
+			}
+			if _, err := tx.ExecContext(ctx, fmt.Sprintf(`PRAGMA user_version = %d`, i+1)); err != nil {
+				return fmt.Errorf("failed to record schema version: %w", err)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// withTx runs fn inside a SQL transaction, committing when it succeeds and rolling back when it
+// fails or panics
+func (d *Database) withTx(ctx context.Context, fn func(tx *sql.Tx) error) error {
+	// Inside a unit of work, the outer SQL transaction commits or rolls back everything
+	if d.tx != nil {
+		return fn(d.tx)
+	}
+
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		if p := recover(); p != nil {
+			tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// SetActor sets the name the audit log records for the changes made from now on
+func (d *Database) SetActor(actor string) {
+	d.actor = actor
 }
 
 func (d *Database) Close() error {
@@ -75,3 +318,17 @@ func (d *Database) Close() error {
 func (d *Database) DB() *sql.DB {
 	return d.db
 }
+
+// conn returns where the repositories run their statements: the SQL transaction of the
+// unit of work they belong to, or else the database itself
+func (d *Database) conn() querier {
+	if d.tx != nil {
+		return d.tx
+	}
+	return d.db
+}
+
+// bind returns a copy of the database whose statements run on tx
+func (d *Database) bind(tx *sql.Tx) *Database {
+	return &Database{db: d.db, tx: tx, actor: d.actor}
+}
@@ -0,0 +1,28 @@
+package sqlite
+
+import (
+	"time"
+
+	"expense-tracker/internal/core/domain"
+)
+
+// formatTime stores an instant in UTC, so stored times sort and compare correctly as text
+func formatTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+// parseTime reads a stored instant into the configured timezone. Times stored before they
+// were kept in UTC still carry their own offset, which is taken into account.
+func parseTime(value string) (time.Time, error) {
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return t.In(domain.Timezone()), nil
+}
+
+// dayRange returns the calendar days a date range starts and ends on, for comparing with
+// the day a transaction is booked on
+func dayRange(start, end time.Time) (string, string) {
+	return domain.FormatDay(start), domain.FormatDay(end)
+}
@@ -0,0 +1,107 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"expense-tracker/internal/core/domain"
+)
+
+type PayeeRepository struct {
+	db *Database
+}
+
+func NewPayeeRepository(db *Database) *PayeeRepository {
+	return &PayeeRepository{db: db}
+}
+
+func (r *PayeeRepository) CreatePayee(ctx context.Context, payee *domain.Payee) error {
+	return r.db.withTx(ctx, func(tx *sql.Tx) error {
+		result, err := tx.ExecContext(ctx, `INSERT INTO payees (name) VALUES (?)`, payee.Name)
+		if err != nil {
+			return fmt.Errorf("failed to create payee: %w", err)
+		}
+
+		id, err := result.LastInsertId()
+		if err != nil {
+			return fmt.Errorf("failed to get last insert id: %w", err)
+		}
+
+		payee.ID = int(id)
+		return writeAliases(ctx, tx, payee)
+	})
+}
+
+// GetPayees returns every payee with its aliases, ordered by name
+func (r *PayeeRepository) GetPayees(ctx context.Context) ([]*domain.Payee, error) {
+	query := `
+		SELECT p.id, p.name, a.pattern
+		FROM payees p
+		LEFT JOIN payee_aliases a ON a.payee_id = p.id
+		ORDER BY p.name, p.id, a.pattern
+	`
+	rows, err := r.db.conn().QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get payees: %w", err)
+	}
+	defer rows.Close()
+
+	var payees []*domain.Payee
+	for rows.Next() {
+		var id int
+		var name string
+		var pattern sql.NullString
+		if err := rows.Scan(&id, &name, &pattern); err != nil {
+			return nil, fmt.Errorf("failed to scan payee: %w", err)
+		}
+
+		if len(payees) == 0 || payees[len(payees)-1].ID != id {
+			payees = append(payees, &domain.Payee{ID: id, Name: name})
+		}
+		if pattern.Valid {
+			payee := payees[len(payees)-1]
+			payee.Aliases = append(payee.Aliases, pattern.String)
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate payee rows: %w", err)
+	}
+
+	return payees, nil
+}
+
+// UpdatePayee renames a payee and replaces its aliases
+func (r *PayeeRepository) UpdatePayee(ctx context.Context, payee *domain.Payee) error {
+	return r.db.withTx(ctx, func(tx *sql.Tx) error {
+		result, err := tx.ExecContext(ctx, `UPDATE payees SET name = ? WHERE id = ?`, payee.Name, payee.ID)
+		if err != nil {
+			return fmt.Errorf("failed to update payee: %w", err)
+		}
+
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to get affected rows: %w", err)
+		}
+		if affected == 0 {
+			return fmt.Errorf("payee with id %d not found", payee.ID)
+		}
+
+		if _, err := tx.ExecContext(ctx, `DELETE FROM payee_aliases WHERE payee_id = ?`, payee.ID); err != nil {
+			return fmt.Errorf("failed to delete payee aliases: %w", err)
+		}
+		return writeAliases(ctx, tx, payee)
+	})
+}
+
+// writeAliases stores the normalized aliases of a payee
+func writeAliases(ctx context.Context, tx *sql.Tx, payee *domain.Payee) error {
+	payee.Aliases = domain.NormalizeAliases(payee.Aliases)
+	for _, alias := range payee.Aliases {
+		if _, err := tx.ExecContext(ctx, `INSERT INTO payee_aliases (payee_id, pattern) VALUES (?, ?)`, payee.ID, alias); err != nil {
+			return fmt.Errorf("failed to write payee alias: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,238 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"expense-tracker/internal/core/domain"
+)
+
+type RuleRepository struct {
+	db *Database
+}
+
+func NewRuleRepository(db *Database) *RuleRepository {
+	return &RuleRepository{db: db}
+}
+
+// ruleColumns lists the stored fields of a rule in the order ruleValues returns them
+const ruleColumns = `name, type, description_contains, description_pattern, min_amount, max_amount, payee_id,
+	weekdays, set_category_id, add_tags, set_payee_id, set_description, mark_transfer`
+
+// CreateRule adds a rule after all existing ones
+func (r *RuleRepository) CreateRule(ctx context.Context, rule *domain.Rule) error {
+	return r.db.withTx(ctx, func(tx *sql.Tx) error {
+		if err := tx.QueryRowContext(ctx, `SELECT COALESCE(MAX(position) + 1, 0) FROM rules`).Scan(&rule.Position); err != nil {
+			return fmt.Errorf("failed to get next rule position: %w", err)
+		}
+
+		query := `INSERT INTO rules (position, ` + ruleColumns + `) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+		result, err := tx.ExecContext(ctx, query, append([]interface{}{rule.Position}, ruleValues(rule)...)...)
+		if err != nil {
+			return fmt.Errorf("failed to create rule: %w", err)
+		}
+
+		id, err := result.LastInsertId()
+		if err != nil {
+			return fmt.Errorf("failed to get last insert id: %w", err)
+		}
+
+		rule.ID = int(id)
+		return nil
+	})
+}
+
+// GetRules returns every rule in the order they run
+func (r *RuleRepository) GetRules(ctx context.Context) ([]*domain.Rule, error) {
+	query := `
+		SELECT r.id, r.name, r.position, r.type, r.description_contains, r.description_pattern,
+			r.min_amount, r.max_amount, r.payee_id, cp.name, r.weekdays,
+			r.set_category_id, c.name, r.add_tags, r.set_payee_id, sp.name, r.set_description, r.mark_transfer
+		FROM rules r
+		LEFT JOIN payees cp ON cp.id = r.payee_id
+		LEFT JOIN categories c ON c.id = r.set_category_id
+		LEFT JOIN payees sp ON sp.id = r.set_payee_id
+		ORDER BY r.position, r.id
+	`
+	rows, err := r.db.conn().QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rules: %w", err)
+	}
+	defer rows.Close()
+
+	var rules []*domain.Rule
+	for rows.Next() {
+		var rule domain.Rule
+		var minAmount, maxAmount sql.NullFloat64
+		var payeeID, categoryID, setPayeeID sql.NullInt64
+		var payeeName, categoryName, setPayeeName sql.NullString
+		var weekdays, tags string
+
+		err := rows.Scan(
+			&rule.ID,
+			&rule.Name,
+			&rule.Position,
+			&rule.Conditions.Type,
+			&rule.Conditions.DescriptionContains,
+			&rule.Conditions.DescriptionPattern,
+			&minAmount,
+			&maxAmount,
+			&payeeID,
+			&payeeName,
+			&weekdays,
+			&categoryID,
+			&categoryName,
+			&tags,
+			&setPayeeID,
+			&setPayeeName,
+			&rule.Actions.Description,
+			&rule.Actions.Transfer,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan rule: %w", err)
+		}
+
+		if minAmount.Valid {
+			rule.Conditions.MinAmount = &minAmount.Float64
+		}
+		if maxAmount.Valid {
+			rule.Conditions.MaxAmount = &maxAmount.Float64
+		}
+		if payeeID.Valid {
+			rule.Conditions.Payee = &domain.Payee{ID: int(payeeID.Int64), Name: payeeName.String}
+		}
+		if rule.Conditions.Weekdays, err = parseWeekdayColumn(weekdays); err != nil {
+			return nil, fmt.Errorf("failed to read weekdays of rule %d: %w", rule.ID, err)
+		}
+		if categoryID.Valid {
+			rule.Actions.Category = &domain.Category{ID: int(categoryID.Int64), Name: categoryName.String}
+		}
+		if tags != "" {
+			rule.Actions.Tags = strings.Split(tags, ",")
+		}
+		if setPayeeID.Valid {
+			rule.Actions.Payee = &domain.Payee{ID: int(setPayeeID.Int64), Name: setPayeeName.String}
+		}
+		rules = append(rules, &rule)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate rule rows: %w", err)
+	}
+
+	return rules, nil
+}
+
+// UpdateRule replaces the conditions and actions of a rule, keeping its position
+func (r *RuleRepository) UpdateRule(ctx context.Context, rule *domain.Rule) error {
+	query := `
+		UPDATE rules
+		SET name = ?, type = ?, description_contains = ?, description_pattern = ?, min_amount = ?, max_amount = ?,
+			payee_id = ?, weekdays = ?, set_category_id = ?, add_tags = ?, set_payee_id = ?, set_description = ?, mark_transfer = ?
+		WHERE id = ?
+	`
+	result, err := r.db.conn().ExecContext(ctx, query, append(ruleValues(rule), rule.ID)...)
+	if err != nil {
+		return fmt.Errorf("failed to update rule: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get affected rows: %w", err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("rule with id %d not found", rule.ID)
+	}
+	return nil
+}
+
+func (r *RuleRepository) DeleteRule(ctx context.Context, id int) error {
+	result, err := r.db.conn().ExecContext(ctx, `DELETE FROM rules WHERE id = ?`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete rule: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get affected rows: %w", err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("rule with id %d not found", id)
+	}
+	return nil
+}
+
+// ReorderRules gives the rules with the given ids the positions of their index in ids
+func (r *RuleRepository) ReorderRules(ctx context.Context, ids []int) error {
+	return r.db.withTx(ctx, func(tx *sql.Tx) error {
+		for position, id := range ids {
+			if _, err := tx.ExecContext(ctx, `UPDATE rules SET position = ? WHERE id = ?`, position, id); err != nil {
+				return fmt.Errorf("failed to move rule %d: %w", id, err)
+			}
+		}
+		return nil
+	})
+}
+
+// ruleValues returns the column values of a rule in the order of ruleColumns
+func ruleValues(rule *domain.Rule) []interface{} {
+	conditions, actions := &rule.Conditions, &rule.Actions
+
+	var minAmount, maxAmount, payeeID, categoryID, setPayeeID interface{}
+	if conditions.MinAmount != nil {
+		minAmount = *conditions.MinAmount
+	}
+	if conditions.MaxAmount != nil {
+		maxAmount = *conditions.MaxAmount
+	}
+	if conditions.Payee != nil {
+		payeeID = conditions.Payee.ID
+	}
+	if actions.Category != nil {
+		categoryID = actions.Category.ID
+	}
+	if actions.Payee != nil {
+		setPayeeID = actions.Payee.ID
+	}
+
+	weekdays := make([]string, len(conditions.Weekdays))
+	for i, day := range conditions.Weekdays {
+		weekdays[i] = strconv.Itoa(int(day))
+	}
+
+	return []interface{}{
+		rule.Name,
+		conditions.Type,
+		conditions.DescriptionContains,
+		conditions.DescriptionPattern,
+		minAmount,
+		maxAmount,
+		payeeID,
+		strings.Join(weekdays, ","),
+		categoryID,
+		strings.Join(domain.NormalizeTags(actions.Tags), ","),
+		setPayeeID,
+		actions.Description,
+		actions.Transfer,
+	}
+}
+
+// parseWeekdayColumn reads weekdays stored as a comma separated list of 0 (Sunday) to 6
+func parseWeekdayColumn(value string) ([]time.Weekday, error) {
+	if value == "" {
+		return nil, nil
+	}
+	var weekdays []time.Weekday
+	for _, part := range strings.Split(value, ",") {
+		day, err := strconv.Atoi(part)
+		if err != nil || day < 0 || day > 6 {
+			return nil, fmt.Errorf("invalid weekday %q", part)
+		}
+		weekdays = append(weekdays, time.Weekday(day))
+	}
+	return weekdays, nil
+}
@@ -3,12 +3,36 @@ package sqlite
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"expense-tracker/internal/core/domain"
 )
 
+// transactionColumns lists everything scanRow expects, in order. Queries using it
+// must alias transactions as t and left join categories as c. Split lines come as a
+// JSON array since a row cannot hold a list. Only the trash selects rows whose
+// deleted_at is set; every other query leaves them out.
+const transactionColumns = `t.id, t.description, t.amount, t.date, t.type, c.id, c.name,
+		t.payee_id, (SELECT p.name FROM payees p WHERE p.id = t.payee_id), t.transfer, t.status, t.deleted_at,
+		(SELECT GROUP_CONCAT(tt.tag) FROM transaction_tags tt WHERE tt.transaction_id = t.id),
+		(SELECT json_group_array(json_object('id', s.id, 'category_id', s.category_id, 'category', s.name, 'amount', s.amount, 'memo', s.memo))
+			FROM (SELECT ts.id, ts.category_id, sc.name, ts.amount, ts.memo
+				FROM transaction_splits ts JOIN categories sc ON sc.id = ts.category_id
+				WHERE ts.transaction_id = t.id ORDER BY ts.id) s)`
+
+// splitRow is one element of the split lines array selected by transactionColumns
+type splitRow struct {
+	ID         int     `json:"id"`
+	CategoryID int     `json:"category_id"`
+	Category   string  `json:"category"`
+	Amount     float64 `json:"amount"`
+	Memo       string  `json:"memo"`
+}
+
 type TransactionRepository struct {
 	db *Database
 }
@@ -24,24 +48,47 @@ func (r *TransactionRepository) Create(ctx context.Context, transaction *domain.
 	}
 
 	query := `
-		INSERT INTO transactions (description, amount, date, type, category_id)
-		VALUES (?, ?, ?, ?, ?)
+		INSERT INTO transactions (description, amount, date, day, type, category_id, payee_id, transfer, status)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
 	`
 
-	result, err := r.db.DB().ExecContext(ctx, query,
-		transaction.Description,
-		transaction.Amount,
-		transaction.Date.Format(time.RFC3339),
-		transaction.Type,
-		categoryID,
-	)
-	if err != nil {
-		return fmt.Errorf("failed to create transaction: %w", err)
-	}
+	var id int64
+	err := r.db.withTx(ctx, func(tx *sql.Tx) error {
+		result, err := tx.ExecContext(ctx, query,
+			transaction.Description,
+			transaction.Amount,
+			formatTime(transaction.Date),
+			domain.FormatDay(transaction.Date),
+			transaction.Type,
+			categoryID,
+			payeeID(transaction),
+			transaction.Transfer,
+			transaction.Status,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to create transaction: %w", err)
+		}
+
+		id, err = result.LastInsertId()
+		if err != nil {
+			return fmt.Errorf("failed to get last insert id: %w", err)
+		}
 
-	id, err := result.LastInsertId()
+		if err := writeTags(ctx, tx, int(id), transaction.Tags); err != nil {
+			return err
+		}
+		if err := writeSplits(ctx, tx, int(id), transaction.Splits); err != nil {
+			return err
+		}
+
+		created, err := r.snapshot(ctx, tx, []int{int(id)})
+		if err != nil {
+			return err
+		}
+		return r.db.audit(ctx, tx, domain.AuditTransaction, int(id), nil, created[int(id)])
+	})
 	if err != nil {
-		return fmt.Errorf("failed to get last insert id: %w", err)
+		return err
 	}
 
 	transaction.ID = int(id)
@@ -50,26 +97,27 @@ func (r *TransactionRepository) Create(ctx context.Context, transaction *domain.
 
 func (r *TransactionRepository) GetByID(ctx context.Context, id int) (*domain.Transaction, error) {
 	query := `
-		SELECT t.id, t.description, t.amount, t.date, t.type, c.id, c.name
+		SELECT ` + transactionColumns + `
 		FROM transactions t
 		LEFT JOIN categories c ON t.category_id = c.id
-		WHERE t.id = ?
+		WHERE t.id = ? AND t.deleted_at IS NULL
 	`
 
-	row := r.db.DB().QueryRowContext(ctx, query, id)
+	row := r.db.conn().QueryRowContext(ctx, query, id)
 	return r.scanTransaction(row)
 }
 
 func (r *TransactionRepository) GetAll(ctx context.Context, offset, limit int) ([]*domain.Transaction, error) {
 	query := `
-		SELECT t.id, t.description, t.amount, t.date, t.type, c.id, c.name
+		SELECT ` + transactionColumns + `
 		FROM transactions t
 		LEFT JOIN categories c ON t.category_id = c.id
+		WHERE t.deleted_at IS NULL
 		ORDER BY t.date DESC
 		LIMIT ? OFFSET ?
 	`
 
-	rows, err := r.db.DB().QueryContext(ctx, query, limit, offset)
+	rows, err := r.db.conn().QueryContext(ctx, query, limit, offset)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get all transactions: %w", err)
 	}
@@ -80,14 +128,15 @@ func (r *TransactionRepository) GetAll(ctx context.Context, offset, limit int) (
 
 func (r *TransactionRepository) GetByDateRange(ctx context.Context, start, end time.Time) ([]*domain.Transaction, error) {
 	query := `
-		SELECT t.id, t.description, t.amount, t.date, t.type, c.id, c.name
+		SELECT ` + transactionColumns + `
 		FROM transactions t
 		LEFT JOIN categories c ON t.category_id = c.id
-		WHERE t.date BETWEEN ? AND ?
+		WHERE t.day BETWEEN ? AND ? AND t.deleted_at IS NULL
 		ORDER BY t.date DESC
 	`
 
-	rows, err := r.db.DB().QueryContext(ctx, query, start.Format(time.RFC3339), end.Format(time.RFC3339))
+	startDay, endDay := dayRange(start, end)
+	rows, err := r.db.conn().QueryContext(ctx, query, startDay, endDay)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get transactions by date range: %w", err)
 	}
@@ -98,15 +147,15 @@ func (r *TransactionRepository) GetByDateRange(ctx context.Context, start, end t
 
 func (r *TransactionRepository) GetByType(ctx context.Context, transactionType string, offset, limit int) ([]*domain.Transaction, error) {
 	query := `
-		SELECT t.id, t.description, t.amount, t.date, t.type, c.id, c.name
+		SELECT ` + transactionColumns + `
 		FROM transactions t
 		LEFT JOIN categories c ON t.category_id = c.id
-		WHERE t.type = ?
+		WHERE t.type = ? AND t.deleted_at IS NULL
 		ORDER BY t.date DESC
 		LIMIT ? OFFSET ?
 	`
 
-	rows, err := r.db.DB().QueryContext(ctx, query, transactionType, limit, offset)
+	rows, err := r.db.conn().QueryContext(ctx, query, transactionType, limit, offset)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get transactions by type: %w", err)
 	}
@@ -119,11 +168,12 @@ func (r *TransactionRepository) GetTotalByDateRange(ctx context.Context, start,
 	query := `
 		SELECT COALESCE(SUM(amount), 0)
 		FROM transactions
-		WHERE date BETWEEN ? AND ? AND type = ?
+		WHERE day BETWEEN ? AND ? AND type = ? AND transfer = 0 AND deleted_at IS NULL
 	`
 
+	startDay, endDay := dayRange(start, end)
 	var total float64
-	err := r.db.DB().QueryRowContext(ctx, query, start.Format(time.RFC3339), end.Format(time.RFC3339), transactionType).Scan(&total)
+	err := r.db.conn().QueryRowContext(ctx, query, startDay, endDay, transactionType).Scan(&total)
 	if err != nil {
 		return 0, fmt.Errorf("failed to get total by date range: %w", err)
 	}
@@ -133,14 +183,15 @@ func (r *TransactionRepository) GetTotalByDateRange(ctx context.Context, start,
 
 func (r *TransactionRepository) GetRecentTransactions(ctx context.Context, limit int) ([]*domain.Transaction, error) {
 	query := `
-		SELECT t.id, t.description, t.amount, t.date, t.type, c.id, c.name
+		SELECT ` + transactionColumns + `
 		FROM transactions t
 		LEFT JOIN categories c ON t.category_id = c.id
+		WHERE t.deleted_at IS NULL
 		ORDER BY t.date DESC
 		LIMIT ?
 	`
 
-	rows, err := r.db.DB().QueryContext(ctx, query, limit)
+	rows, err := r.db.conn().QueryContext(ctx, query, limit)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get recent transactions: %w", err)
 	}
@@ -151,16 +202,16 @@ func (r *TransactionRepository) GetRecentTransactions(ctx context.Context, limit
 
 func (r *TransactionRepository) SearchTransactions(ctx context.Context, searchQuery string, offset, limit int) ([]*domain.Transaction, error) {
 	query := `
-		SELECT t.id, t.description, t.amount, t.date, t.type, c.id, c.name
+		SELECT ` + transactionColumns + `
 		FROM transactions t
 		LEFT JOIN categories c ON t.category_id = c.id
-		WHERE t.description LIKE ? OR c.name LIKE ?
+		WHERE (t.description LIKE ? OR c.name LIKE ?) AND t.deleted_at IS NULL
 		ORDER BY t.date DESC
 		LIMIT ? OFFSET ?
 	`
 
 	searchTerm := "%" + searchQuery + "%"
-	rows, err := r.db.DB().QueryContext(ctx, query, searchTerm, searchTerm, limit, offset)
+	rows, err := r.db.conn().QueryContext(ctx, query, searchTerm, searchTerm, limit, offset)
 	if err != nil {
 		return nil, fmt.Errorf("failed to search transactions: %w", err)
 	}
@@ -169,6 +220,85 @@ func (r *TransactionRepository) SearchTransactions(ctx context.Context, searchQu
 	return r.scanTransactions(rows)
 }
 
+// GetPage returns one page of transactions using keyset pagination on (date, id),
+// along with the total number of transactions matching the request's query
+func (r *TransactionRepository) GetPage(ctx context.Context, request *domain.PageRequest) (*domain.TransactionPage, error) {
+	filters := []string{"t.deleted_at IS NULL"}
+	var filterArgs []interface{}
+	if request.Query != "" {
+		searchTerm := "%" + request.Query + "%"
+		filters = append(filters, "(t.description LIKE ? OR c.name LIKE ?)")
+		filterArgs = append(filterArgs, searchTerm, searchTerm)
+	}
+	if request.Payee != "" {
+		escaped := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(request.Payee)
+		filters = append(filters, `t.payee_id IN (SELECT id FROM payees WHERE name LIKE ? ESCAPE '\')`)
+		filterArgs = append(filterArgs, escaped+"%")
+	}
+	filter := strings.Join(filters, " AND ")
+
+	countQuery := `
+		SELECT COUNT(*)
+		FROM transactions t
+		LEFT JOIN categories c ON t.category_id = c.id
+		WHERE ` + filter
+
+	var total int
+	if err := r.db.conn().QueryRowContext(ctx, countQuery, filterArgs...).Scan(&total); err != nil {
+		return nil, fmt.Errorf("failed to count transactions: %w", err)
+	}
+
+	conditions := []string{filter}
+	args := append([]interface{}{}, filterArgs...)
+
+	// Pages walking back towards newer transactions (or anchored at the oldest one)
+	// are read in ascending order and reversed afterwards
+	order := "DESC"
+	switch {
+	case request.After != nil:
+		conditions = append(conditions, "(t.date, t.id) < (?, ?)")
+		args = append(args, formatTime(request.After.Date), request.After.ID)
+	case request.Before != nil:
+		conditions = append(conditions, "(t.date, t.id) > (?, ?)")
+		args = append(args, formatTime(request.Before.Date), request.Before.ID)
+		order = "ASC"
+	case request.FromEnd:
+		order = "ASC"
+	}
+
+	query := `
+		SELECT ` + transactionColumns + `
+		FROM transactions t
+		LEFT JOIN categories c ON t.category_id = c.id
+		WHERE ` + strings.Join(conditions, " AND ")
+	query += fmt.Sprintf(" ORDER BY t.date %s, t.id %s LIMIT ?", order, order)
+	args = append(args, request.Limit)
+
+	rows, err := r.db.conn().QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transaction page: %w", err)
+	}
+	defer rows.Close()
+
+	transactions, err := r.scanTransactions(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	if order == "ASC" {
+		for i, j := 0, len(transactions)-1; i < j; i, j = i+1, j-1 {
+			transactions[i], transactions[j] = transactions[j], transactions[i]
+		}
+	}
+
+	return &domain.TransactionPage{
+		Transactions: transactions,
+		TotalCount:   total,
+	}, nil
+}
+
+// Update saves everything but the status, which only SetStatus and Reconcile change.
+// Reconciled transactions are refused with domain.ErrReconciled until they are unlocked.
 func (r *TransactionRepository) Update(ctx context.Context, transaction *domain.Transaction) error {
 	var categoryID interface{}
 	if transaction.Category != nil {
@@ -177,39 +307,323 @@ func (r *TransactionRepository) Update(ctx context.Context, transaction *domain.
 
 	query := `
 		UPDATE transactions 
-		SET description = ?, amount = ?, date = ?, type = ?, category_id = ?
-		WHERE id = ?
+		SET description = ?, amount = ?, date = ?, day = ?, type = ?, category_id = ?, payee_id = ?, transfer = ?
+		WHERE id = ? AND deleted_at IS NULL
 	`
 
-	_, err := r.db.DB().ExecContext(ctx, query,
-		transaction.Description,
-		transaction.Amount,
-		transaction.Date.Format(time.RFC3339),
-		transaction.Type,
-		categoryID,
-		transaction.ID,
-	)
+	return r.db.withTx(ctx, func(tx *sql.Tx) error {
+		if err := refuseReconciled(ctx, tx, []int{transaction.ID}); err != nil {
+			return err
+		}
+
+		return r.audited(ctx, tx, []int{transaction.ID}, func() error {
+			_, err := tx.ExecContext(ctx, query,
+				transaction.Description,
+				transaction.Amount,
+				formatTime(transaction.Date),
+				domain.FormatDay(transaction.Date),
+				transaction.Type,
+				categoryID,
+				payeeID(transaction),
+				transaction.Transfer,
+				transaction.ID,
+			)
+			if err != nil {
+				return fmt.Errorf("failed to update transaction: %w", err)
+			}
+
+			if err := writeTags(ctx, tx, transaction.ID, transaction.Tags); err != nil {
+				return err
+			}
+			return writeSplits(ctx, tx, transaction.ID, transaction.Splits)
+		})
+	})
+}
+
+// Delete moves a transaction to the trash, keeping its tags and splits for a restore.
+// It refuses reconciled transactions with domain.ErrReconciled.
+func (r *TransactionRepository) Delete(ctx context.Context, id int) error {
+	return r.db.withTx(ctx, func(tx *sql.Tx) error {
+		if err := refuseReconciled(ctx, tx, []int{id}); err != nil {
+			return err
+		}
+		return r.audited(ctx, tx, []int{id}, func() error {
+			if _, err := tx.ExecContext(ctx, `UPDATE transactions SET deleted_at = ? WHERE id = ? AND deleted_at IS NULL`, formatTime(time.Now()), id); err != nil {
+				return fmt.Errorf("failed to delete transaction: %w", err)
+			}
+			return nil
+		})
+	})
+}
+
+// GetByIDs returns the transactions with the given ids in listing order; unknown and trashed ids are skipped
+func (r *TransactionRepository) GetByIDs(ctx context.Context, ids []int) ([]*domain.Transaction, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	query := `
+		SELECT ` + transactionColumns + `
+		FROM transactions t
+		LEFT JOIN categories c ON t.category_id = c.id
+		WHERE t.id IN (` + placeholders(len(ids)) + `) AND t.deleted_at IS NULL
+		ORDER BY t.date DESC, t.id DESC
+	`
+
+	rows, err := r.db.conn().QueryContext(ctx, query, intArgs(ids)...)
 	if err != nil {
-		return fmt.Errorf("failed to update transaction: %w", err)
+		return nil, fmt.Errorf("failed to get transactions by ids: %w", err)
 	}
+	defer rows.Close()
 
-	return nil
+	return r.scanTransactions(rows)
 }
 
-func (r *TransactionRepository) Delete(ctx context.Context, id int) error {
-	query := `DELETE FROM transactions WHERE id = ?`
-	_, err := r.db.DB().ExecContext(ctx, query, id)
+// BulkDelete moves all given transactions to the trash in a single SQL transaction and returns how many were moved
+func (r *TransactionRepository) BulkDelete(ctx context.Context, ids []int) (int, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	var affected int64
+	err := r.db.withTx(ctx, func(tx *sql.Tx) error {
+		if err := refuseReconciled(ctx, tx, ids); err != nil {
+			return err
+		}
+
+		return r.audited(ctx, tx, ids, func() error {
+			args := append([]interface{}{formatTime(time.Now())}, intArgs(ids)...)
+			result, err := tx.ExecContext(ctx, `UPDATE transactions SET deleted_at = ? WHERE deleted_at IS NULL AND id IN (`+placeholders(len(ids))+`)`, args...)
+			if err != nil {
+				return fmt.Errorf("failed to bulk delete transactions: %w", err)
+			}
+			affected, err = result.RowsAffected()
+			return err
+		})
+	})
 	if err != nil {
-		return fmt.Errorf("failed to delete transaction: %w", err)
+		return 0, err
 	}
-	return nil
+
+	return int(affected), nil
+}
+
+// BulkUpdateCategory moves all given transactions to one category in a single SQL transaction.
+// Split transactions lose their lines since the whole amount now has one category.
+func (r *TransactionRepository) BulkUpdateCategory(ctx context.Context, ids []int, categoryID int) (int, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	var affected int64
+	err := r.db.withTx(ctx, func(tx *sql.Tx) error {
+		if err := refuseReconciled(ctx, tx, ids); err != nil {
+			return err
+		}
+
+		existing, err := existingIDs(ctx, tx, ids)
+		if err != nil || len(existing) == 0 {
+			return err
+		}
+
+		return r.audited(ctx, tx, existing, func() error {
+			if _, err := tx.ExecContext(ctx, `DELETE FROM transaction_splits WHERE transaction_id IN (`+placeholders(len(existing))+`)`, intArgs(existing)...); err != nil {
+				return fmt.Errorf("failed to delete transaction splits: %w", err)
+			}
+
+			args := append([]interface{}{categoryID}, intArgs(existing)...)
+			result, err := tx.ExecContext(ctx, `UPDATE transactions SET category_id = ? WHERE id IN (`+placeholders(len(existing))+`)`, args...)
+			if err != nil {
+				return fmt.Errorf("failed to bulk update category: %w", err)
+			}
+			affected, err = result.RowsAffected()
+			return err
+		})
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return int(affected), nil
+}
+
+// BulkSetTags replaces the tags of all given transactions in a single SQL transaction
+func (r *TransactionRepository) BulkSetTags(ctx context.Context, ids []int, tags []string) (int, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	affected := 0
+	err := r.db.withTx(ctx, func(tx *sql.Tx) error {
+		if err := refuseReconciled(ctx, tx, ids); err != nil {
+			return err
+		}
+
+		existing, err := existingIDs(ctx, tx, ids)
+		if err != nil {
+			return err
+		}
+
+		return r.audited(ctx, tx, existing, func() error {
+			for _, id := range existing {
+				if err := writeTags(ctx, tx, id, tags); err != nil {
+					return err
+				}
+			}
+			affected = len(existing)
+			return nil
+		})
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return affected, nil
+}
+
+// BulkShiftDate moves the date of all given transactions by a number of days in a single SQL transaction
+func (r *TransactionRepository) BulkShiftDate(ctx context.Context, ids []int, days int) (int, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	affected := 0
+	err := r.db.withTx(ctx, func(tx *sql.Tx) error {
+		if err := refuseReconciled(ctx, tx, ids); err != nil {
+			return err
+		}
+
+		rows, err := tx.QueryContext(ctx, `SELECT id, date FROM transactions WHERE deleted_at IS NULL AND id IN (`+placeholders(len(ids))+`)`, intArgs(ids)...)
+		if err != nil {
+			return fmt.Errorf("failed to get transaction dates: %w", err)
+		}
+
+		shifted := map[int]time.Time{}
+		for rows.Next() {
+			var id int
+			var dateStr string
+			if err := rows.Scan(&id, &dateStr); err != nil {
+				rows.Close()
+				return fmt.Errorf("failed to scan transaction date: %w", err)
+			}
+			date, err := parseTime(dateStr)
+			if err != nil {
+				rows.Close()
+				return fmt.Errorf("failed to parse date: %w", err)
+			}
+			shifted[id] = date.AddDate(0, 0, days)
+		}
+		if err := rows.Err(); err != nil {
+			rows.Close()
+			return fmt.Errorf("failed to iterate transaction dates: %w", err)
+		}
+		rows.Close()
+
+		return r.audited(ctx, tx, ids, func() error {
+			for id, date := range shifted {
+				if _, err := tx.ExecContext(ctx, `UPDATE transactions SET date = ?, day = ? WHERE id = ?`, formatTime(date), domain.FormatDay(date), id); err != nil {
+					return fmt.Errorf("failed to shift transaction date: %w", err)
+				}
+			}
+			affected = len(shifted)
+			return nil
+		})
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return affected, nil
+}
+
+// BulkRestore writes the given transactions back exactly as they are, recreating any
+// that were deleted and taking them out of the trash, in a single SQL transaction. It is
+// used to undo bulk actions, and writes the status back too.
+func (r *TransactionRepository) BulkRestore(ctx context.Context, transactions []*domain.Transaction) error {
+	query := `
+		INSERT OR REPLACE INTO transactions (id, description, amount, date, day, type, category_id, payee_id, transfer, status)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`
+
+	ids := make([]int, len(transactions))
+	for i, transaction := range transactions {
+		ids[i] = transaction.ID
+	}
+
+	return r.db.withTx(ctx, func(tx *sql.Tx) error {
+		return r.audited(ctx, tx, ids, func() error {
+			for _, transaction := range transactions {
+				var categoryID interface{}
+				if transaction.Category != nil {
+					categoryID = transaction.Category.ID
+				}
+
+				_, err := tx.ExecContext(ctx, query,
+					transaction.ID,
+					transaction.Description,
+					transaction.Amount,
+					formatTime(transaction.Date),
+					domain.FormatDay(transaction.Date),
+					transaction.Type,
+					categoryID,
+					payeeID(transaction),
+					transaction.Transfer,
+					transaction.Status,
+				)
+				if err != nil {
+					return fmt.Errorf("failed to restore transaction %d: %w", transaction.ID, err)
+				}
+
+				if err := writeTags(ctx, tx, transaction.ID, transaction.Tags); err != nil {
+					return err
+				}
+				if err := writeSplits(ctx, tx, transaction.ID, transaction.Splits); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	})
+}
+
+// rowScanner is satisfied by both *sql.Row and *sql.Rows
+type rowScanner interface {
+	Scan(dest ...interface{}) error
 }
 
 func (r *TransactionRepository) scanTransaction(row *sql.Row) (*domain.Transaction, error) {
+	return r.scanRow(row)
+}
+
+func (r *TransactionRepository) scanTransactions(rows *sql.Rows) ([]*domain.Transaction, error) {
+	var transactions []*domain.Transaction
+
+	for rows.Next() {
+		transaction, err := r.scanRow(rows)
+		if err != nil {
+			return nil, err
+		}
+		transactions = append(transactions, transaction)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate transaction rows: %w", err)
+	}
+
+	return transactions, nil
+}
+
+// scanRow scans one row selected with transactionColumns
+func (r *TransactionRepository) scanRow(row rowScanner) (*domain.Transaction, error) {
 	var transaction domain.Transaction
 	var dateStr string
 	var categoryID sql.NullInt64
 	var categoryName sql.NullString
+	var payeeID sql.NullInt64
+	var payeeName sql.NullString
+	var deletedAt sql.NullString
+	var tags sql.NullString
+	var splits string
 
 	err := row.Scan(
 		&transaction.ID,
@@ -219,17 +633,32 @@ func (r *TransactionRepository) scanTransaction(row *sql.Row) (*domain.Transacti
 		&transaction.Type,
 		&categoryID,
 		&categoryName,
+		&payeeID,
+		&payeeName,
+		&transaction.Transfer,
+		&transaction.Status,
+		&deletedAt,
+		&tags,
+		&splits,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to scan transaction: %w", err)
 	}
 
-	parsedDate, err := time.Parse(time.RFC3339, dateStr)
+	parsedDate, err := parseTime(dateStr)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse date: %w", err)
 	}
 	transaction.Date = parsedDate
 
+	if deletedAt.Valid {
+		deleted, err := parseTime(deletedAt.String)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse deletion time: %w", err)
+		}
+		transaction.DeletedAt = &deleted
+	}
+
 	if categoryID.Valid && categoryName.Valid {
 		transaction.Category = &domain.Category{
 			ID:   int(categoryID.Int64),
@@ -237,72 +666,217 @@ func (r *TransactionRepository) scanTransaction(row *sql.Row) (*domain.Transacti
 		}
 	}
 
+	if payeeID.Valid && payeeName.Valid {
+		transaction.Payee = &domain.Payee{
+			ID:   int(payeeID.Int64),
+			Name: payeeName.String,
+		}
+	}
+
+	if tags.Valid && tags.String != "" {
+		transaction.Tags = domain.NormalizeTags(strings.Split(tags.String, ","))
+	}
+
+	var lines []splitRow
+	if err := json.Unmarshal([]byte(splits), &lines); err != nil {
+		return nil, fmt.Errorf("failed to parse transaction splits: %w", err)
+	}
+	for _, line := range lines {
+		transaction.Splits = append(transaction.Splits, &domain.Split{
+			ID:       line.ID,
+			Category: &domain.Category{ID: line.CategoryID, Name: line.Category},
+			Amount:   line.Amount,
+			Memo:     line.Memo,
+		})
+	}
+
 	return &transaction, nil
 }
 
-func (r *TransactionRepository) scanTransactions(rows *sql.Rows) ([]*domain.Transaction, error) {
-	var transactions []*domain.Transaction
+// payeeID returns the payee column value of a transaction, NULL when it has no payee
+func payeeID(transaction *domain.Transaction) interface{} {
+	if transaction.Payee == nil {
+		return nil
+	}
+	return transaction.Payee.ID
+}
 
-	for rows.Next() {
-		var transaction domain.Transaction
-		var dateStr string
-		var categoryID sql.NullInt64
-		var categoryName sql.NullString
+// writeTags replaces the tags stored for a transaction
+func writeTags(ctx context.Context, tx *sql.Tx, transactionID int, tags []string) error {
+	if _, err := tx.ExecContext(ctx, `DELETE FROM transaction_tags WHERE transaction_id = ?`, transactionID); err != nil {
+		return fmt.Errorf("failed to clear transaction tags: %w", err)
+	}
 
-		err := rows.Scan(
-			&transaction.ID,
-			&transaction.Description,
-			&transaction.Amount,
-			&dateStr,
-			&transaction.Type,
-			&categoryID,
-			&categoryName,
-		)
-		if err != nil {
-			return nil, fmt.Errorf("failed to scan transaction: %w", err)
+	for _, tag := range domain.NormalizeTags(tags) {
+		if _, err := tx.ExecContext(ctx, `INSERT INTO transaction_tags (transaction_id, tag) VALUES (?, ?)`, transactionID, tag); err != nil {
+			return fmt.Errorf("failed to save transaction tag: %w", err)
 		}
+	}
 
-		parsedDate, err := time.Parse(time.RFC3339, dateStr)
+	return nil
+}
+
+// writeSplits replaces the split lines stored for a transaction and sets their IDs
+func writeSplits(ctx context.Context, tx *sql.Tx, transactionID int, splits []*domain.Split) error {
+	if _, err := tx.ExecContext(ctx, `DELETE FROM transaction_splits WHERE transaction_id = ?`, transactionID); err != nil {
+		return fmt.Errorf("failed to clear transaction splits: %w", err)
+	}
+
+	for _, split := range splits {
+		result, err := tx.ExecContext(ctx, `INSERT INTO transaction_splits (transaction_id, category_id, amount, memo) VALUES (?, ?, ?, ?)`,
+			transactionID, split.Category.ID, split.Amount, split.Memo)
 		if err != nil {
-			return nil, fmt.Errorf("failed to parse date: %w", err)
+			return fmt.Errorf("failed to save transaction split: %w", err)
+		}
+		id, err := result.LastInsertId()
+		if err != nil {
+			return fmt.Errorf("failed to get last insert id: %w", err)
 		}
-		transaction.Date = parsedDate
+		split.ID = int(id)
+	}
 
-		if categoryID.Valid && categoryName.Valid {
-			transaction.Category = &domain.Category{
-				ID:   int(categoryID.Int64),
-				Name: categoryName.String,
-			}
+	return nil
+}
+
+// existingIDs filters ids down to the transactions that are stored and not in the trash
+func existingIDs(ctx context.Context, tx *sql.Tx, ids []int) ([]int, error) {
+	rows, err := tx.QueryContext(ctx, `SELECT id FROM transactions WHERE deleted_at IS NULL AND id IN (`+placeholders(len(ids))+`)`, intArgs(ids)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up transactions: %w", err)
+	}
+	defer rows.Close()
+
+	var existing []int
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan transaction id: %w", err)
 		}
+		existing = append(existing, id)
+	}
 
-		transactions = append(transactions, &transaction)
+	return existing, rows.Err()
+}
+
+// refuseReconciled returns domain.ErrReconciled naming the first of the transactions that is reconciled
+func refuseReconciled(ctx context.Context, tx *sql.Tx, ids []int) error {
+	args := append([]interface{}{domain.StatusReconciled}, intArgs(ids)...)
+	var id int
+	err := tx.QueryRowContext(ctx, `SELECT id FROM transactions WHERE status = ? AND id IN (`+placeholders(len(ids))+`) ORDER BY id LIMIT 1`, args...).Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to check transaction status: %w", err)
+	}
+	return fmt.Errorf("transaction %d: %w", id, domain.ErrReconciled)
+}
+
+// snapshot loads the given transactions inside tx for the audit log, keyed by ID. Trashed
+// transactions are missing, so moving one to the trash is logged as a delete and
+// restoring it as a create.
+func (r *TransactionRepository) snapshot(ctx context.Context, tx *sql.Tx, ids []int) (map[int]*domain.Transaction, error) {
+	snapshot := map[int]*domain.Transaction{}
+	if len(ids) == 0 {
+		return snapshot, nil
 	}
 
-	return transactions, nil
+	query := `
+		SELECT ` + transactionColumns + `
+		FROM transactions t
+		LEFT JOIN categories c ON t.category_id = c.id
+		WHERE t.id IN (` + placeholders(len(ids)) + `) AND t.deleted_at IS NULL
+	`
+
+	rows, err := tx.QueryContext(ctx, query, intArgs(ids)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to snapshot transactions: %w", err)
+	}
+	defer rows.Close()
+
+	transactions, err := r.scanTransactions(rows)
+	if err != nil {
+		return nil, err
+	}
+	for _, transaction := range transactions {
+		snapshot[transaction.ID] = transaction
+	}
+	return snapshot, nil
+}
+
+// audited runs change inside tx and records in the audit log what it did to each of
+// the given transactions, so the log commits or rolls back together with the change
+func (r *TransactionRepository) audited(ctx context.Context, tx *sql.Tx, ids []int, change func() error) error {
+	before, err := r.snapshot(ctx, tx, ids)
+	if err != nil {
+		return err
+	}
+	if err := change(); err != nil {
+		return err
+	}
+	after, err := r.snapshot(ctx, tx, ids)
+	if err != nil {
+		return err
+	}
+
+	seen := map[int]bool{}
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		if err := r.db.audit(ctx, tx, domain.AuditTransaction, id, before[id], after[id]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// placeholders returns "?, ?, ..." with n parameters for IN clauses
+func placeholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?, ", n), ", ")
+}
+
+func intArgs(values []int) []interface{} {
+	args := make([]interface{}, len(values))
+	for i, v := range values {
+		args[i] = v
+	}
+	return args
 }
 
-// GetCategoryTotalsByDateRange returns category breakdowns for the given date range and transaction type
+// GetCategoryTotalsByDateRange returns category breakdowns for the given date range and transaction type.
+// Split transactions count towards the category of each line with that line's amount.
 func (r *TransactionRepository) GetCategoryTotalsByDateRange(ctx context.Context, start, end time.Time, transactionType string) ([]*domain.CategoryBreakdown, error) {
 	query := `
+		WITH lines AS (
+			SELECT t.id AS transaction_id, t.category_id, t.amount
+			FROM transactions t
+			WHERE t.day BETWEEN ? AND ? AND t.type = ? AND t.transfer = 0 AND t.deleted_at IS NULL
+				AND NOT EXISTS (SELECT 1 FROM transaction_splits s WHERE s.transaction_id = t.id)
+			UNION ALL
+			SELECT t.id, s.category_id, s.amount
+			FROM transactions t
+			JOIN transaction_splits s ON s.transaction_id = t.id
+			WHERE t.day BETWEEN ? AND ? AND t.type = ? AND t.transfer = 0 AND t.deleted_at IS NULL
+		)
 		SELECT 
 			c.id, 
 			c.name, 
-			COALESCE(SUM(t.amount), 0) as total_amount,
-			COUNT(t.id) as transaction_count
+			COALESCE(SUM(l.amount), 0) as total_amount,
+			COUNT(DISTINCT l.transaction_id) as transaction_count
 		FROM categories c
-		LEFT JOIN transactions t ON c.id = t.category_id 
-			AND t.date BETWEEN ? AND ? 
-			AND t.type = ?
+		LEFT JOIN lines l ON c.id = l.category_id
 		WHERE c.type = ?
 		GROUP BY c.id, c.name
 		HAVING total_amount > 0
 		ORDER BY total_amount DESC
 	`
 
-	rows, err := r.db.DB().QueryContext(ctx, query, 
-		start.Format(time.RFC3339), 
-		end.Format(time.RFC3339), 
-		transactionType,
+	startStr, endStr := dayRange(start, end)
+	rows, err := r.db.conn().QueryContext(ctx, query, 
+		startStr, endStr, transactionType,
+		startStr, endStr, transactionType,
 		transactionType,
 	)
 	if err != nil {
@@ -347,27 +921,28 @@ func (r *TransactionRepository) GetCategoryTotalsByDateRange(ctx context.Context
 func (r *TransactionRepository) GetTransactionCountByDateRange(ctx context.Context, start, end time.Time, transactionType string) (int, error) {
 	var query string
 	var args []interface{}
+	startDay, endDay := dayRange(start, end)
 
 	if transactionType == "" {
 		// Count all transactions regardless of type
 		query = `
 			SELECT COUNT(*)
 			FROM transactions
-			WHERE date BETWEEN ? AND ?
+			WHERE day BETWEEN ? AND ? AND transfer = 0 AND deleted_at IS NULL
 		`
-		args = []interface{}{start.Format(time.RFC3339), end.Format(time.RFC3339)}
+		args = []interface{}{startDay, endDay}
 	} else {
 		// Count transactions of specific type
 		query = `
 			SELECT COUNT(*)
 			FROM transactions
-			WHERE date BETWEEN ? AND ? AND type = ?
+			WHERE day BETWEEN ? AND ? AND type = ? AND transfer = 0 AND deleted_at IS NULL
 		`
-		args = []interface{}{start.Format(time.RFC3339), end.Format(time.RFC3339), transactionType}
+		args = []interface{}{startDay, endDay, transactionType}
 	}
 
 	var count int
-	err := r.db.DB().QueryRowContext(ctx, query, args...).Scan(&count)
+	err := r.db.conn().QueryRowContext(ctx, query, args...).Scan(&count)
 	if err != nil {
 		return 0, fmt.Errorf("failed to get transaction count by date range: %w", err)
 	}
@@ -375,20 +950,27 @@ func (r *TransactionRepository) GetTransactionCountByDateRange(ctx context.Conte
 	return count, nil
 }
 
-// GetCategoryTransactionCount returns the count of transactions for a specific category, date range, and type
+// GetCategoryTransactionCount returns the count of transactions for a specific category, date range, and type,
+// counting split transactions with a line in the category
 func (r *TransactionRepository) GetCategoryTransactionCount(ctx context.Context, start, end time.Time, categoryID int, transactionType string) (int, error) {
 	query := `
 		SELECT COUNT(*)
-		FROM transactions
-		WHERE date BETWEEN ? AND ? AND category_id = ? AND type = ?
+		FROM transactions t
+		WHERE t.day BETWEEN ? AND ? AND t.type = ? AND t.transfer = 0 AND t.deleted_at IS NULL
+			AND CASE WHEN EXISTS (SELECT 1 FROM transaction_splits s WHERE s.transaction_id = t.id)
+				THEN EXISTS (SELECT 1 FROM transaction_splits s WHERE s.transaction_id = t.id AND s.category_id = ?)
+				ELSE t.category_id = ?
+			END
 	`
 
+	startDay, endDay := dayRange(start, end)
 	var count int
-	err := r.db.DB().QueryRowContext(ctx, query, 
-		start.Format(time.RFC3339), 
-		end.Format(time.RFC3339), 
-		categoryID, 
+	err := r.db.conn().QueryRowContext(ctx, query, 
+		startDay, 
+		endDay, 
 		transactionType,
+		categoryID, 
+		categoryID, 
 	).Scan(&count)
 	if err != nil {
 		return 0, fmt.Errorf("failed to get category transaction count: %w", err)
@@ -396,3 +978,597 @@ func (r *TransactionRepository) GetCategoryTransactionCount(ctx context.Context,
 
 	return count, nil
 }
+
+// GetDescriptionSuggestions returns the distinct descriptions of the given type in which a
+// word starts with prefix, each with its use count, last use, last amount and the category
+// used most often with it. At most limit descriptions are returned, most used first.
+func (r *TransactionRepository) GetDescriptionSuggestions(ctx context.Context, prefix, transactionType string, limit int) ([]*domain.DescriptionSuggestion, error) {
+	query := `
+		SELECT
+			s.description,
+			s.uses,
+			s.last_used,
+			(SELECT amount FROM transactions
+				WHERE description = s.description AND type = ? AND deleted_at IS NULL
+				ORDER BY date DESC, id DESC LIMIT 1),
+			c.id,
+			c.name
+		FROM (
+			SELECT description, COUNT(*) AS uses, MAX(date) AS last_used
+			FROM transactions
+			WHERE type = ? AND deleted_at IS NULL AND (description LIKE ? ESCAPE '\' OR description LIKE ? ESCAPE '\')
+			GROUP BY description
+		) s
+		LEFT JOIN categories c ON c.id = (
+			SELECT category_id FROM transactions
+			WHERE description = s.description AND type = ? AND category_id IS NOT NULL AND deleted_at IS NULL
+			GROUP BY category_id
+			ORDER BY COUNT(*) DESC, MAX(date) DESC
+			LIMIT 1
+		)
+		ORDER BY s.uses DESC, s.last_used DESC
+		LIMIT ?
+	`
+
+	escaped := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(prefix)
+	rows, err := r.db.conn().QueryContext(ctx, query,
+		transactionType,
+		transactionType,
+		escaped+"%",
+		"% "+escaped+"%",
+		transactionType,
+		limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get description suggestions: %w", err)
+	}
+	defer rows.Close()
+
+	var suggestions []*domain.DescriptionSuggestion
+	for rows.Next() {
+		var suggestion domain.DescriptionSuggestion
+		var lastUsed string
+		var categoryID sql.NullInt64
+		var categoryName sql.NullString
+
+		err := rows.Scan(&suggestion.Description, &suggestion.UseCount, &lastUsed, &suggestion.LastAmount, &categoryID, &categoryName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan description suggestion: %w", err)
+		}
+
+		suggestion.LastUsed, err = parseTime(lastUsed)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse date: %w", err)
+		}
+		if categoryID.Valid && categoryName.Valid {
+			suggestion.Category = &domain.Category{
+				ID:   int(categoryID.Int64),
+				Name: categoryName.String,
+			}
+		}
+
+		suggestions = append(suggestions, &suggestion)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate description suggestion rows: %w", err)
+	}
+
+	return suggestions, nil
+}
+
+// GetRecentCategoryIDs returns the IDs of the categories most recently used by transactions
+// of the given type, most recent first
+func (r *TransactionRepository) GetRecentCategoryIDs(ctx context.Context, transactionType string, limit int) ([]int, error) {
+	query := `
+		SELECT category_id
+		FROM transactions
+		WHERE type = ? AND category_id IS NOT NULL AND deleted_at IS NULL
+		GROUP BY category_id
+		ORDER BY MAX(date) DESC, MAX(id) DESC
+		LIMIT ?
+	`
+
+	rows, err := r.db.conn().QueryContext(ctx, query, transactionType, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get recent categories: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []int
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan category id: %w", err)
+		}
+		ids = append(ids, id)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate recent category rows: %w", err)
+	}
+
+	return ids, nil
+}
+
+// GetCategoryHistory returns the description, amount, type and category of every
+// categorized transaction, oldest first, for learning how the user files transactions
+func (r *TransactionRepository) GetCategoryHistory(ctx context.Context) ([]*domain.Transaction, error) {
+	query := `
+		SELECT t.id, t.description, t.amount, t.type, c.id, c.name
+		FROM transactions t
+		JOIN categories c ON c.id = t.category_id
+		WHERE t.deleted_at IS NULL
+		ORDER BY t.id
+	`
+
+	rows, err := r.db.conn().QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get category history: %w", err)
+	}
+	defer rows.Close()
+
+	var transactions []*domain.Transaction
+	for rows.Next() {
+		transaction := &domain.Transaction{Category: &domain.Category{}}
+		err := rows.Scan(
+			&transaction.ID,
+			&transaction.Description,
+			&transaction.Amount,
+			&transaction.Type,
+			&transaction.Category.ID,
+			&transaction.Category.Name,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan category history: %w", err)
+		}
+		transactions = append(transactions, transaction)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate category history rows: %w", err)
+	}
+
+	return transactions, nil
+}
+
+// GetPayeeTotalsByDateRange returns the total and count of transactions per payee for the
+// given date range and type, largest first. Transactions without a payee form one
+// breakdown with a nil payee.
+func (r *TransactionRepository) GetPayeeTotalsByDateRange(ctx context.Context, start, end time.Time, transactionType string) ([]*domain.PayeeBreakdown, error) {
+	query := `
+		SELECT p.id, p.name, SUM(t.amount) AS total_amount, COUNT(*)
+		FROM transactions t
+		LEFT JOIN payees p ON p.id = t.payee_id
+		WHERE t.day BETWEEN ? AND ? AND t.type = ? AND t.transfer = 0 AND t.deleted_at IS NULL
+		GROUP BY p.id, p.name
+		ORDER BY total_amount DESC
+	`
+
+	startDay, endDay := dayRange(start, end)
+	rows, err := r.db.conn().QueryContext(ctx, query, startDay, endDay, transactionType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get payee totals by date range: %w", err)
+	}
+	defer rows.Close()
+
+	var breakdowns []*domain.PayeeBreakdown
+	for rows.Next() {
+		var payeeID sql.NullInt64
+		var payeeName sql.NullString
+		var breakdown domain.PayeeBreakdown
+
+		if err := rows.Scan(&payeeID, &payeeName, &breakdown.TotalAmount, &breakdown.TransactionCount); err != nil {
+			return nil, fmt.Errorf("failed to scan payee breakdown: %w", err)
+		}
+		if payeeID.Valid {
+			breakdown.Payee = &domain.Payee{ID: int(payeeID.Int64), Name: payeeName.String}
+		}
+		breakdowns = append(breakdowns, &breakdown)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate payee breakdown rows: %w", err)
+	}
+
+	return breakdowns, nil
+}
+
+// GetDescriptionsWithoutPayee returns the distinct descriptions of transactions that have no payee yet
+func (r *TransactionRepository) GetDescriptionsWithoutPayee(ctx context.Context) ([]string, error) {
+	rows, err := r.db.conn().QueryContext(ctx, `SELECT DISTINCT description FROM transactions WHERE payee_id IS NULL AND deleted_at IS NULL ORDER BY description`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get descriptions without payee: %w", err)
+	}
+	defer rows.Close()
+
+	var descriptions []string
+	for rows.Next() {
+		var description string
+		if err := rows.Scan(&description); err != nil {
+			return nil, fmt.Errorf("failed to scan description: %w", err)
+		}
+		descriptions = append(descriptions, description)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate description rows: %w", err)
+	}
+
+	return descriptions, nil
+}
+
+// AssignPayee sets the payee of every transaction with exactly this description that has
+// no payee yet and returns how many were updated
+func (r *TransactionRepository) AssignPayee(ctx context.Context, description string, payeeID int) (int, error) {
+	var ids []int
+	err := r.db.withTx(ctx, func(tx *sql.Tx) error {
+		rows, err := tx.QueryContext(ctx, `SELECT id FROM transactions WHERE description = ? AND payee_id IS NULL AND deleted_at IS NULL`, description)
+		if err != nil {
+			return fmt.Errorf("failed to look up transactions: %w", err)
+		}
+		for rows.Next() {
+			var id int
+			if err := rows.Scan(&id); err != nil {
+				rows.Close()
+				return fmt.Errorf("failed to scan transaction id: %w", err)
+			}
+			ids = append(ids, id)
+		}
+		if err := rows.Err(); err != nil {
+			rows.Close()
+			return fmt.Errorf("failed to iterate transaction ids: %w", err)
+		}
+		rows.Close()
+		if len(ids) == 0 {
+			return nil
+		}
+
+		return r.audited(ctx, tx, ids, func() error {
+			args := append([]interface{}{payeeID}, intArgs(ids)...)
+			if _, err := tx.ExecContext(ctx, `UPDATE transactions SET payee_id = ? WHERE id IN (`+placeholders(len(ids))+`)`, args...); err != nil {
+				return fmt.Errorf("failed to assign payee: %w", err)
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return 0, err
+	}
+	return len(ids), nil
+}
+
+// GetDismissedDuplicates returns the pairs of transactions marked as not duplicates
+func (r *TransactionRepository) GetDismissedDuplicates(ctx context.Context) ([]domain.DuplicateKey, error) {
+	rows, err := r.db.conn().QueryContext(ctx, `SELECT first_id, second_id FROM duplicate_dismissals ORDER BY first_id, second_id`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get dismissed duplicates: %w", err)
+	}
+	defer rows.Close()
+
+	var keys []domain.DuplicateKey
+	for rows.Next() {
+		var key domain.DuplicateKey
+		if err := rows.Scan(&key.First, &key.Second); err != nil {
+			return nil, fmt.Errorf("failed to scan dismissed duplicate: %w", err)
+		}
+		keys = append(keys, key)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate dismissed duplicate rows: %w", err)
+	}
+
+	return keys, nil
+}
+
+// DismissDuplicate records that two transactions are not duplicates of each other
+func (r *TransactionRepository) DismissDuplicate(ctx context.Context, key domain.DuplicateKey) error {
+	key = domain.NewDuplicateKey(key.First, key.Second)
+	if key.First == key.Second {
+		return fmt.Errorf("a transaction cannot be a duplicate of itself")
+	}
+	if _, err := r.db.conn().ExecContext(ctx, `INSERT OR IGNORE INTO duplicate_dismissals (first_id, second_id) VALUES (?, ?)`, key.First, key.Second); err != nil {
+		return fmt.Errorf("failed to dismiss duplicate: %w", err)
+	}
+	return nil
+}
+
+// MergeDuplicate saves the merged transaction and moves the duplicate to the trash in a single
+// SQL transaction. Like Update and Delete it refuses reconciled transactions.
+func (r *TransactionRepository) MergeDuplicate(ctx context.Context, merged *domain.Transaction, duplicateID int) error {
+	var categoryID interface{}
+	if merged.Category != nil {
+		categoryID = merged.Category.ID
+	}
+
+	return r.db.withTx(ctx, func(tx *sql.Tx) error {
+		if err := refuseReconciled(ctx, tx, []int{merged.ID, duplicateID}); err != nil {
+			return err
+		}
+
+		return r.audited(ctx, tx, []int{merged.ID, duplicateID}, func() error {
+			result, err := tx.ExecContext(ctx, `UPDATE transactions SET deleted_at = ? WHERE id = ? AND deleted_at IS NULL`, formatTime(time.Now()), duplicateID)
+			if err != nil {
+				return fmt.Errorf("failed to delete duplicate: %w", err)
+			}
+			if affected, err := result.RowsAffected(); err != nil {
+				return fmt.Errorf("failed to get affected rows: %w", err)
+			} else if affected == 0 {
+				return fmt.Errorf("duplicate transaction %d not found", duplicateID)
+			}
+
+			result, err = tx.ExecContext(ctx, `
+				UPDATE transactions
+				SET description = ?, amount = ?, date = ?, day = ?, type = ?, category_id = ?, payee_id = ?, transfer = ?
+				WHERE id = ? AND deleted_at IS NULL
+			`,
+				merged.Description,
+				merged.Amount,
+				formatTime(merged.Date),
+				domain.FormatDay(merged.Date),
+				merged.Type,
+				categoryID,
+				payeeID(merged),
+				merged.Transfer,
+				merged.ID,
+			)
+			if err != nil {
+				return fmt.Errorf("failed to update merged transaction: %w", err)
+			}
+			if affected, err := result.RowsAffected(); err != nil {
+				return fmt.Errorf("failed to get affected rows: %w", err)
+			} else if affected == 0 {
+				return fmt.Errorf("transaction %d not found", merged.ID)
+			}
+
+			if err := writeTags(ctx, tx, merged.ID, merged.Tags); err != nil {
+				return err
+			}
+			return writeSplits(ctx, tx, merged.ID, merged.Splits)
+		})
+	})
+}
+
+// GetUnreconciled returns the transactions that are not reconciled yet and dated up to end, oldest first
+func (r *TransactionRepository) GetUnreconciled(ctx context.Context, end time.Time) ([]*domain.Transaction, error) {
+	query := `
+		SELECT ` + transactionColumns + `
+		FROM transactions t
+		LEFT JOIN categories c ON t.category_id = c.id
+		WHERE t.status != ? AND t.day <= ? AND t.deleted_at IS NULL
+		ORDER BY t.date, t.id
+	`
+
+	rows, err := r.db.conn().QueryContext(ctx, query, domain.StatusReconciled, domain.FormatDay(end))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get unreconciled transactions: %w", err)
+	}
+	defer rows.Close()
+
+	return r.scanTransactions(rows)
+}
+
+// SetStatus marks transactions as uncleared or cleared and returns how many rows changed.
+// Setting reconciled transactions back to cleared is how they are unlocked; only Reconcile
+// can lock them.
+func (r *TransactionRepository) SetStatus(ctx context.Context, ids []int, status domain.TransactionStatus) (int, error) {
+	if status == domain.StatusReconciled {
+		return 0, fmt.Errorf("transactions can only be reconciled together with a statement")
+	}
+	if err := status.Validate(); err != nil {
+		return 0, err
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	var affected int64
+	err := r.db.withTx(ctx, func(tx *sql.Tx) error {
+		return r.audited(ctx, tx, ids, func() error {
+			args := append([]interface{}{status}, intArgs(ids)...)
+			result, err := tx.ExecContext(ctx, `UPDATE transactions SET status = ? WHERE deleted_at IS NULL AND id IN (`+placeholders(len(ids))+`)`, args...)
+			if err != nil {
+				return fmt.Errorf("failed to set transaction status: %w", err)
+			}
+			affected, err = result.RowsAffected()
+			if err != nil {
+				return fmt.Errorf("failed to get affected rows: %w", err)
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return 0, err
+	}
+	return int(affected), nil
+}
+
+// GetLastStatement returns the most recent finished reconciliation, nil when there is none
+func (r *TransactionRepository) GetLastStatement(ctx context.Context) (*domain.Statement, error) {
+	var statement domain.Statement
+	var dateStr, reconciledAtStr string
+	err := r.db.conn().QueryRowContext(ctx, `
+		SELECT id, date, opening_balance, closing_balance, reconciled_at
+		FROM statements
+		ORDER BY date DESC, id DESC
+		LIMIT 1
+	`).Scan(&statement.ID, &dateStr, &statement.OpeningBalance, &statement.ClosingBalance, &reconciledAtStr)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get last statement: %w", err)
+	}
+
+	if statement.Date, err = parseTime(dateStr); err != nil {
+		return nil, fmt.Errorf("failed to parse statement date: %w", err)
+	}
+	if statement.ReconciledAt, err = parseTime(reconciledAtStr); err != nil {
+		return nil, fmt.Errorf("failed to parse reconciliation time: %w", err)
+	}
+	return &statement, nil
+}
+
+// Reconcile records the statement and locks the given cleared transactions as reconciled
+// in a single SQL transaction. Every transaction has to be cleared.
+func (r *TransactionRepository) Reconcile(ctx context.Context, statement *domain.Statement, ids []int) error {
+	if err := statement.Validate(); err != nil {
+		return err
+	}
+
+	var id int64
+	err := r.db.withTx(ctx, func(tx *sql.Tx) error {
+		if len(ids) > 0 {
+			err := r.audited(ctx, tx, ids, func() error {
+				args := append([]interface{}{domain.StatusReconciled, domain.StatusCleared}, intArgs(ids)...)
+				result, err := tx.ExecContext(ctx, `UPDATE transactions SET status = ? WHERE status = ? AND deleted_at IS NULL AND id IN (`+placeholders(len(ids))+`)`, args...)
+				if err != nil {
+					return fmt.Errorf("failed to reconcile transactions: %w", err)
+				}
+				if affected, err := result.RowsAffected(); err != nil {
+					return fmt.Errorf("failed to get affected rows: %w", err)
+				} else if int(affected) != len(ids) {
+					return fmt.Errorf("only %d of %d transactions are cleared", affected, len(ids))
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		result, err := tx.ExecContext(ctx, `
+			INSERT INTO statements (date, opening_balance, closing_balance, reconciled_at)
+			VALUES (?, ?, ?, ?)
+		`,
+			formatTime(statement.Date),
+			statement.OpeningBalance,
+			statement.ClosingBalance,
+			formatTime(statement.ReconciledAt),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to create statement: %w", err)
+		}
+		id, err = result.LastInsertId()
+		if err != nil {
+			return fmt.Errorf("failed to get last insert id: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	statement.ID = int(id)
+	return nil
+}
+
+// GetTrash returns the transactions in the trash, most recently deleted first
+func (r *TransactionRepository) GetTrash(ctx context.Context) ([]*domain.Transaction, error) {
+	query := `
+		SELECT ` + transactionColumns + `
+		FROM transactions t
+		LEFT JOIN categories c ON t.category_id = c.id
+		WHERE t.deleted_at IS NOT NULL
+		ORDER BY t.deleted_at DESC, t.id DESC
+	`
+
+	rows, err := r.db.conn().QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get trash: %w", err)
+	}
+	defer rows.Close()
+
+	return r.scanTransactions(rows)
+}
+
+// RestoreDeleted takes the given transactions out of the trash and returns how many were restored
+func (r *TransactionRepository) RestoreDeleted(ctx context.Context, ids []int) (int, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	var affected int64
+	err := r.db.withTx(ctx, func(tx *sql.Tx) error {
+		return r.audited(ctx, tx, ids, func() error {
+			result, err := tx.ExecContext(ctx, `UPDATE transactions SET deleted_at = NULL WHERE deleted_at IS NOT NULL AND id IN (`+placeholders(len(ids))+`)`, intArgs(ids)...)
+			if err != nil {
+				return fmt.Errorf("failed to restore transactions: %w", err)
+			}
+			affected, err = result.RowsAffected()
+			return err
+		})
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return int(affected), nil
+}
+
+// Purge permanently removes the given transactions from the trash along with their tags
+// and splits, and returns how many were removed. Transactions not in the trash are left alone.
+func (r *TransactionRepository) Purge(ctx context.Context, ids []int) (int, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	purged, err := r.purge(ctx, `id IN (`+placeholders(len(ids))+`)`, intArgs(ids)...)
+	return len(purged), err
+}
+
+// PurgeDeletedBefore permanently removes the transactions moved to the trash before the
+// given time and returns the IDs of the ones removed
+func (r *TransactionRepository) PurgeDeletedBefore(ctx context.Context, before time.Time) ([]int, error) {
+	return r.purge(ctx, `deleted_at < ?`, formatTime(before))
+}
+
+// purge removes the trashed transactions matching condition in a single SQL transaction
+// and returns their IDs. The audit log already recorded them as deleted when they were
+// moved to the trash.
+func (r *TransactionRepository) purge(ctx context.Context, condition string, args ...interface{}) ([]int, error) {
+	var ids []int
+	err := r.db.withTx(ctx, func(tx *sql.Tx) error {
+		rows, err := tx.QueryContext(ctx, `SELECT id FROM transactions WHERE deleted_at IS NOT NULL AND `+condition, args...)
+		if err != nil {
+			return fmt.Errorf("failed to look up trashed transactions: %w", err)
+		}
+		for rows.Next() {
+			var id int
+			if err := rows.Scan(&id); err != nil {
+				rows.Close()
+				return fmt.Errorf("failed to scan transaction id: %w", err)
+			}
+			ids = append(ids, id)
+		}
+		if err := rows.Err(); err != nil {
+			rows.Close()
+			return fmt.Errorf("failed to iterate transaction ids: %w", err)
+		}
+		rows.Close()
+		if len(ids) == 0 {
+			return nil
+		}
+
+		idArgs := intArgs(ids)
+		if _, err := tx.ExecContext(ctx, `DELETE FROM transaction_tags WHERE transaction_id IN (`+placeholders(len(ids))+`)`, idArgs...); err != nil {
+			return fmt.Errorf("failed to purge transaction tags: %w", err)
+		}
+		if _, err := tx.ExecContext(ctx, `DELETE FROM transaction_splits WHERE transaction_id IN (`+placeholders(len(ids))+`)`, idArgs...); err != nil {
+			return fmt.Errorf("failed to purge transaction splits: %w", err)
+		}
+		if _, err := tx.ExecContext(ctx, `DELETE FROM transactions WHERE id IN (`+placeholders(len(ids))+`)`, idArgs...); err != nil {
+			return fmt.Errorf("failed to purge transactions: %w", err)
+		}
+		dismissalArgs := append(append([]interface{}{}, idArgs...), idArgs...)
+		if _, err := tx.ExecContext(ctx, `DELETE FROM duplicate_dismissals WHERE first_id IN (`+placeholders(len(ids))+`) OR second_id IN (`+placeholders(len(ids))+`)`, dismissalArgs...); err != nil {
+			return fmt.Errorf("failed to purge duplicate dismissals: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
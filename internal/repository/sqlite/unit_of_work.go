@@ -0,0 +1,32 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+
+	"expense-tracker/internal/core/usecase"
+)
+
+// UnitOfWork hands use cases repositories that share one SQL transaction
+type UnitOfWork struct {
+	db *Database
+}
+
+func NewUnitOfWork(db *Database) *UnitOfWork {
+	return &UnitOfWork{db: db}
+}
+
+// WithTx runs fn with repositories bound to a new SQL transaction, committing when fn returns
+// nil and rolling back when it returns an error or panics. The repositories' own multi-statement
+// writes join that transaction instead of starting another one.
+func (u *UnitOfWork) WithTx(ctx context.Context, fn func(repos usecase.Repositories) error) error {
+	return u.db.withTx(ctx, func(tx *sql.Tx) error {
+		bound := u.db.bind(tx)
+		return fn(usecase.Repositories{
+			Transactions: NewTransactionRepository(bound),
+			Categories:   NewCategoryRepository(bound),
+			Payees:       NewPayeeRepository(bound),
+			Rules:        NewRuleRepository(bound),
+		})
+	})
+}
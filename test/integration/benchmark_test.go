@@ -0,0 +1,121 @@
+package integration
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"path/filepath"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+
+	"expense-tracker/internal/core/domain"
+	"expense-tracker/internal/core/usecase"
+	"expense-tracker/internal/repository/sqlite"
+)
+
+// benchmarkTransactions is how many synthetic transactions the query benchmarks run against,
+// spread over three years
+const benchmarkTransactions = 100_000
+
+var benchmarkDescriptions = []string{"Coffee Shop", "Supermarket", "Gas Station", "Pharmacy", "Restaurant", "Cinema", "Electricity", "Bookstore", "Train Ticket", "Bakery"}
+
+// seedBenchmarkDatabase fills a new database with synthetic transactions in one unit of work
+func seedBenchmarkDatabase(b *testing.B) *sqlite.Database {
+	ctx := context.Background()
+	db, err := sqlite.NewDatabase(filepath.Join(b.TempDir(), "benchmark_expense_tracker.db"))
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.Cleanup(func() { db.Close() })
+
+	categoryRepo := sqlite.NewCategoryRepository(db)
+	categories := map[string][]*domain.Category{}
+	for _, transactionType := range []string{"income", "expense"} {
+		if categories[transactionType], err = categoryRepo.GetCategories(ctx, transactionType); err != nil {
+			b.Fatal(err)
+		}
+	}
+
+	random := rand.New(rand.NewSource(1))
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	err = sqlite.NewUnitOfWork(db).WithTx(ctx, func(repos usecase.Repositories) error {
+		for i := 0; i < benchmarkTransactions; i++ {
+			transactionType := "expense"
+			if i%10 == 0 {
+				transactionType = "income"
+			}
+			options := categories[transactionType]
+			transaction := &domain.Transaction{
+				Description: fmt.Sprintf("%s #%d", benchmarkDescriptions[random.Intn(len(benchmarkDescriptions))], i),
+				Amount:      float64(random.Intn(20000)) / 100,
+				Date:        start.AddDate(0, 0, random.Intn(3*365)),
+				Type:        transactionType,
+				Category:    options[random.Intn(len(options))],
+			}
+			if err := repos.Transactions.Create(ctx, transaction); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		b.Fatal(err)
+	}
+	return db
+}
+
+// BenchmarkQueries times the summary and search queries against a database of
+// benchmarkTransactions transactions. Run it with: go test ./test/integration -run '^$' -bench Queries
+func BenchmarkQueries(b *testing.B) {
+	ctx := context.Background()
+	db := seedBenchmarkDatabase(b)
+	transactionRepo := sqlite.NewTransactionRepository(db)
+	summaryUseCase := usecase.NewSummaryUseCase(transactionRepo)
+
+	june := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	benchmarks := []struct {
+		name string
+		run  func() error
+	}{
+		{"MonthlySummary", func() error {
+			_, err := summaryUseCase.GetMonthlySummary(ctx, 2024, time.June)
+			return err
+		}},
+		{"MonthlySummaryWithBreakdowns", func() error {
+			_, err := summaryUseCase.GetSummaryByDateRange(ctx, june, june.AddDate(0, 1, 0).Add(-time.Nanosecond), domain.PeriodTypeMonth)
+			return err
+		}},
+		{"YearlySummary", func() error {
+			_, err := summaryUseCase.GetYearlySummary(ctx, 2024)
+			return err
+		}},
+		{"CategoryTotals", func() error {
+			_, err := transactionRepo.GetCategoryTotalsByDateRange(ctx, june, june.AddDate(0, 1, 0), "expense")
+			return err
+		}},
+		{"Search", func() error {
+			_, err := summaryUseCase.SearchTransactions(ctx, "coffee", 0, 50)
+			return err
+		}},
+		{"FirstPage", func() error {
+			_, err := summaryUseCase.GetTransactionPage(ctx, &domain.PageRequest{Limit: 50})
+			return err
+		}},
+		{"SearchPage", func() error {
+			_, err := summaryUseCase.GetTransactionPage(ctx, &domain.PageRequest{Query: "bakery", Limit: 50})
+			return err
+		}},
+	}
+
+	for _, benchmark := range benchmarks {
+		b.Run(benchmark.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if err := benchmark.run(); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package integration
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"expense-tracker/internal/core/domain"
+	"expense-tracker/internal/repository/sqlite"
+)
+
+type DatabaseIntegrationSuite struct {
+	suite.Suite
+	db     *sqlite.Database
+	ctx    context.Context
+	testDB string
+}
+
+func (suite *DatabaseIntegrationSuite) SetupSuite() {
+	suite.ctx = context.Background()
+
+	// Create a temporary database file for testing
+	tempDir := os.TempDir()
+	suite.testDB = filepath.Join(tempDir, "test_database_expense_tracker.db")
+
+	var err error
+	suite.db, err = sqlite.NewDatabase(suite.testDB)
+	suite.Require().NoError(err)
+}
+
+func (suite *DatabaseIntegrationSuite) TearDownSuite() {
+	if suite.db != nil {
+		suite.db.Close()
+	}
+	os.Remove(suite.testDB)
+}
+
+func TestDatabaseIntegrationSuite(t *testing.T) {
+	suite.Run(t, new(DatabaseIntegrationSuite))
+}
+
+func (suite *DatabaseIntegrationSuite) TestMigrationsAreRecorded() {
+	assert := assert.New(suite.T())
+
+	var version int
+	err := suite.db.DB().QueryRow("PRAGMA user_version").Scan(&version)
+	suite.Require().NoError(err)
+	assert.Equal(8, version)
+
+	// Opening the same file again must not reapply the migrations
+	reopened, err := sqlite.NewDatabase(suite.testDB)
+	suite.Require().NoError(err)
+	reopened.Close()
+}
+
+func (suite *DatabaseIntegrationSuite) TestNewerSchemaIsRejected() {
+	path := filepath.Join(os.TempDir(), "test_future_schema.db")
+	defer os.Remove(path)
+
+	raw, err := sql.Open("sqlite3", path)
+	suite.Require().NoError(err)
+	_, err = raw.Exec("PRAGMA user_version = 99")
+	suite.Require().NoError(err)
+	raw.Close()
+
+	_, err = sqlite.NewDatabase(path)
+	assert.ErrorContains(suite.T(), err, "database schema version 99 is newer")
+}
+
+func (suite *DatabaseIntegrationSuite) TestConnectionSettings() {
+	assert := assert.New(suite.T())
+
+	pragma := func(name string) string {
+		var value string
+		suite.Require().NoError(suite.db.DB().QueryRow("PRAGMA " + name).Scan(&value))
+		return value
+	}
+	assert.Equal("wal", pragma("journal_mode"))
+	assert.Equal("1", pragma("foreign_keys"))
+	assert.Equal("5000", pragma("busy_timeout"))
+	assert.Equal("1", pragma("synchronous"), "NORMAL")
+
+	err := sqlite.NewTransactionRepository(suite.db).Create(suite.ctx, &domain.Transaction{
+		Description: "Bakery",
+		Amount:      4.5,
+		Date:        time.Date(2024, 6, 10, 0, 0, 0, 0, time.UTC),
+		Type:        "expense",
+		Category:    &domain.Category{ID: 9999},
+	})
+	assert.ErrorContains(err, "FOREIGN KEY constraint failed")
+}
@@ -0,0 +1,145 @@
+package integration
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"expense-tracker/internal/core/domain"
+	"expense-tracker/internal/core/usecase"
+	"expense-tracker/internal/repository/sqlite"
+)
+
+type PayeeRepositoryIntegrationSuite struct {
+	suite.Suite
+	db              *sqlite.Database
+	repo            *sqlite.PayeeRepository
+	transactionRepo *sqlite.TransactionRepository
+	ctx             context.Context
+	testDB          string
+}
+
+func (suite *PayeeRepositoryIntegrationSuite) SetupSuite() {
+	suite.ctx = context.Background()
+
+	// Create a temporary database file for testing
+	tempDir := os.TempDir()
+	suite.testDB = filepath.Join(tempDir, "test_payee_expense_tracker.db")
+
+	var err error
+	suite.db, err = sqlite.NewDatabase(suite.testDB)
+	suite.Require().NoError(err)
+
+	suite.repo = sqlite.NewPayeeRepository(suite.db)
+	suite.transactionRepo = sqlite.NewTransactionRepository(suite.db)
+}
+
+func (suite *PayeeRepositoryIntegrationSuite) TearDownSuite() {
+	if suite.db != nil {
+		suite.db.Close()
+	}
+	os.Remove(suite.testDB)
+}
+
+func (suite *PayeeRepositoryIntegrationSuite) SetupTest() {
+	// Clean up test data before each test
+	suite.cleanupTestData()
+}
+
+func (suite *PayeeRepositoryIntegrationSuite) TearDownTest() {
+	// Clean up test data after each test
+	suite.cleanupTestData()
+}
+
+func (suite *PayeeRepositoryIntegrationSuite) cleanupTestData() {
+	for _, table := range []string{"transaction_tags", "transaction_splits", "transactions", "rules", "payee_aliases", "payees"} {
+		_, err := suite.db.DB().Exec("DELETE FROM " + table)
+		suite.Require().NoError(err)
+	}
+}
+
+func TestPayeeRepositoryIntegrationSuite(t *testing.T) {
+	suite.Run(t, new(PayeeRepositoryIntegrationSuite))
+}
+
+func (suite *PayeeRepositoryIntegrationSuite) TestCreateUpdateAndGet() {
+	assert := assert.New(suite.T())
+
+	amazon := &domain.Payee{Name: "Amazon", Aliases: []string{"AMZN  Mktp*", "amzn mktp*", "Amazon EU"}}
+	suite.Require().NoError(suite.repo.CreatePayee(suite.ctx, amazon))
+	assert.NotZero(amazon.ID)
+	assert.Equal([]string{"amzn mktp*", "amazon eu"}, amazon.Aliases)
+
+	netflix := &domain.Payee{Name: "Netflix"}
+	suite.Require().NoError(suite.repo.CreatePayee(suite.ctx, netflix))
+
+	assert.Error(suite.repo.CreatePayee(suite.ctx, &domain.Payee{Name: "NETFLIX"}), "names are unique regardless of case")
+
+	amazon.Aliases = []string{"amazon.de*"}
+	suite.Require().NoError(suite.repo.UpdatePayee(suite.ctx, amazon))
+
+	payees, err := suite.repo.GetPayees(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Len(payees, 2)
+	assert.Equal("Amazon", payees[0].Name)
+	assert.Equal([]string{"amazon.de*"}, payees[0].Aliases)
+	assert.Equal("Netflix", payees[1].Name)
+	assert.Empty(payees[1].Aliases)
+
+	assert.Error(suite.repo.UpdatePayee(suite.ctx, &domain.Payee{ID: 9999, Name: "Missing"}))
+}
+
+func (suite *PayeeRepositoryIntegrationSuite) TestNormalizeAndBreakdown() {
+	assert := assert.New(suite.T())
+
+	amazon := &domain.Payee{Name: "Amazon", Aliases: []string{"amzn mktp*"}}
+	suite.Require().NoError(suite.repo.CreatePayee(suite.ctx, amazon))
+
+	date := time.Date(2024, 6, 10, 12, 0, 0, 0, time.UTC)
+	for _, tx := range []*domain.Transaction{
+		{Description: "AMZN Mktp DE", Amount: 30, Type: "expense", Date: date},
+		{Description: "AMZN Mktp DE", Amount: 20, Type: "expense", Date: date},
+		{Description: "amazon", Amount: 10, Type: "expense", Date: date},
+		{Description: "Bakery", Amount: 5, Type: "expense", Date: date},
+	} {
+		suite.Require().NoError(suite.transactionRepo.Create(suite.ctx, tx))
+	}
+
+	payeeUseCase := usecase.NewPayeeUseCase(suite.repo, suite.transactionRepo, sqlite.NewUnitOfWork(suite.db))
+	updated, err := payeeUseCase.NormalizePayees(suite.ctx)
+	suite.Require().NoError(err)
+	assert.Equal(3, updated)
+
+	descriptions, err := suite.transactionRepo.GetDescriptionsWithoutPayee(suite.ctx)
+	suite.Require().NoError(err)
+	assert.Equal([]string{"Bakery"}, descriptions)
+
+	breakdowns, err := suite.transactionRepo.GetPayeeTotalsByDateRange(suite.ctx, date.AddDate(0, 0, -1), date.AddDate(0, 0, 1), "expense")
+	suite.Require().NoError(err)
+	suite.Require().Len(breakdowns, 2)
+	suite.Require().NotNil(breakdowns[0].Payee)
+	assert.Equal("Amazon", breakdowns[0].Payee.Name)
+	assert.Equal(60.0, breakdowns[0].TotalAmount)
+	assert.Equal(3, breakdowns[0].TransactionCount)
+	assert.Nil(breakdowns[1].Payee)
+	assert.Equal(5.0, breakdowns[1].TotalAmount)
+
+	page, err := suite.transactionRepo.GetPage(suite.ctx, &domain.PageRequest{Limit: 10, Payee: "ama"})
+	suite.Require().NoError(err)
+	assert.Equal(3, page.TotalCount)
+	for _, tx := range page.Transactions {
+		suite.Require().NotNil(tx.Payee)
+		assert.Equal(amazon.ID, tx.Payee.ID)
+		assert.Equal("Amazon", tx.Payee.Name)
+	}
+
+	page, err = suite.transactionRepo.GetPage(suite.ctx, &domain.PageRequest{Limit: 10, Payee: "a%"})
+	suite.Require().NoError(err)
+	assert.Zero(page.TotalCount, "wildcards in the payee filter are taken literally")
+}
@@ -0,0 +1,251 @@
+package integration
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"expense-tracker/internal/core/domain"
+	"expense-tracker/internal/core/usecase"
+	"expense-tracker/internal/repository/sqlite"
+)
+
+type RuleRepositoryIntegrationSuite struct {
+	suite.Suite
+	db              *sqlite.Database
+	repo            *sqlite.RuleRepository
+	transactionRepo *sqlite.TransactionRepository
+	categoryRepo    *sqlite.CategoryRepository
+	payeeRepo       *sqlite.PayeeRepository
+	ctx             context.Context
+	testDB          string
+}
+
+func (suite *RuleRepositoryIntegrationSuite) SetupSuite() {
+	suite.ctx = context.Background()
+
+	// Create a temporary database file for testing
+	tempDir := os.TempDir()
+	suite.testDB = filepath.Join(tempDir, "test_rule_expense_tracker.db")
+
+	var err error
+	suite.db, err = sqlite.NewDatabase(suite.testDB)
+	suite.Require().NoError(err)
+
+	suite.repo = sqlite.NewRuleRepository(suite.db)
+	suite.transactionRepo = sqlite.NewTransactionRepository(suite.db)
+	suite.categoryRepo = sqlite.NewCategoryRepository(suite.db)
+	suite.payeeRepo = sqlite.NewPayeeRepository(suite.db)
+}
+
+func (suite *RuleRepositoryIntegrationSuite) TearDownSuite() {
+	if suite.db != nil {
+		suite.db.Close()
+	}
+	os.Remove(suite.testDB)
+}
+
+func (suite *RuleRepositoryIntegrationSuite) SetupTest() {
+	// Clean up test data before each test
+	suite.cleanupTestData()
+}
+
+func (suite *RuleRepositoryIntegrationSuite) TearDownTest() {
+	// Clean up test data after each test
+	suite.cleanupTestData()
+}
+
+func (suite *RuleRepositoryIntegrationSuite) cleanupTestData() {
+	for _, table := range []string{"transaction_tags", "transaction_splits", "transactions", "rules", "payee_aliases", "payees"} {
+		_, err := suite.db.DB().Exec("DELETE FROM " + table)
+		suite.Require().NoError(err)
+	}
+}
+
+func TestRuleRepositoryIntegrationSuite(t *testing.T) {
+	suite.Run(t, new(RuleRepositoryIntegrationSuite))
+}
+
+// category returns the default category with the given name
+func (suite *RuleRepositoryIntegrationSuite) category(name, categoryType string) *domain.Category {
+	categories, err := suite.categoryRepo.GetCategories(suite.ctx, categoryType)
+	suite.Require().NoError(err)
+	for _, category := range categories {
+		if category.Name == name {
+			return category
+		}
+	}
+	suite.FailNow("missing default category " + name)
+	return nil
+}
+
+func (suite *RuleRepositoryIntegrationSuite) TestCreateUpdateAndGet() {
+	assert := assert.New(suite.T())
+
+	amazon := &domain.Payee{Name: "Amazon"}
+	suite.Require().NoError(suite.payeeRepo.CreatePayee(suite.ctx, amazon))
+	shopping := suite.category("Shopping", "expense")
+	minAmount, maxAmount := 5.0, 100.0
+
+	online := &domain.Rule{
+		Name: "Online shopping",
+		Conditions: domain.RuleConditions{
+			Type:               "expense",
+			DescriptionPattern: "^amzn",
+			MinAmount:          &minAmount,
+			MaxAmount:          &maxAmount,
+			Weekdays:           []time.Weekday{time.Saturday, time.Sunday},
+		},
+		Actions: domain.RuleActions{
+			Category: shopping,
+			Tags:     []string{"Online", "amazon"},
+			Payee:    amazon,
+		},
+	}
+	suite.Require().NoError(suite.repo.CreateRule(suite.ctx, online))
+	assert.NotZero(online.ID)
+	assert.Equal(0, online.Position)
+
+	savings := &domain.Rule{
+		Conditions: domain.RuleConditions{Payee: amazon, DescriptionContains: "savings"},
+		Actions:    domain.RuleActions{Description: "Savings", Transfer: true},
+	}
+	suite.Require().NoError(suite.repo.CreateRule(suite.ctx, savings))
+	assert.Equal(1, savings.Position)
+
+	rules, err := suite.repo.GetRules(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Len(rules, 2)
+
+	got := rules[0]
+	assert.Equal("Online shopping", got.Name)
+	assert.Equal("expense", got.Conditions.Type)
+	assert.Equal("^amzn", got.Conditions.DescriptionPattern)
+	assert.Equal(&minAmount, got.Conditions.MinAmount)
+	assert.Equal(&maxAmount, got.Conditions.MaxAmount)
+	assert.Nil(got.Conditions.Payee)
+	assert.Equal([]time.Weekday{time.Saturday, time.Sunday}, got.Conditions.Weekdays)
+	suite.Require().NotNil(got.Actions.Category)
+	assert.Equal(shopping.ID, got.Actions.Category.ID)
+	assert.Equal("Shopping", got.Actions.Category.Name)
+	assert.Equal([]string{"amazon", "online"}, got.Actions.Tags)
+	suite.Require().NotNil(got.Actions.Payee)
+	assert.Equal("Amazon", got.Actions.Payee.Name)
+	assert.False(got.Actions.Transfer)
+
+	got = rules[1]
+	assert.Nil(got.Conditions.MinAmount)
+	assert.Empty(got.Conditions.Weekdays)
+	suite.Require().NotNil(got.Conditions.Payee)
+	assert.Equal("Amazon", got.Conditions.Payee.Name)
+	assert.Nil(got.Actions.Category)
+	assert.Empty(got.Actions.Tags)
+	assert.Equal("Savings", got.Actions.Description)
+	assert.True(got.Actions.Transfer)
+
+	savings.Actions.Description = ""
+	suite.Require().NoError(suite.repo.UpdateRule(suite.ctx, savings))
+	rules, err = suite.repo.GetRules(suite.ctx)
+	suite.Require().NoError(err)
+	assert.Empty(rules[1].Actions.Description)
+	assert.Equal(1, rules[1].Position, "updating keeps the position")
+
+	assert.Error(suite.repo.UpdateRule(suite.ctx, &domain.Rule{ID: 9999}))
+	assert.Error(suite.repo.DeleteRule(suite.ctx, 9999))
+
+	suite.Require().NoError(suite.repo.DeleteRule(suite.ctx, online.ID))
+	rules, err = suite.repo.GetRules(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Len(rules, 1)
+	assert.Equal(savings.ID, rules[0].ID)
+}
+
+func (suite *RuleRepositoryIntegrationSuite) TestReorder() {
+	assert := assert.New(suite.T())
+
+	ruleUseCase := usecase.NewRuleUseCase(suite.repo, suite.transactionRepo, suite.categoryRepo, suite.payeeRepo, usecase.NewCategoryLearner(suite.transactionRepo), nil)
+	var ids []int
+	for _, word := range []string{"one", "two", "three"} {
+		rule := &domain.Rule{
+			Conditions: domain.RuleConditions{DescriptionContains: word},
+			Actions:    domain.RuleActions{Tags: []string{word}},
+		}
+		suite.Require().NoError(ruleUseCase.SaveRule(suite.ctx, rule))
+		ids = append(ids, rule.ID)
+	}
+
+	_, err := ruleUseCase.MoveRule(suite.ctx, ids[2], -2)
+	suite.Require().NoError(err)
+
+	rules, err := suite.repo.GetRules(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Len(rules, 3)
+	assert.Equal([]int{ids[2], ids[0], ids[1]}, []int{rules[0].ID, rules[1].ID, rules[2].ID})
+}
+
+func (suite *RuleRepositoryIntegrationSuite) TestRulesOnCreateAndReapply() {
+	assert := assert.New(suite.T())
+
+	shopping := suite.category("Shopping", "expense")
+	other := suite.category("Other", "expense")
+	learner := usecase.NewCategoryLearner(suite.transactionRepo)
+	transactionUseCase := usecase.NewTransactionUseCase(suite.transactionRepo, suite.categoryRepo, suite.payeeRepo, suite.repo, sqlite.NewUnitOfWork(suite.db), learner, nil)
+	ruleUseCase := usecase.NewRuleUseCase(suite.repo, suite.transactionRepo, suite.categoryRepo, suite.payeeRepo, learner, nil)
+
+	date := time.Date(2024, 6, 10, 12, 0, 0, 0, time.UTC)
+	bakery := &domain.Transaction{Description: "Bakery", Amount: 5, Type: "expense", Category: other, Date: date}
+	savings := &domain.Transaction{Description: "To savings", Amount: 200, Type: "expense", Category: other, Date: date}
+	for _, tx := range []*domain.Transaction{bakery, savings} {
+		suite.Require().NoError(suite.transactionRepo.Create(suite.ctx, tx))
+	}
+
+	rule, err := ruleUseCase.ParseRule(suite.ctx, "contains:savings then transfer", domain.DefaultLocale())
+	suite.Require().NoError(err)
+	suite.Require().NoError(ruleUseCase.SaveRule(suite.ctx, rule))
+	rule, err = ruleUseCase.ParseRule(suite.ctx, "contains:amzn then category:shopping tags:online", domain.DefaultLocale())
+	suite.Require().NoError(err)
+	suite.Require().NoError(ruleUseCase.SaveRule(suite.ctx, rule))
+
+	// New transactions get the rules right away
+	order := &domain.Transaction{Description: "AMZN Mktp", Amount: 25, Type: "expense", Category: other, Date: date}
+	suite.Require().NoError(transactionUseCase.AddTransaction(suite.ctx, order))
+	stored, err := suite.transactionRepo.GetByID(suite.ctx, order.ID)
+	suite.Require().NoError(err)
+	assert.Equal(shopping.ID, stored.Category.ID)
+	assert.Equal([]string{"online"}, stored.Tags)
+
+	// Existing ones only change once the previewed changes are applied
+	changes, err := ruleUseCase.PreviewRules(suite.ctx, date.AddDate(0, 0, -1), date.AddDate(0, 0, 1))
+	suite.Require().NoError(err)
+	suite.Require().Len(changes, 1)
+	assert.Equal(savings.ID, changes[0].After.ID)
+	assert.Equal([]string{"marked as transfer"}, changes[0].Differences())
+
+	total, err := suite.transactionRepo.GetTotalByDateRange(suite.ctx, date.AddDate(0, 0, -1), date.AddDate(0, 0, 1), "expense")
+	suite.Require().NoError(err)
+	assert.Equal(230.0, total)
+
+	suite.Require().NoError(ruleUseCase.ApplyRuleChanges(suite.ctx, changes))
+
+	stored, err = suite.transactionRepo.GetByID(suite.ctx, savings.ID)
+	suite.Require().NoError(err)
+	assert.True(stored.Transfer)
+
+	total, err = suite.transactionRepo.GetTotalByDateRange(suite.ctx, date.AddDate(0, 0, -1), date.AddDate(0, 0, 1), "expense")
+	suite.Require().NoError(err)
+	assert.Equal(30.0, total, "transfers are left out of totals")
+
+	count, err := suite.transactionRepo.GetTransactionCountByDateRange(suite.ctx, date.AddDate(0, 0, -1), date.AddDate(0, 0, 1), "expense")
+	suite.Require().NoError(err)
+	assert.Equal(2, count)
+
+	changes, err = ruleUseCase.PreviewRules(suite.ctx, date.AddDate(0, 0, -1), date.AddDate(0, 0, 1))
+	suite.Require().NoError(err)
+	assert.Empty(changes, "nothing is left to change")
+}
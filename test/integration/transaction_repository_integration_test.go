@@ -2,6 +2,7 @@ package integration
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"path/filepath"
 	"testing"
@@ -11,6 +12,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"expense-tracker/internal/core/domain"
+	"expense-tracker/internal/core/usecase"
 	"expense-tracker/internal/repository/sqlite"
 )
 
@@ -36,9 +38,13 @@ func (suite *TransactionRepositoryIntegrationSuite) SetupSuite() {
 
 	suite.repo = sqlite.NewTransactionRepository(suite.db)
 	suite.categoryRepo = sqlite.NewCategoryRepository(suite.db)
+
+	// The test data is dated in UTC, so days and periods are taken there too
+	domain.UseTimezone(time.UTC)
 }
 
 func (suite *TransactionRepositoryIntegrationSuite) TearDownSuite() {
+	domain.UseTimezone(nil)
 	if suite.db != nil {
 		suite.db.Close()
 	}
@@ -57,7 +63,21 @@ func (suite *TransactionRepositoryIntegrationSuite) TearDownTest() {
 
 func (suite *TransactionRepositoryIntegrationSuite) cleanupTestData() {
 	// Delete test transactions and categories
-	_, err := suite.db.DB().Exec("DELETE FROM transactions")
+	_, err := suite.db.DB().Exec("DELETE FROM transaction_tags")
+	suite.Require().NoError(err)
+	_, err = suite.db.DB().Exec("DELETE FROM transaction_splits")
+	suite.Require().NoError(err)
+	_, err = suite.db.DB().Exec("DELETE FROM transactions")
+	suite.Require().NoError(err)
+	_, err = suite.db.DB().Exec("DELETE FROM duplicate_dismissals")
+	suite.Require().NoError(err)
+	_, err = suite.db.DB().Exec("DELETE FROM statements")
+	suite.Require().NoError(err)
+	_, err = suite.db.DB().Exec("DELETE FROM rules")
+	suite.Require().NoError(err)
+	_, err = suite.db.DB().Exec("DELETE FROM payee_aliases")
+	suite.Require().NoError(err)
+	_, err = suite.db.DB().Exec("DELETE FROM payees")
 	suite.Require().NoError(err)
 	_, err = suite.db.DB().Exec("DELETE FROM categories WHERE name NOT IN ('Food & Dining', 'Transportation', 'Shopping', 'Entertainment', 'Bills & Utilities', 'Healthcare', 'Other', 'Salary', 'Freelance', 'Investment', 'Gift')")
 	suite.Require().NoError(err)
@@ -307,6 +327,52 @@ func (suite *TransactionRepositoryIntegrationSuite) TestGetTotalByDateRange() {
 	assert.Equal(500.0, totalExpense) // 300 + 200
 }
 
+func (suite *TransactionRepositoryIntegrationSuite) TestDatesInConfiguredTimezone() {
+	assert := assert.New(suite.T())
+
+	berlin, err := domain.LoadTimezone("Europe/Berlin")
+	suite.Require().NoError(err)
+	domain.UseTimezone(berlin)
+	defer domain.UseTimezone(time.UTC)
+
+	// Half past midnight in Berlin is still October in UTC
+	november := &domain.Transaction{Description: "Night bus", Amount: 3, Type: "expense", Date: time.Date(2026, 11, 1, 0, 30, 0, 0, berlin)}
+	october := &domain.Transaction{Description: "Late dinner", Amount: 40, Type: "expense", Date: time.Date(2026, 10, 31, 23, 0, 0, 0, berlin)}
+	suite.Require().NoError(suite.repo.Create(suite.ctx, november))
+	suite.Require().NoError(suite.repo.Create(suite.ctx, october))
+
+	var date, day string
+	err = suite.db.DB().QueryRow("SELECT date, day FROM transactions WHERE id = ?", november.ID).Scan(&date, &day)
+	suite.Require().NoError(err)
+	assert.Equal("2026-10-31T23:30:00Z", date, "stored as a UTC instant")
+	assert.Equal("2026-11-01", day, "booked on the day in the configured timezone")
+
+	novemberRange := domain.GetMonthRange(2026, time.November)
+	total, err := suite.repo.GetTotalByDateRange(suite.ctx, novemberRange.Start, novemberRange.End, "expense")
+	suite.Require().NoError(err)
+	assert.Equal(3.0, total)
+
+	octoberRange := domain.GetMonthRange(2026, time.October)
+	transactions, err := suite.repo.GetByDateRange(suite.ctx, octoberRange.Start, octoberRange.End)
+	suite.Require().NoError(err)
+	suite.Require().Len(transactions, 1)
+	assert.Equal("Late dinner", transactions[0].Description)
+	assert.Equal(october.Date, transactions[0].Date, "read back on Berlin's clock")
+
+	// Shifting across the change back to winter time keeps the time of day
+	_, err = suite.repo.BulkShiftDate(suite.ctx, []int{october.ID}, -7)
+	suite.Require().NoError(err)
+	shifted, err := suite.repo.GetByID(suite.ctx, october.ID)
+	suite.Require().NoError(err)
+	assert.Equal(time.Date(2026, 10, 24, 23, 0, 0, 0, berlin), shifted.Date)
+
+	// Listings sort by the instant, whatever offset a date was entered in
+	page, err := suite.repo.GetPage(suite.ctx, &domain.PageRequest{Limit: 10})
+	suite.Require().NoError(err)
+	suite.Require().Len(page.Transactions, 2)
+	assert.Equal("Night bus", page.Transactions[0].Description)
+}
+
 func (suite *TransactionRepositoryIntegrationSuite) TestSearchTransactions() {
 	assert := assert.New(suite.T())
 
@@ -336,3 +402,762 @@ func (suite *TransactionRepositoryIntegrationSuite) TestSearchTransactions() {
 	assert.NoError(err)
 	assert.Len(results, 0)
 }
+
+func (suite *TransactionRepositoryIntegrationSuite) TestGetPage() {
+	assert := assert.New(suite.T())
+
+	// Five transactions share the same date so ordering must fall back to the id
+	base := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+	for i := 0; i < 12; i++ {
+		date := base.AddDate(0, 0, -i)
+		if i < 5 {
+			date = base
+		}
+		tx := &domain.Transaction{Description: fmt.Sprintf("Paged %02d", i), Amount: 10.0, Type: "expense", Date: date}
+		suite.Require().NoError(suite.repo.Create(suite.ctx, tx))
+	}
+
+	all, err := suite.repo.GetPage(suite.ctx, &domain.PageRequest{Limit: 100})
+	assert.NoError(err)
+	assert.Equal(12, all.TotalCount)
+	suite.Require().Len(all.Transactions, 12)
+
+	// Walk forwards through every page and compare against the full listing
+	var walked []*domain.Transaction
+	page, err := suite.repo.GetPage(suite.ctx, &domain.PageRequest{Limit: 5})
+	suite.Require().NoError(err)
+	for len(page.Transactions) > 0 {
+		assert.Equal(12, page.TotalCount)
+		walked = append(walked, page.Transactions...)
+		last := page.Transactions[len(page.Transactions)-1]
+		page, err = suite.repo.GetPage(suite.ctx, &domain.PageRequest{After: last.Cursor(), Limit: 5})
+		suite.Require().NoError(err)
+	}
+	suite.Require().Len(walked, 12)
+	for i := range walked {
+		assert.Equal(all.Transactions[i].ID, walked[i].ID)
+	}
+
+	// The last page is anchored at the end and sized to line up with the forward pages
+	lastPage, err := suite.repo.GetPage(suite.ctx, &domain.PageRequest{FromEnd: true, Limit: domain.LastPageSize(12, 5)})
+	assert.NoError(err)
+	suite.Require().Len(lastPage.Transactions, 2)
+	assert.Equal(all.Transactions[10].ID, lastPage.Transactions[0].ID)
+	assert.Equal(all.Transactions[11].ID, lastPage.Transactions[1].ID)
+
+	// Stepping back from the last page returns the middle page in listing order
+	middlePage, err := suite.repo.GetPage(suite.ctx, &domain.PageRequest{Before: lastPage.Transactions[0].Cursor(), Limit: 5})
+	assert.NoError(err)
+	suite.Require().Len(middlePage.Transactions, 5)
+	for i, tx := range middlePage.Transactions {
+		assert.Equal(all.Transactions[5+i].ID, tx.ID)
+	}
+}
+
+func (suite *TransactionRepositoryIntegrationSuite) TestGetPage_WithQuery() {
+	assert := assert.New(suite.T())
+
+	transactions := []*domain.Transaction{
+		{Description: "Coffee at station", Amount: 3.0, Type: "expense", Date: time.Now()},
+		{Description: "Coffee beans", Amount: 12.0, Type: "expense", Date: time.Now().Add(-time.Hour)},
+		{Description: "Train ticket", Amount: 25.0, Type: "expense", Date: time.Now().Add(-2 * time.Hour)},
+	}
+	for _, tx := range transactions {
+		suite.Require().NoError(suite.repo.Create(suite.ctx, tx))
+	}
+
+	page, err := suite.repo.GetPage(suite.ctx, &domain.PageRequest{Query: "coffee", Limit: 1})
+	assert.NoError(err)
+	assert.Equal(2, page.TotalCount)
+	suite.Require().Len(page.Transactions, 1)
+	assert.Equal("Coffee at station", page.Transactions[0].Description)
+
+	page, err = suite.repo.GetPage(suite.ctx, &domain.PageRequest{Query: "coffee", After: page.Transactions[0].Cursor(), Limit: 1})
+	assert.NoError(err)
+	suite.Require().Len(page.Transactions, 1)
+	assert.Equal("Coffee beans", page.Transactions[0].Description)
+
+	page, err = suite.repo.GetPage(suite.ctx, &domain.PageRequest{Query: "nonexistent", Limit: 10})
+	assert.NoError(err)
+	assert.Equal(0, page.TotalCount)
+	assert.Empty(page.Transactions)
+}
+
+func (suite *TransactionRepositoryIntegrationSuite) createBulkFixtures() []*domain.Transaction {
+	base := time.Date(2024, 5, 15, 9, 0, 0, 0, time.UTC)
+	transactions := []*domain.Transaction{
+		{Description: "Lidl", Amount: 42.5, Type: "expense", Date: base, Tags: []string{"groceries"}},
+		{Description: "Bus ticket", Amount: 2.8, Type: "expense", Date: base.AddDate(0, 0, -1)},
+		{Description: "Pharmacy", Amount: 12.0, Type: "expense", Date: base.AddDate(0, 0, -2), Tags: []string{"health", "shared"}},
+	}
+	for _, tx := range transactions {
+		suite.Require().NoError(suite.repo.Create(suite.ctx, tx))
+	}
+	return transactions
+}
+
+func (suite *TransactionRepositoryIntegrationSuite) TestTags_RoundTrip() {
+	assert := assert.New(suite.T())
+
+	transactions := suite.createBulkFixtures()
+
+	retrieved, err := suite.repo.GetByID(suite.ctx, transactions[2].ID)
+	assert.NoError(err)
+	assert.Equal([]string{"health", "shared"}, retrieved.Tags)
+
+	retrieved.Tags = []string{"Work", " work ", "travel"}
+	suite.Require().NoError(suite.repo.Update(suite.ctx, retrieved))
+
+	retrieved, err = suite.repo.GetByID(suite.ctx, transactions[2].ID)
+	assert.NoError(err)
+	assert.Equal([]string{"travel", "work"}, retrieved.Tags)
+}
+
+func (suite *TransactionRepositoryIntegrationSuite) TestBulkActions() {
+	assert := assert.New(suite.T())
+
+	transactions := suite.createBulkFixtures()
+	ids := []int{transactions[0].ID, transactions[1].ID}
+
+	category := &domain.Category{Name: "Bulk Category"}
+	suite.Require().NoError(suite.categoryRepo.CreateCategory(suite.ctx, category, "expense"))
+
+	affected, err := suite.repo.BulkUpdateCategory(suite.ctx, ids, category.ID)
+	assert.NoError(err)
+	assert.Equal(2, affected)
+
+	affected, err = suite.repo.BulkSetTags(suite.ctx, ids, []string{"receipts"})
+	assert.NoError(err)
+	assert.Equal(2, affected)
+
+	affected, err = suite.repo.BulkShiftDate(suite.ctx, ids, -3)
+	assert.NoError(err)
+	assert.Equal(2, affected)
+
+	updated, err := suite.repo.GetByIDs(suite.ctx, ids)
+	assert.NoError(err)
+	suite.Require().Len(updated, 2)
+	for i, tx := range updated {
+		suite.Require().NotNil(tx.Category)
+		assert.Equal(category.ID, tx.Category.ID)
+		assert.Equal([]string{"receipts"}, tx.Tags)
+		assert.True(tx.Date.Equal(transactions[i].Date.AddDate(0, 0, -3)))
+	}
+
+	affected, err = suite.repo.BulkDelete(suite.ctx, ids)
+	assert.NoError(err)
+	assert.Equal(2, affected)
+
+	remaining, err := suite.repo.GetAll(suite.ctx, 0, 10)
+	assert.NoError(err)
+	suite.Require().Len(remaining, 1)
+	assert.Equal(transactions[2].ID, remaining[0].ID)
+}
+
+func (suite *TransactionRepositoryIntegrationSuite) TestBulkRestore() {
+	assert := assert.New(suite.T())
+
+	transactions := suite.createBulkFixtures()
+	ids := []int{transactions[0].ID, transactions[2].ID}
+
+	snapshot, err := suite.repo.GetByIDs(suite.ctx, ids)
+	suite.Require().NoError(err)
+
+	_, err = suite.repo.BulkSetTags(suite.ctx, []int{transactions[2].ID}, nil)
+	suite.Require().NoError(err)
+	_, err = suite.repo.BulkDelete(suite.ctx, []int{transactions[0].ID})
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.repo.BulkRestore(suite.ctx, snapshot))
+
+	restored, err := suite.repo.GetByIDs(suite.ctx, ids)
+	assert.NoError(err)
+	assert.Equal(snapshot, restored)
+}
+
+func (suite *TransactionRepositoryIntegrationSuite) TestGetDescriptionSuggestions() {
+	assert := assert.New(suite.T())
+
+	categories, err := suite.categoryRepo.GetCategories(suite.ctx, "expense")
+	suite.Require().NoError(err)
+	byName := map[string]*domain.Category{}
+	for _, category := range categories {
+		byName[category.Name] = category
+	}
+	food, shopping := byName["Food & Dining"], byName["Shopping"]
+
+	base := time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)
+	transactions := []*domain.Transaction{
+		{Description: "Lidl", Amount: 20, Type: "expense", Date: base, Category: food},
+		{Description: "Lidl", Amount: 35.5, Type: "expense", Date: base.AddDate(0, 0, 7), Category: food},
+		{Description: "Lidl", Amount: 12, Type: "expense", Date: base.AddDate(0, 0, 3), Category: shopping},
+		{Description: "Corner shop Lidl", Amount: 5, Type: "expense", Date: base, Category: shopping},
+		{Description: "Library", Amount: 3, Type: "expense", Date: base.AddDate(0, 0, 1)},
+		{Description: "Lidl refund", Amount: 10, Type: "income", Date: base},
+		{Description: "100% juice", Amount: 2, Type: "expense", Date: base},
+		{Description: "Bus ticket", Amount: 2.8, Type: "expense", Date: base},
+	}
+	for _, tx := range transactions {
+		suite.Require().NoError(suite.repo.Create(suite.ctx, tx))
+	}
+
+	suggestions, err := suite.repo.GetDescriptionSuggestions(suite.ctx, "li", "expense", 10)
+	suite.Require().NoError(err)
+	suite.Require().Len(suggestions, 3)
+
+	lidl := suggestions[0]
+	assert.Equal("Lidl", lidl.Description)
+	assert.Equal(3, lidl.UseCount)
+	assert.True(base.AddDate(0, 0, 7).Equal(lidl.LastUsed))
+	assert.Equal(35.5, lidl.LastAmount)
+	suite.Require().NotNil(lidl.Category)
+	assert.Equal(food.ID, lidl.Category.ID)
+
+	descriptions := []string{suggestions[1].Description, suggestions[2].Description}
+	assert.ElementsMatch([]string{"Corner shop Lidl", "Library"}, descriptions)
+	for _, suggestion := range suggestions[1:] {
+		if suggestion.Description == "Library" {
+			assert.Nil(suggestion.Category)
+		}
+	}
+
+	// LIKE wildcards in the prefix are matched literally
+	suggestions, err = suite.repo.GetDescriptionSuggestions(suite.ctx, "100%", "expense", 10)
+	suite.Require().NoError(err)
+	suite.Require().Len(suggestions, 1)
+	assert.Equal("100% juice", suggestions[0].Description)
+
+	suggestions, err = suite.repo.GetDescriptionSuggestions(suite.ctx, "1%", "expense", 10)
+	suite.Require().NoError(err)
+	assert.Empty(suggestions)
+
+	suggestions, err = suite.repo.GetDescriptionSuggestions(suite.ctx, "li", "expense", 1)
+	suite.Require().NoError(err)
+	assert.Len(suggestions, 1)
+}
+
+func (suite *TransactionRepositoryIntegrationSuite) TestGetRecentCategoryIDs() {
+	assert := assert.New(suite.T())
+
+	categories, err := suite.categoryRepo.GetCategories(suite.ctx, "expense")
+	suite.Require().NoError(err)
+	byName := map[string]*domain.Category{}
+	for _, category := range categories {
+		byName[category.Name] = category
+	}
+	food, shopping, transport := byName["Food & Dining"], byName["Shopping"], byName["Transportation"]
+	incomeCategories, err := suite.categoryRepo.GetCategories(suite.ctx, "income")
+	suite.Require().NoError(err)
+	salary := incomeCategories[0]
+
+	base := time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)
+	transactions := []*domain.Transaction{
+		{Description: "Lidl", Amount: 20, Type: "expense", Date: base.AddDate(0, 0, 5), Category: food},
+		{Description: "Shoes", Amount: 60, Type: "expense", Date: base.AddDate(0, 0, 9), Category: shopping},
+		{Description: "Bus", Amount: 3, Type: "expense", Date: base, Category: transport},
+		{Description: "Lidl", Amount: 15, Type: "expense", Date: base.AddDate(0, 0, 10), Category: food},
+		{Description: "Cash", Amount: 5, Type: "expense", Date: base.AddDate(0, 0, 20)},
+		{Description: "Pay", Amount: 2000, Type: "income", Date: base.AddDate(0, 0, 30), Category: salary},
+	}
+	for _, tx := range transactions {
+		suite.Require().NoError(suite.repo.Create(suite.ctx, tx))
+	}
+
+	ids, err := suite.repo.GetRecentCategoryIDs(suite.ctx, "expense", 10)
+	suite.Require().NoError(err)
+	assert.Equal([]int{food.ID, shopping.ID, transport.ID}, ids)
+
+	ids, err = suite.repo.GetRecentCategoryIDs(suite.ctx, "expense", 2)
+	suite.Require().NoError(err)
+	assert.Equal([]int{food.ID, shopping.ID}, ids)
+}
+
+func (suite *TransactionRepositoryIntegrationSuite) TestDuplicates() {
+	assert := assert.New(suite.T())
+
+	categories, err := suite.categoryRepo.GetCategories(suite.ctx, "expense")
+	suite.Require().NoError(err)
+	var food *domain.Category
+	for _, category := range categories {
+		if category.Name == "Food & Dining" {
+			food = category
+		}
+	}
+
+	date := time.Date(2026, 10, 14, 9, 0, 0, 0, time.UTC)
+	receipt := &domain.Transaction{Description: "Lidl Berlin", Amount: 23.5, Type: "expense", Date: date, Tags: []string{"weekly"}}
+	statement := &domain.Transaction{Description: "LIDL BERLIN 0412", Amount: 23.5, Type: "expense", Date: date.AddDate(0, 0, 2), Category: food, Tags: []string{"card"}}
+	coffee := &domain.Transaction{Description: "Coffee", Amount: 3, Type: "expense", Date: date}
+	coffeeAgain := &domain.Transaction{Description: "Coffee", Amount: 3, Type: "expense", Date: date}
+	for _, tx := range []*domain.Transaction{receipt, statement, coffee, coffeeAgain} {
+		suite.Require().NoError(suite.repo.Create(suite.ctx, tx))
+	}
+
+	duplicateUseCase := usecase.NewDuplicateUseCase(suite.repo, usecase.NewCategoryLearner(suite.repo), nil, 0)
+	pairs, err := duplicateUseCase.FindDuplicates(suite.ctx, date, date.AddDate(0, 0, 1))
+	suite.Require().NoError(err)
+	suite.Require().Len(pairs, 2)
+	assert.Equal(receipt.ID, pairs[0].Original.ID)
+	assert.Equal(statement.ID, pairs[0].Duplicate.ID)
+	assert.Equal(domain.NewDuplicateKey(coffee.ID, coffeeAgain.ID), pairs[1].Key())
+
+	// A dismissed pair is never flagged again
+	suite.Require().NoError(duplicateUseCase.Dismiss(suite.ctx, pairs[1]))
+	suite.Require().NoError(duplicateUseCase.Dismiss(suite.ctx, pairs[1]), "dismissing twice is harmless")
+	dismissed, err := suite.repo.GetDismissedDuplicates(suite.ctx)
+	suite.Require().NoError(err)
+	assert.Equal([]domain.DuplicateKey{domain.NewDuplicateKey(coffee.ID, coffeeAgain.ID)}, dismissed)
+
+	// Merging keeps the receipt, filled in from the statement, and deletes the statement
+	merged, err := duplicateUseCase.Merge(suite.ctx, pairs[0], false)
+	suite.Require().NoError(err)
+	assert.Equal(receipt.ID, merged.ID)
+
+	stored, err := suite.repo.GetByID(suite.ctx, receipt.ID)
+	suite.Require().NoError(err)
+	assert.Equal("Lidl Berlin", stored.Description)
+	suite.Require().NotNil(stored.Category)
+	assert.Equal(food.ID, stored.Category.ID)
+	assert.Equal([]string{"card", "weekly"}, stored.Tags)
+	_, err = suite.repo.GetByID(suite.ctx, statement.ID)
+	assert.Error(err)
+
+	pairs, err = duplicateUseCase.FindDuplicates(suite.ctx, date, date.AddDate(0, 0, 1))
+	suite.Require().NoError(err)
+	assert.Empty(pairs)
+
+	// A new entry is checked against what is saved
+	pairs, err = duplicateUseCase.FindDuplicatesOf(suite.ctx, &domain.Transaction{Description: "Lidl Berlin", Amount: 23.5, Type: "expense", Date: date.AddDate(0, 0, 1)})
+	suite.Require().NoError(err)
+	suite.Require().Len(pairs, 1)
+	assert.Equal(receipt.ID, pairs[0].Original.ID)
+
+	// Merging a pair whose duplicate is gone changes nothing
+	err = suite.repo.MergeDuplicate(suite.ctx, merged, statement.ID)
+	assert.Error(err)
+}
+
+func (suite *TransactionRepositoryIntegrationSuite) TestReconciliation() {
+	assert := assert.New(suite.T())
+
+	date := time.Date(2026, 9, 30, 0, 0, 0, 0, time.UTC)
+	salary := &domain.Transaction{Description: "Salary", Amount: 2500, Type: "income", Date: date.AddDate(0, 0, -29)}
+	rent := &domain.Transaction{Description: "Rent", Amount: 900, Type: "expense", Date: date.AddDate(0, 0, -28)}
+	pending := &domain.Transaction{Description: "Cheque", Amount: 40, Type: "expense", Date: date.AddDate(0, 0, -1)}
+	later := &domain.Transaction{Description: "Coffee", Amount: 3, Type: "expense", Date: date.Add(36 * time.Hour)}
+	for _, tx := range []*domain.Transaction{salary, rent, pending, later} {
+		suite.Require().NoError(suite.repo.Create(suite.ctx, tx))
+	}
+
+	reconciliationUseCase := usecase.NewReconciliationUseCase(suite.repo)
+	reconciliation, err := reconciliationUseCase.Start(suite.ctx, date, 1700, 100)
+	suite.Require().NoError(err)
+	suite.Require().Len(reconciliation.Transactions, 3, "only transactions up to the statement date")
+	assert.Equal(salary.ID, reconciliation.Transactions[0].ID)
+
+	suite.Require().NoError(reconciliationUseCase.SetCleared(suite.ctx, reconciliation, []int{salary.ID, rent.ID}, true))
+	assert.True(reconciliation.IsBalanced())
+	stored, err := suite.repo.GetByID(suite.ctx, rent.ID)
+	suite.Require().NoError(err)
+	assert.Equal(domain.StatusCleared, stored.Status, "ticking off is saved right away")
+
+	suite.Require().NoError(reconciliationUseCase.Finish(suite.ctx, reconciliation))
+	assert.NotZero(reconciliation.Statement.ID)
+
+	stored, err = suite.repo.GetByID(suite.ctx, rent.ID)
+	suite.Require().NoError(err)
+	assert.Equal(domain.StatusReconciled, stored.Status)
+	stored, err = suite.repo.GetByID(suite.ctx, pending.ID)
+	suite.Require().NoError(err)
+	assert.Equal(domain.StatusUncleared, stored.Status)
+
+	// Reconciled rows refuse silent changes
+	stored, err = suite.repo.GetByID(suite.ctx, rent.ID)
+	suite.Require().NoError(err)
+	stored.Amount = 950
+	assert.ErrorIs(suite.repo.Update(suite.ctx, stored), domain.ErrReconciled)
+	assert.ErrorIs(suite.repo.Delete(suite.ctx, rent.ID), domain.ErrReconciled)
+	_, err = suite.repo.BulkShiftDate(suite.ctx, []int{pending.ID, rent.ID}, 1)
+	assert.ErrorIs(err, domain.ErrReconciled)
+	stored, err = suite.repo.GetByID(suite.ctx, pending.ID)
+	suite.Require().NoError(err)
+	assert.Equal(pending.Date, stored.Date, "a refused bulk action changes nothing")
+
+	// Unlocking sets them back to cleared, after which they can be changed
+	transactionUseCase := usecase.NewTransactionUseCase(suite.repo, suite.categoryRepo, nil, nil, sqlite.NewUnitOfWork(suite.db), usecase.NewCategoryLearner(suite.repo), nil)
+	unlocked, err := transactionUseCase.UnlockTransactions(suite.ctx, []int{rent.ID, pending.ID})
+	suite.Require().NoError(err)
+	assert.Equal(1, unlocked)
+	stored, err = suite.repo.GetByID(suite.ctx, rent.ID)
+	suite.Require().NoError(err)
+	assert.Equal(domain.StatusCleared, stored.Status)
+	stored.Amount = 950
+	suite.Require().NoError(suite.repo.Update(suite.ctx, stored))
+
+	// The next statement opens where the last one closed
+	last, err := reconciliationUseCase.LastStatement(suite.ctx)
+	suite.Require().NoError(err)
+	assert.Equal(1700.0, last.ClosingBalance)
+	assert.True(date.Equal(last.Date))
+	next, err := reconciliationUseCase.Start(suite.ctx, date.AddDate(0, 1, 0), 1607, 0)
+	suite.Require().NoError(err)
+	assert.Equal(1700.0, next.Statement.OpeningBalance)
+	assert.Len(next.Transactions, 3, "the unlocked rent, the cheque and the coffee")
+	_, err = reconciliationUseCase.Start(suite.ctx, date, 1700, 0)
+	assert.Error(err, "statements are reconciled in order")
+
+	suite.Require().NoError(suite.repo.Reconcile(suite.ctx, &domain.Statement{Date: date.AddDate(0, 1, 0)}, nil))
+	assert.Error(suite.repo.Reconcile(suite.ctx, &domain.Statement{Date: date.AddDate(0, 2, 0)}, []int{later.ID}), "only cleared transactions can be reconciled")
+}
+
+func (suite *TransactionRepositoryIntegrationSuite) TestAuditLog() {
+	assert := assert.New(suite.T())
+
+	suite.db.SetActor("alice")
+	defer suite.db.SetActor("")
+	auditRepo := sqlite.NewAuditRepository(suite.db)
+
+	tx := &domain.Transaction{Description: "Groceries", Amount: 42.5, Type: "expense", Date: time.Date(2026, 9, 14, 0, 0, 0, 0, time.UTC)}
+	suite.Require().NoError(suite.repo.Create(suite.ctx, tx))
+	tx.Amount = 45
+	suite.Require().NoError(suite.repo.Update(suite.ctx, tx))
+	suite.Require().NoError(suite.repo.Update(suite.ctx, tx))
+	_, err := suite.repo.BulkSetTags(suite.ctx, []int{tx.ID}, []string{"weekly"})
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.repo.Delete(suite.ctx, tx.ID))
+
+	history, err := auditRepo.GetEntityHistory(suite.ctx, domain.AuditTransaction, tx.ID)
+	suite.Require().NoError(err)
+	suite.Require().Len(history, 4, "saving without changes is not recorded")
+	assert.Equal(domain.AuditDelete, history[0].Action)
+	assert.Nil(history[0].After)
+	assert.Equal("tags", history[1].Summary())
+	assert.Equal("amount", history[2].Summary())
+	assert.Equal(domain.AuditCreate, history[3].Action)
+	assert.Equal("alice", history[3].Actor)
+	assert.False(history[3].At.IsZero())
+
+	changes, err := history[2].Changes()
+	suite.Require().NoError(err)
+	assert.Equal([]domain.AuditChange{{Field: "amount", Before: "42.5", After: "45"}}, changes)
+
+	// A refused change leaves no trace
+	rent := &domain.Transaction{Description: "Rent", Amount: 900, Type: "expense", Date: time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)}
+	suite.Require().NoError(suite.repo.Create(suite.ctx, rent))
+	_, err = suite.repo.SetStatus(suite.ctx, []int{rent.ID}, domain.StatusCleared)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.repo.Reconcile(suite.ctx, &domain.Statement{Date: rent.Date}, []int{rent.ID}))
+	assert.ErrorIs(suite.repo.Delete(suite.ctx, rent.ID), domain.ErrReconciled)
+	history, err = auditRepo.GetEntityHistory(suite.ctx, domain.AuditTransaction, rent.ID)
+	suite.Require().NoError(err)
+	suite.Require().Len(history, 3)
+	assert.Equal("status", history[0].Summary())
+
+	// Categories are recorded too, and the log cannot be rewritten
+	category := &domain.Category{Name: "Audited"}
+	suite.Require().NoError(suite.categoryRepo.CreateCategory(suite.ctx, category, "expense"))
+	recent, err := auditRepo.GetRecentChanges(suite.ctx, 1)
+	suite.Require().NoError(err)
+	suite.Require().Len(recent, 1)
+	assert.Equal(domain.AuditCategory, recent[0].Entity)
+	assert.Equal(category.ID, recent[0].EntityID)
+	assert.JSONEq(fmt.Sprintf(`{"id": %d, "name": "Audited", "type": "expense"}`, category.ID), string(recent[0].After))
+
+	_, err = suite.db.DB().Exec("DELETE FROM audit_log")
+	assert.ErrorContains(err, "append-only")
+	_, err = suite.db.DB().Exec("UPDATE audit_log SET actor = 'mallory'")
+	assert.ErrorContains(err, "append-only")
+}
+
+func (suite *TransactionRepositoryIntegrationSuite) TestTrash() {
+	assert := assert.New(suite.T())
+
+	transactions := suite.createBulkFixtures()
+	lidl, bus, pharmacy := transactions[0], transactions[1], transactions[2]
+	start, end := lidl.Date.AddDate(0, 0, -7), lidl.Date.AddDate(0, 0, 1)
+
+	categories, err := suite.categoryRepo.GetCategories(suite.ctx, "expense")
+	suite.Require().NoError(err)
+	_, err = suite.repo.BulkUpdateCategory(suite.ctx, []int{lidl.ID}, categories[0].ID)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.repo.Delete(suite.ctx, lidl.ID))
+	affected, err := suite.repo.BulkDelete(suite.ctx, []int{bus.ID, lidl.ID})
+	suite.Require().NoError(err)
+	assert.Equal(1, affected, "a transaction already in the trash is not moved again")
+
+	// Trashed transactions are left out of lookups, totals and breakdowns
+	_, err = suite.repo.GetByID(suite.ctx, lidl.ID)
+	assert.Error(err)
+	total, err := suite.repo.GetTotalByDateRange(suite.ctx, start, end, "expense")
+	suite.Require().NoError(err)
+	assert.Equal(12.0, total)
+	breakdowns, err := suite.repo.GetCategoryTotalsByDateRange(suite.ctx, start, end, "expense")
+	suite.Require().NoError(err)
+	assert.Empty(breakdowns)
+	count, err := suite.repo.GetTransactionCountByDateRange(suite.ctx, start, end, "")
+	suite.Require().NoError(err)
+	assert.Equal(1, count)
+	page, err := suite.repo.GetPage(suite.ctx, &domain.PageRequest{Limit: 10})
+	suite.Require().NoError(err)
+	assert.Equal(1, page.TotalCount)
+	suggestions, err := suite.repo.GetDescriptionSuggestions(suite.ctx, "Lid", "expense", 5)
+	suite.Require().NoError(err)
+	assert.Empty(suggestions)
+	_, err = suite.repo.BulkSetTags(suite.ctx, []int{lidl.ID}, []string{"changed"})
+	suite.Require().NoError(err)
+
+	// The trash keeps them as they were, most recently deleted first
+	trash, err := suite.repo.GetTrash(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Len(trash, 2)
+	for _, tx := range trash {
+		suite.Require().NotNil(tx.DeletedAt)
+		assert.True(tx.InTrash())
+	}
+	trashed := map[int]*domain.Transaction{trash[0].ID: trash[0], trash[1].ID: trash[1]}
+	suite.Require().Contains(trashed, lidl.ID)
+	assert.Equal([]string{"groceries"}, trashed[lidl.ID].Tags)
+
+	restored, err := suite.repo.RestoreDeleted(suite.ctx, []int{lidl.ID, pharmacy.ID})
+	suite.Require().NoError(err)
+	assert.Equal(1, restored)
+	stored, err := suite.repo.GetByID(suite.ctx, lidl.ID)
+	suite.Require().NoError(err)
+	assert.Nil(stored.DeletedAt)
+	suite.Require().NotNil(stored.Category)
+	assert.Equal(categories[0].ID, stored.Category.ID)
+
+	history, err := sqlite.NewAuditRepository(suite.db).GetEntityHistory(suite.ctx, domain.AuditTransaction, lidl.ID)
+	suite.Require().NoError(err)
+	suite.Require().NotEmpty(history)
+	assert.Equal(domain.AuditCreate, history[0].Action, "a restore is logged as the transaction coming back")
+	assert.Equal(domain.AuditDelete, history[1].Action)
+
+	// Purging only removes what is in the trash
+	purged, err := suite.repo.Purge(suite.ctx, []int{bus.ID, pharmacy.ID})
+	suite.Require().NoError(err)
+	assert.Equal(1, purged)
+	var rows int
+	suite.Require().NoError(suite.db.DB().QueryRow("SELECT COUNT(*) FROM transactions").Scan(&rows))
+	assert.Equal(2, rows)
+
+	suite.Require().NoError(suite.repo.Delete(suite.ctx, pharmacy.ID))
+	expired, err := suite.repo.PurgeDeletedBefore(suite.ctx, time.Now().Add(-time.Hour))
+	suite.Require().NoError(err)
+	assert.Empty(expired)
+	expired, err = suite.repo.PurgeDeletedBefore(suite.ctx, time.Now().Add(time.Minute))
+	suite.Require().NoError(err)
+	assert.Equal([]int{pharmacy.ID}, expired)
+	suite.Require().NoError(suite.db.DB().QueryRow("SELECT COUNT(*) FROM transaction_tags WHERE transaction_id = ?", pharmacy.ID).Scan(&rows))
+	assert.Zero(rows)
+
+	trash, err = suite.repo.GetTrash(suite.ctx)
+	suite.Require().NoError(err)
+	assert.Empty(trash)
+}
+
+func (suite *TransactionRepositoryIntegrationSuite) TestUndoHistory() {
+	assert := assert.New(suite.T())
+
+	categories, err := suite.categoryRepo.GetCategories(suite.ctx, "expense")
+	suite.Require().NoError(err)
+	food, shopping := categories[0], categories[1]
+
+	learner := usecase.NewCategoryLearner(suite.repo)
+	history := usecase.NewUndoHistory(suite.repo, sqlite.NewUnitOfWork(suite.db), learner, 0)
+	transactionUseCase := usecase.NewTransactionUseCase(suite.repo, suite.categoryRepo, sqlite.NewPayeeRepository(suite.db), sqlite.NewRuleRepository(suite.db), sqlite.NewUnitOfWork(suite.db), learner, history)
+
+	receipt := &domain.Transaction{
+		Description: "Supermarket",
+		Amount:      50,
+		Date:        time.Date(2026, 10, 10, 12, 0, 0, 0, time.UTC),
+		Type:        "expense",
+		Tags:        []string{"weekly"},
+		Splits: []*domain.Split{
+			{Category: food, Amount: 40},
+			{Category: shopping, Amount: 10, Memo: "detergent"},
+		},
+	}
+	suite.Require().NoError(transactionUseCase.AddTransaction(suite.ctx, receipt))
+	_, err = transactionUseCase.BulkRetag(suite.ctx, []int{receipt.ID}, []string{"party"})
+	suite.Require().NoError(err)
+
+	undo, redo := history.Pending()
+	assert.Equal("Retagged 1 transaction", undo)
+	assert.Empty(redo)
+
+	_, err = history.Undo(suite.ctx)
+	suite.Require().NoError(err)
+	stored, err := suite.repo.GetByID(suite.ctx, receipt.ID)
+	suite.Require().NoError(err)
+	assert.Equal([]string{"weekly"}, stored.Tags)
+
+	// Undoing the add moves it to the trash, redoing brings the same transaction back
+	description, err := history.Undo(suite.ctx)
+	suite.Require().NoError(err)
+	assert.Equal(`Added "Supermarket"`, description)
+	_, err = suite.repo.GetByID(suite.ctx, receipt.ID)
+	assert.Error(err)
+
+	_, err = history.Redo(suite.ctx)
+	suite.Require().NoError(err)
+	stored, err = suite.repo.GetByID(suite.ctx, receipt.ID)
+	suite.Require().NoError(err)
+	assert.Len(stored.Splits, 2)
+
+	// Split lines written again get new IDs, which does not make the next step stale
+	_, err = history.Redo(suite.ctx)
+	suite.Require().NoError(err)
+	_, err = history.Undo(suite.ctx)
+	suite.Require().NoError(err)
+	_, err = history.Redo(suite.ctx)
+	suite.Require().NoError(err)
+	stored, err = suite.repo.GetByID(suite.ctx, receipt.ID)
+	suite.Require().NoError(err)
+	assert.Equal([]string{"party"}, stored.Tags)
+
+	// A change made some other way stops undo from overwriting it
+	_, err = suite.repo.SetStatus(suite.ctx, []int{receipt.ID}, domain.StatusCleared)
+	suite.Require().NoError(err)
+	_, err = history.Undo(suite.ctx)
+	assert.ErrorIs(err, domain.ErrStaleCommand)
+	stored, err = suite.repo.GetByID(suite.ctx, receipt.ID)
+	suite.Require().NoError(err)
+	assert.Equal([]string{"party"}, stored.Tags)
+	undo, _ = history.Pending()
+	assert.Empty(undo)
+}
+
+func (suite *TransactionRepositoryIntegrationSuite) TestCategorySuggestions() {
+	assert := assert.New(suite.T())
+
+	categories, err := suite.categoryRepo.GetCategories(suite.ctx, "expense")
+	suite.Require().NoError(err)
+	byName := map[string]*domain.Category{}
+	for _, category := range categories {
+		byName[category.Name] = category
+	}
+	food, transport := byName["Food & Dining"], byName["Transportation"]
+
+	date := time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)
+	for _, tx := range []*domain.Transaction{
+		{Description: "Lidl Berlin", Amount: 20, Type: "expense", Date: date, Category: food},
+		{Description: "BVG ticket", Amount: 3, Type: "expense", Date: date, Category: transport},
+		{Description: "Cash", Amount: 5, Type: "expense", Date: date},
+	} {
+		suite.Require().NoError(suite.repo.Create(suite.ctx, tx))
+	}
+
+	history, err := suite.repo.GetCategoryHistory(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Len(history, 2, "uncategorized transactions teach nothing")
+	assert.Equal("Lidl Berlin", history[0].Description)
+	assert.Equal(20.0, history[0].Amount)
+	assert.Equal("expense", history[0].Type)
+	assert.Equal(food.ID, history[0].Category.ID)
+	assert.Equal("Food & Dining", history[0].Category.Name)
+
+	learner := usecase.NewCategoryLearner(suite.repo)
+	transactionUseCase := usecase.NewTransactionUseCase(suite.repo, suite.categoryRepo, sqlite.NewPayeeRepository(suite.db), sqlite.NewRuleRepository(suite.db), sqlite.NewUnitOfWork(suite.db), learner, nil)
+	suggestion, err := transactionUseCase.SuggestCategory(suite.ctx, "LIDL Hamburg", 25, "expense")
+	suite.Require().NoError(err)
+	suite.Require().NotNil(suggestion)
+	assert.Equal(food.ID, suggestion.Category.ID)
+
+	// A transaction added afterwards counts right away
+	suite.Require().NoError(transactionUseCase.AddTransaction(suite.ctx, &domain.Transaction{
+		Description: "Taxi", Amount: 18, Type: "expense", Date: date, Category: transport,
+	}))
+	suggestion, err = transactionUseCase.SuggestCategory(suite.ctx, "taxi", 18, "expense")
+	suite.Require().NoError(err)
+	suite.Require().NotNil(suggestion)
+	assert.Equal(transport.ID, suggestion.Category.ID)
+}
+
+func (suite *TransactionRepositoryIntegrationSuite) TestSplitTransactions() {
+	assert := assert.New(suite.T())
+
+	categories, err := suite.categoryRepo.GetCategories(suite.ctx, "expense")
+	suite.Require().NoError(err)
+	byName := map[string]*domain.Category{}
+	for _, category := range categories {
+		byName[category.Name] = category
+	}
+	food, shopping, health := byName["Food & Dining"], byName["Shopping"], byName["Healthcare"]
+
+	date := time.Date(2026, 10, 10, 12, 0, 0, 0, time.UTC)
+	receipt := &domain.Transaction{
+		Description: "Supermarket",
+		Amount:      58.3,
+		Date:        date,
+		Type:        "expense",
+		Category:    food,
+		Splits: []*domain.Split{
+			{Category: food, Amount: 41.1},
+			{Category: shopping, Amount: 12.4, Memo: "detergent"},
+			{Category: health, Amount: 4.8, Memo: "aspirin"},
+		},
+	}
+	suite.Require().NoError(suite.repo.Create(suite.ctx, receipt))
+	suite.Require().NoError(suite.repo.Create(suite.ctx, &domain.Transaction{
+		Description: "Restaurant", Amount: 30, Date: date, Type: "expense", Category: food,
+	}))
+
+	stored, err := suite.repo.GetByID(suite.ctx, receipt.ID)
+	suite.Require().NoError(err)
+	suite.Require().Len(stored.Splits, 3)
+	assert.Equal(receipt.Splits[1].ID, stored.Splits[1].ID)
+	assert.Equal(shopping.ID, stored.Splits[1].Category.ID)
+	assert.Equal("Shopping", stored.Splits[1].Category.Name)
+	assert.Equal(12.4, stored.Splits[1].Amount)
+	assert.Equal("detergent", stored.Splits[1].Memo)
+
+	// Totals are attributed per line
+	start, end := date.AddDate(0, 0, -1), date.AddDate(0, 0, 1)
+	breakdowns, err := suite.repo.GetCategoryTotalsByDateRange(suite.ctx, start, end, "expense")
+	suite.Require().NoError(err)
+	totals := map[string]float64{}
+	counts := map[string]int{}
+	for _, breakdown := range breakdowns {
+		totals[breakdown.Category.Name] = breakdown.TotalAmount
+		counts[breakdown.Category.Name] = breakdown.TransactionCount
+	}
+	assert.InDelta(71.1, totals["Food & Dining"], 0.001)
+	assert.InDelta(12.4, totals["Shopping"], 0.001)
+	assert.InDelta(4.8, totals["Healthcare"], 0.001)
+	assert.Equal(2, counts["Food & Dining"])
+
+	count, err := suite.repo.GetCategoryTransactionCount(suite.ctx, start, end, health.ID, "expense")
+	suite.Require().NoError(err)
+	assert.Equal(1, count)
+
+	// Updating replaces the lines
+	stored.Splits = []*domain.Split{{Category: food, Amount: 50}, {Category: shopping, Amount: 8.3}}
+	suite.Require().NoError(suite.repo.Update(suite.ctx, stored))
+	updated, err := suite.repo.GetByID(suite.ctx, receipt.ID)
+	suite.Require().NoError(err)
+	assert.Len(updated.Splits, 2)
+
+	// Re-categorizing drops the lines and undoing brings them back
+	_, err = suite.repo.BulkUpdateCategory(suite.ctx, []int{receipt.ID}, shopping.ID)
+	suite.Require().NoError(err)
+	recategorized, err := suite.repo.GetByID(suite.ctx, receipt.ID)
+	suite.Require().NoError(err)
+	assert.Empty(recategorized.Splits)
+
+	suite.Require().NoError(suite.repo.BulkRestore(suite.ctx, []*domain.Transaction{updated}))
+	restored, err := suite.repo.GetByID(suite.ctx, receipt.ID)
+	suite.Require().NoError(err)
+	assert.Len(restored.Splits, 2)
+
+	// The trash keeps the lines for a restore; purging removes them with the transaction
+	suite.Require().NoError(suite.repo.Delete(suite.ctx, receipt.ID))
+	var remaining int
+	suite.Require().NoError(suite.db.DB().QueryRow("SELECT COUNT(*) FROM transaction_splits").Scan(&remaining))
+	assert.Equal(2, remaining)
+	_, err = suite.repo.Purge(suite.ctx, []int{receipt.ID})
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.db.DB().QueryRow("SELECT COUNT(*) FROM transaction_splits").Scan(&remaining))
+	assert.Zero(remaining)
+}
@@ -0,0 +1,164 @@
+package integration
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"expense-tracker/internal/core/domain"
+	"expense-tracker/internal/core/usecase"
+	"expense-tracker/internal/repository/sqlite"
+)
+
+type UnitOfWorkIntegrationSuite struct {
+	suite.Suite
+	db              *sqlite.Database
+	uow             *sqlite.UnitOfWork
+	transactionRepo *sqlite.TransactionRepository
+	payeeRepo       *sqlite.PayeeRepository
+	ctx             context.Context
+	testDB          string
+}
+
+func (suite *UnitOfWorkIntegrationSuite) SetupSuite() {
+	suite.ctx = context.Background()
+
+	// Create a temporary database file for testing
+	tempDir := os.TempDir()
+	suite.testDB = filepath.Join(tempDir, "test_unit_of_work_expense_tracker.db")
+
+	var err error
+	suite.db, err = sqlite.NewDatabase(suite.testDB)
+	suite.Require().NoError(err)
+
+	suite.uow = sqlite.NewUnitOfWork(suite.db)
+	suite.transactionRepo = sqlite.NewTransactionRepository(suite.db)
+	suite.payeeRepo = sqlite.NewPayeeRepository(suite.db)
+}
+
+func (suite *UnitOfWorkIntegrationSuite) TearDownSuite() {
+	if suite.db != nil {
+		suite.db.Close()
+	}
+	os.Remove(suite.testDB)
+}
+
+func (suite *UnitOfWorkIntegrationSuite) SetupTest() {
+	// Clean up test data before each test
+	suite.cleanupTestData()
+}
+
+func (suite *UnitOfWorkIntegrationSuite) TearDownTest() {
+	// Clean up test data after each test
+	suite.cleanupTestData()
+}
+
+func (suite *UnitOfWorkIntegrationSuite) cleanupTestData() {
+	for _, table := range []string{"transaction_tags", "transaction_splits", "transactions", "payee_aliases", "payees"} {
+		_, err := suite.db.DB().Exec("DELETE FROM " + table)
+		suite.Require().NoError(err)
+	}
+}
+
+func TestUnitOfWorkIntegrationSuite(t *testing.T) {
+	suite.Run(t, new(UnitOfWorkIntegrationSuite))
+}
+
+// newTransaction returns a tagged expense, whose tags the repository writes in a transaction of its own
+func (suite *UnitOfWorkIntegrationSuite) newTransaction() *domain.Transaction {
+	return &domain.Transaction{
+		Description: "Bakery",
+		Amount:      4.5,
+		Date:        time.Date(2026, 10, 3, 0, 0, 0, 0, time.UTC),
+		Type:        "expense",
+		Tags:        []string{"breakfast"},
+	}
+}
+
+// count returns how many rows a table holds
+func (suite *UnitOfWorkIntegrationSuite) count(table string) int {
+	var count int
+	err := suite.db.DB().QueryRow("SELECT COUNT(*) FROM " + table).Scan(&count)
+	suite.Require().NoError(err)
+	return count
+}
+
+func (suite *UnitOfWorkIntegrationSuite) TestCommits() {
+	assert := assert.New(suite.T())
+
+	transaction := suite.newTransaction()
+	err := suite.uow.WithTx(suite.ctx, func(repos usecase.Repositories) error {
+		if err := repos.Payees.CreatePayee(suite.ctx, &domain.Payee{Name: "Bakery"}); err != nil {
+			return err
+		}
+		return repos.Transactions.Create(suite.ctx, transaction)
+	})
+	suite.Require().NoError(err)
+
+	saved, err := suite.transactionRepo.GetByID(suite.ctx, transaction.ID)
+	suite.Require().NoError(err)
+	assert.Equal([]string{"breakfast"}, saved.Tags)
+	payees, err := suite.payeeRepo.GetPayees(suite.ctx)
+	suite.Require().NoError(err)
+	assert.Len(payees, 1)
+}
+
+func (suite *UnitOfWorkIntegrationSuite) TestRollsBackOnError() {
+	assert := assert.New(suite.T())
+
+	failure := errors.New("failure")
+	err := suite.uow.WithTx(suite.ctx, func(repos usecase.Repositories) error {
+		if err := repos.Payees.CreatePayee(suite.ctx, &domain.Payee{Name: "Bakery"}); err != nil {
+			return err
+		}
+		if err := repos.Transactions.Create(suite.ctx, suite.newTransaction()); err != nil {
+			return err
+		}
+		return failure
+	})
+
+	assert.ErrorIs(err, failure)
+	assert.Zero(suite.count("transactions"))
+	assert.Zero(suite.count("transaction_tags"), "the repository's own transaction joined the outer one")
+	assert.Zero(suite.count("payees"))
+}
+
+func (suite *UnitOfWorkIntegrationSuite) TestRollsBackOnPanic() {
+	assert := assert.New(suite.T())
+
+	assert.PanicsWithValue("failure", func() {
+		suite.uow.WithTx(suite.ctx, func(repos usecase.Repositories) error {
+			if err := repos.Transactions.Create(suite.ctx, suite.newTransaction()); err != nil {
+				return err
+			}
+			panic("failure")
+		})
+	})
+
+	assert.Zero(suite.count("transactions"))
+	assert.Zero(suite.count("transaction_tags"))
+
+	// The connection went back to the pool without a transaction left open on it
+	suite.Require().NoError(suite.transactionRepo.Create(suite.ctx, suite.newTransaction()))
+	assert.Equal(1, suite.count("transactions"))
+}
+
+func (suite *UnitOfWorkIntegrationSuite) TestImportPayeesIsAllOrNothing() {
+	assert := assert.New(suite.T())
+
+	payeeUseCase := usecase.NewPayeeUseCase(suite.payeeRepo, suite.transactionRepo, suite.uow)
+	err := payeeUseCase.ImportPayees(suite.ctx, map[string][]string{
+		"Amazon":  {"amzn mktp*"},
+		"Netflix": {"**"},
+	})
+
+	assert.ErrorContains(err, `invalid payee "Netflix"`)
+	assert.Zero(suite.count("payees"), "Amazon was saved first and rolled back")
+}
@@ -24,6 +24,342 @@ func (_m *MockTransactionRepository) EXPECT() *MockTransactionRepository_Expecte
 	return &MockTransactionRepository_Expecter{mock: &_m.Mock}
 }
 
+// AssignPayee provides a mock function with given fields: ctx, description, payeeID
+func (_m *MockTransactionRepository) AssignPayee(ctx context.Context, description string, payeeID int) (int, error) {
+	ret := _m.Called(ctx, description, payeeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AssignPayee")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) (int, error)); ok {
+		return rf(ctx, description, payeeID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) int); ok {
+		r0 = rf(ctx, description, payeeID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = rf(ctx, description, payeeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTransactionRepository_AssignPayee_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AssignPayee'
+type MockTransactionRepository_AssignPayee_Call struct {
+	*mock.Call
+}
+
+// AssignPayee is a helper method to define mock.On call
+//   - ctx context.Context
+//   - description string
+//   - payeeID int
+func (_e *MockTransactionRepository_Expecter) AssignPayee(ctx interface{}, description interface{}, payeeID interface{}) *MockTransactionRepository_AssignPayee_Call {
+	return &MockTransactionRepository_AssignPayee_Call{Call: _e.mock.On("AssignPayee", ctx, description, payeeID)}
+}
+
+func (_c *MockTransactionRepository_AssignPayee_Call) Run(run func(ctx context.Context, description string, payeeID int)) *MockTransactionRepository_AssignPayee_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockTransactionRepository_AssignPayee_Call) Return(_a0 int, _a1 error) *MockTransactionRepository_AssignPayee_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTransactionRepository_AssignPayee_Call) RunAndReturn(run func(context.Context, string, int) (int, error)) *MockTransactionRepository_AssignPayee_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BulkDelete provides a mock function with given fields: ctx, ids
+func (_m *MockTransactionRepository) BulkDelete(ctx context.Context, ids []int) (int, error) {
+	ret := _m.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BulkDelete")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int) (int, error)); ok {
+		return rf(ctx, ids)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int) int); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int) error); ok {
+		r1 = rf(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTransactionRepository_BulkDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BulkDelete'
+type MockTransactionRepository_BulkDelete_Call struct {
+	*mock.Call
+}
+
+// BulkDelete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []int
+func (_e *MockTransactionRepository_Expecter) BulkDelete(ctx interface{}, ids interface{}) *MockTransactionRepository_BulkDelete_Call {
+	return &MockTransactionRepository_BulkDelete_Call{Call: _e.mock.On("BulkDelete", ctx, ids)}
+}
+
+func (_c *MockTransactionRepository_BulkDelete_Call) Run(run func(ctx context.Context, ids []int)) *MockTransactionRepository_BulkDelete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]int))
+	})
+	return _c
+}
+
+func (_c *MockTransactionRepository_BulkDelete_Call) Return(_a0 int, _a1 error) *MockTransactionRepository_BulkDelete_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTransactionRepository_BulkDelete_Call) RunAndReturn(run func(context.Context, []int) (int, error)) *MockTransactionRepository_BulkDelete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BulkRestore provides a mock function with given fields: ctx, transactions
+func (_m *MockTransactionRepository) BulkRestore(ctx context.Context, transactions []*domain.Transaction) error {
+	ret := _m.Called(ctx, transactions)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BulkRestore")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []*domain.Transaction) error); ok {
+		r0 = rf(ctx, transactions)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTransactionRepository_BulkRestore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BulkRestore'
+type MockTransactionRepository_BulkRestore_Call struct {
+	*mock.Call
+}
+
+// BulkRestore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transactions []*domain.Transaction
+func (_e *MockTransactionRepository_Expecter) BulkRestore(ctx interface{}, transactions interface{}) *MockTransactionRepository_BulkRestore_Call {
+	return &MockTransactionRepository_BulkRestore_Call{Call: _e.mock.On("BulkRestore", ctx, transactions)}
+}
+
+func (_c *MockTransactionRepository_BulkRestore_Call) Run(run func(ctx context.Context, transactions []*domain.Transaction)) *MockTransactionRepository_BulkRestore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]*domain.Transaction))
+	})
+	return _c
+}
+
+func (_c *MockTransactionRepository_BulkRestore_Call) Return(_a0 error) *MockTransactionRepository_BulkRestore_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTransactionRepository_BulkRestore_Call) RunAndReturn(run func(context.Context, []*domain.Transaction) error) *MockTransactionRepository_BulkRestore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BulkSetTags provides a mock function with given fields: ctx, ids, tags
+func (_m *MockTransactionRepository) BulkSetTags(ctx context.Context, ids []int, tags []string) (int, error) {
+	ret := _m.Called(ctx, ids, tags)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BulkSetTags")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int, []string) (int, error)); ok {
+		return rf(ctx, ids, tags)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int, []string) int); ok {
+		r0 = rf(ctx, ids, tags)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int, []string) error); ok {
+		r1 = rf(ctx, ids, tags)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTransactionRepository_BulkSetTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BulkSetTags'
+type MockTransactionRepository_BulkSetTags_Call struct {
+	*mock.Call
+}
+
+// BulkSetTags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []int
+//   - tags []string
+func (_e *MockTransactionRepository_Expecter) BulkSetTags(ctx interface{}, ids interface{}, tags interface{}) *MockTransactionRepository_BulkSetTags_Call {
+	return &MockTransactionRepository_BulkSetTags_Call{Call: _e.mock.On("BulkSetTags", ctx, ids, tags)}
+}
+
+func (_c *MockTransactionRepository_BulkSetTags_Call) Run(run func(ctx context.Context, ids []int, tags []string)) *MockTransactionRepository_BulkSetTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]int), args[2].([]string))
+	})
+	return _c
+}
+
+func (_c *MockTransactionRepository_BulkSetTags_Call) Return(_a0 int, _a1 error) *MockTransactionRepository_BulkSetTags_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTransactionRepository_BulkSetTags_Call) RunAndReturn(run func(context.Context, []int, []string) (int, error)) *MockTransactionRepository_BulkSetTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BulkShiftDate provides a mock function with given fields: ctx, ids, days
+func (_m *MockTransactionRepository) BulkShiftDate(ctx context.Context, ids []int, days int) (int, error) {
+	ret := _m.Called(ctx, ids, days)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BulkShiftDate")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int, int) (int, error)); ok {
+		return rf(ctx, ids, days)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int, int) int); ok {
+		r0 = rf(ctx, ids, days)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int, int) error); ok {
+		r1 = rf(ctx, ids, days)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTransactionRepository_BulkShiftDate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BulkShiftDate'
+type MockTransactionRepository_BulkShiftDate_Call struct {
+	*mock.Call
+}
+
+// BulkShiftDate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []int
+//   - days int
+func (_e *MockTransactionRepository_Expecter) BulkShiftDate(ctx interface{}, ids interface{}, days interface{}) *MockTransactionRepository_BulkShiftDate_Call {
+	return &MockTransactionRepository_BulkShiftDate_Call{Call: _e.mock.On("BulkShiftDate", ctx, ids, days)}
+}
+
+func (_c *MockTransactionRepository_BulkShiftDate_Call) Run(run func(ctx context.Context, ids []int, days int)) *MockTransactionRepository_BulkShiftDate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]int), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockTransactionRepository_BulkShiftDate_Call) Return(_a0 int, _a1 error) *MockTransactionRepository_BulkShiftDate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTransactionRepository_BulkShiftDate_Call) RunAndReturn(run func(context.Context, []int, int) (int, error)) *MockTransactionRepository_BulkShiftDate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BulkUpdateCategory provides a mock function with given fields: ctx, ids, categoryID
+func (_m *MockTransactionRepository) BulkUpdateCategory(ctx context.Context, ids []int, categoryID int) (int, error) {
+	ret := _m.Called(ctx, ids, categoryID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BulkUpdateCategory")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int, int) (int, error)); ok {
+		return rf(ctx, ids, categoryID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int, int) int); ok {
+		r0 = rf(ctx, ids, categoryID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int, int) error); ok {
+		r1 = rf(ctx, ids, categoryID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTransactionRepository_BulkUpdateCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BulkUpdateCategory'
+type MockTransactionRepository_BulkUpdateCategory_Call struct {
+	*mock.Call
+}
+
+// BulkUpdateCategory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []int
+//   - categoryID int
+func (_e *MockTransactionRepository_Expecter) BulkUpdateCategory(ctx interface{}, ids interface{}, categoryID interface{}) *MockTransactionRepository_BulkUpdateCategory_Call {
+	return &MockTransactionRepository_BulkUpdateCategory_Call{Call: _e.mock.On("BulkUpdateCategory", ctx, ids, categoryID)}
+}
+
+func (_c *MockTransactionRepository_BulkUpdateCategory_Call) Run(run func(ctx context.Context, ids []int, categoryID int)) *MockTransactionRepository_BulkUpdateCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]int), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockTransactionRepository_BulkUpdateCategory_Call) Return(_a0 int, _a1 error) *MockTransactionRepository_BulkUpdateCategory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTransactionRepository_BulkUpdateCategory_Call) RunAndReturn(run func(context.Context, []int, int) (int, error)) *MockTransactionRepository_BulkUpdateCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function with given fields: ctx, transaction
 func (_m *MockTransactionRepository) Create(ctx context.Context, transaction *domain.Transaction) error {
 	ret := _m.Called(ctx, transaction)
@@ -118,6 +454,53 @@ func (_c *MockTransactionRepository_Delete_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// DismissDuplicate provides a mock function with given fields: ctx, key
+func (_m *MockTransactionRepository) DismissDuplicate(ctx context.Context, key domain.DuplicateKey) error {
+	ret := _m.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DismissDuplicate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.DuplicateKey) error); ok {
+		r0 = rf(ctx, key)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTransactionRepository_DismissDuplicate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DismissDuplicate'
+type MockTransactionRepository_DismissDuplicate_Call struct {
+	*mock.Call
+}
+
+// DismissDuplicate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key domain.DuplicateKey
+func (_e *MockTransactionRepository_Expecter) DismissDuplicate(ctx interface{}, key interface{}) *MockTransactionRepository_DismissDuplicate_Call {
+	return &MockTransactionRepository_DismissDuplicate_Call{Call: _e.mock.On("DismissDuplicate", ctx, key)}
+}
+
+func (_c *MockTransactionRepository_DismissDuplicate_Call) Run(run func(ctx context.Context, key domain.DuplicateKey)) *MockTransactionRepository_DismissDuplicate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.DuplicateKey))
+	})
+	return _c
+}
+
+func (_c *MockTransactionRepository_DismissDuplicate_Call) Return(_a0 error) *MockTransactionRepository_DismissDuplicate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTransactionRepository_DismissDuplicate_Call) RunAndReturn(run func(context.Context, domain.DuplicateKey) error) *MockTransactionRepository_DismissDuplicate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetAll provides a mock function with given fields: ctx, offset, limit
 func (_m *MockTransactionRepository) GetAll(ctx context.Context, offset int, limit int) ([]*domain.Transaction, error) {
 	ret := _m.Called(ctx, offset, limit)
@@ -191,16 +574,965 @@ func (_m *MockTransactionRepository) GetByDateRange(ctx context.Context, start t
 	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) ([]*domain.Transaction, error)); ok {
 		return rf(ctx, start, end)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) []*domain.Transaction); ok {
-		r0 = rf(ctx, start, end)
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) []*domain.Transaction); ok {
+		r0 = rf(ctx, start, end)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, start, end)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTransactionRepository_GetByDateRange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByDateRange'
+type MockTransactionRepository_GetByDateRange_Call struct {
+	*mock.Call
+}
+
+// GetByDateRange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - start time.Time
+//   - end time.Time
+func (_e *MockTransactionRepository_Expecter) GetByDateRange(ctx interface{}, start interface{}, end interface{}) *MockTransactionRepository_GetByDateRange_Call {
+	return &MockTransactionRepository_GetByDateRange_Call{Call: _e.mock.On("GetByDateRange", ctx, start, end)}
+}
+
+func (_c *MockTransactionRepository_GetByDateRange_Call) Run(run func(ctx context.Context, start time.Time, end time.Time)) *MockTransactionRepository_GetByDateRange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockTransactionRepository_GetByDateRange_Call) Return(_a0 []*domain.Transaction, _a1 error) *MockTransactionRepository_GetByDateRange_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTransactionRepository_GetByDateRange_Call) RunAndReturn(run func(context.Context, time.Time, time.Time) ([]*domain.Transaction, error)) *MockTransactionRepository_GetByDateRange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *MockTransactionRepository) GetByID(ctx context.Context, id int) (*domain.Transaction, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *domain.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*domain.Transaction, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *domain.Transaction); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTransactionRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockTransactionRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *MockTransactionRepository_Expecter) GetByID(ctx interface{}, id interface{}) *MockTransactionRepository_GetByID_Call {
+	return &MockTransactionRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *MockTransactionRepository_GetByID_Call) Run(run func(ctx context.Context, id int)) *MockTransactionRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockTransactionRepository_GetByID_Call) Return(_a0 *domain.Transaction, _a1 error) *MockTransactionRepository_GetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTransactionRepository_GetByID_Call) RunAndReturn(run func(context.Context, int) (*domain.Transaction, error)) *MockTransactionRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByIDs provides a mock function with given fields: ctx, ids
+func (_m *MockTransactionRepository) GetByIDs(ctx context.Context, ids []int) ([]*domain.Transaction, error) {
+	ret := _m.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByIDs")
+	}
+
+	var r0 []*domain.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int) ([]*domain.Transaction, error)); ok {
+		return rf(ctx, ids)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int) []*domain.Transaction); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int) error); ok {
+		r1 = rf(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTransactionRepository_GetByIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByIDs'
+type MockTransactionRepository_GetByIDs_Call struct {
+	*mock.Call
+}
+
+// GetByIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []int
+func (_e *MockTransactionRepository_Expecter) GetByIDs(ctx interface{}, ids interface{}) *MockTransactionRepository_GetByIDs_Call {
+	return &MockTransactionRepository_GetByIDs_Call{Call: _e.mock.On("GetByIDs", ctx, ids)}
+}
+
+func (_c *MockTransactionRepository_GetByIDs_Call) Run(run func(ctx context.Context, ids []int)) *MockTransactionRepository_GetByIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]int))
+	})
+	return _c
+}
+
+func (_c *MockTransactionRepository_GetByIDs_Call) Return(_a0 []*domain.Transaction, _a1 error) *MockTransactionRepository_GetByIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTransactionRepository_GetByIDs_Call) RunAndReturn(run func(context.Context, []int) ([]*domain.Transaction, error)) *MockTransactionRepository_GetByIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByType provides a mock function with given fields: ctx, transactionType, offset, limit
+func (_m *MockTransactionRepository) GetByType(ctx context.Context, transactionType string, offset int, limit int) ([]*domain.Transaction, error) {
+	ret := _m.Called(ctx, transactionType, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByType")
+	}
+
+	var r0 []*domain.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int) ([]*domain.Transaction, error)); ok {
+		return rf(ctx, transactionType, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int) []*domain.Transaction); ok {
+		r0 = rf(ctx, transactionType, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, int) error); ok {
+		r1 = rf(ctx, transactionType, offset, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTransactionRepository_GetByType_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByType'
+type MockTransactionRepository_GetByType_Call struct {
+	*mock.Call
+}
+
+// GetByType is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transactionType string
+//   - offset int
+//   - limit int
+func (_e *MockTransactionRepository_Expecter) GetByType(ctx interface{}, transactionType interface{}, offset interface{}, limit interface{}) *MockTransactionRepository_GetByType_Call {
+	return &MockTransactionRepository_GetByType_Call{Call: _e.mock.On("GetByType", ctx, transactionType, offset, limit)}
+}
+
+func (_c *MockTransactionRepository_GetByType_Call) Run(run func(ctx context.Context, transactionType string, offset int, limit int)) *MockTransactionRepository_GetByType_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockTransactionRepository_GetByType_Call) Return(_a0 []*domain.Transaction, _a1 error) *MockTransactionRepository_GetByType_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTransactionRepository_GetByType_Call) RunAndReturn(run func(context.Context, string, int, int) ([]*domain.Transaction, error)) *MockTransactionRepository_GetByType_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCategoryHistory provides a mock function with given fields: ctx
+func (_m *MockTransactionRepository) GetCategoryHistory(ctx context.Context) ([]*domain.Transaction, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCategoryHistory")
+	}
+
+	var r0 []*domain.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*domain.Transaction, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*domain.Transaction); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTransactionRepository_GetCategoryHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCategoryHistory'
+type MockTransactionRepository_GetCategoryHistory_Call struct {
+	*mock.Call
+}
+
+// GetCategoryHistory is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockTransactionRepository_Expecter) GetCategoryHistory(ctx interface{}) *MockTransactionRepository_GetCategoryHistory_Call {
+	return &MockTransactionRepository_GetCategoryHistory_Call{Call: _e.mock.On("GetCategoryHistory", ctx)}
+}
+
+func (_c *MockTransactionRepository_GetCategoryHistory_Call) Run(run func(ctx context.Context)) *MockTransactionRepository_GetCategoryHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockTransactionRepository_GetCategoryHistory_Call) Return(_a0 []*domain.Transaction, _a1 error) *MockTransactionRepository_GetCategoryHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTransactionRepository_GetCategoryHistory_Call) RunAndReturn(run func(context.Context) ([]*domain.Transaction, error)) *MockTransactionRepository_GetCategoryHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCategoryTotalsByDateRange provides a mock function with given fields: ctx, start, end, transactionType
+func (_m *MockTransactionRepository) GetCategoryTotalsByDateRange(ctx context.Context, start time.Time, end time.Time, transactionType string) ([]*domain.CategoryBreakdown, error) {
+	ret := _m.Called(ctx, start, end, transactionType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCategoryTotalsByDateRange")
+	}
+
+	var r0 []*domain.CategoryBreakdown
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, string) ([]*domain.CategoryBreakdown, error)); ok {
+		return rf(ctx, start, end, transactionType)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, string) []*domain.CategoryBreakdown); ok {
+		r0 = rf(ctx, start, end, transactionType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.CategoryBreakdown)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, time.Time, string) error); ok {
+		r1 = rf(ctx, start, end, transactionType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTransactionRepository_GetCategoryTotalsByDateRange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCategoryTotalsByDateRange'
+type MockTransactionRepository_GetCategoryTotalsByDateRange_Call struct {
+	*mock.Call
+}
+
+// GetCategoryTotalsByDateRange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - start time.Time
+//   - end time.Time
+//   - transactionType string
+func (_e *MockTransactionRepository_Expecter) GetCategoryTotalsByDateRange(ctx interface{}, start interface{}, end interface{}, transactionType interface{}) *MockTransactionRepository_GetCategoryTotalsByDateRange_Call {
+	return &MockTransactionRepository_GetCategoryTotalsByDateRange_Call{Call: _e.mock.On("GetCategoryTotalsByDateRange", ctx, start, end, transactionType)}
+}
+
+func (_c *MockTransactionRepository_GetCategoryTotalsByDateRange_Call) Run(run func(ctx context.Context, start time.Time, end time.Time, transactionType string)) *MockTransactionRepository_GetCategoryTotalsByDateRange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(time.Time), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockTransactionRepository_GetCategoryTotalsByDateRange_Call) Return(_a0 []*domain.CategoryBreakdown, _a1 error) *MockTransactionRepository_GetCategoryTotalsByDateRange_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTransactionRepository_GetCategoryTotalsByDateRange_Call) RunAndReturn(run func(context.Context, time.Time, time.Time, string) ([]*domain.CategoryBreakdown, error)) *MockTransactionRepository_GetCategoryTotalsByDateRange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCategoryTransactionCount provides a mock function with given fields: ctx, start, end, categoryID, transactionType
+func (_m *MockTransactionRepository) GetCategoryTransactionCount(ctx context.Context, start time.Time, end time.Time, categoryID int, transactionType string) (int, error) {
+	ret := _m.Called(ctx, start, end, categoryID, transactionType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCategoryTransactionCount")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, int, string) (int, error)); ok {
+		return rf(ctx, start, end, categoryID, transactionType)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, int, string) int); ok {
+		r0 = rf(ctx, start, end, categoryID, transactionType)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, time.Time, int, string) error); ok {
+		r1 = rf(ctx, start, end, categoryID, transactionType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTransactionRepository_GetCategoryTransactionCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCategoryTransactionCount'
+type MockTransactionRepository_GetCategoryTransactionCount_Call struct {
+	*mock.Call
+}
+
+// GetCategoryTransactionCount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - start time.Time
+//   - end time.Time
+//   - categoryID int
+//   - transactionType string
+func (_e *MockTransactionRepository_Expecter) GetCategoryTransactionCount(ctx interface{}, start interface{}, end interface{}, categoryID interface{}, transactionType interface{}) *MockTransactionRepository_GetCategoryTransactionCount_Call {
+	return &MockTransactionRepository_GetCategoryTransactionCount_Call{Call: _e.mock.On("GetCategoryTransactionCount", ctx, start, end, categoryID, transactionType)}
+}
+
+func (_c *MockTransactionRepository_GetCategoryTransactionCount_Call) Run(run func(ctx context.Context, start time.Time, end time.Time, categoryID int, transactionType string)) *MockTransactionRepository_GetCategoryTransactionCount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(time.Time), args[3].(int), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *MockTransactionRepository_GetCategoryTransactionCount_Call) Return(_a0 int, _a1 error) *MockTransactionRepository_GetCategoryTransactionCount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTransactionRepository_GetCategoryTransactionCount_Call) RunAndReturn(run func(context.Context, time.Time, time.Time, int, string) (int, error)) *MockTransactionRepository_GetCategoryTransactionCount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDescriptionSuggestions provides a mock function with given fields: ctx, prefix, transactionType, limit
+func (_m *MockTransactionRepository) GetDescriptionSuggestions(ctx context.Context, prefix string, transactionType string, limit int) ([]*domain.DescriptionSuggestion, error) {
+	ret := _m.Called(ctx, prefix, transactionType, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDescriptionSuggestions")
+	}
+
+	var r0 []*domain.DescriptionSuggestion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int) ([]*domain.DescriptionSuggestion, error)); ok {
+		return rf(ctx, prefix, transactionType, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int) []*domain.DescriptionSuggestion); ok {
+		r0 = rf(ctx, prefix, transactionType, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.DescriptionSuggestion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int) error); ok {
+		r1 = rf(ctx, prefix, transactionType, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTransactionRepository_GetDescriptionSuggestions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDescriptionSuggestions'
+type MockTransactionRepository_GetDescriptionSuggestions_Call struct {
+	*mock.Call
+}
+
+// GetDescriptionSuggestions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - prefix string
+//   - transactionType string
+//   - limit int
+func (_e *MockTransactionRepository_Expecter) GetDescriptionSuggestions(ctx interface{}, prefix interface{}, transactionType interface{}, limit interface{}) *MockTransactionRepository_GetDescriptionSuggestions_Call {
+	return &MockTransactionRepository_GetDescriptionSuggestions_Call{Call: _e.mock.On("GetDescriptionSuggestions", ctx, prefix, transactionType, limit)}
+}
+
+func (_c *MockTransactionRepository_GetDescriptionSuggestions_Call) Run(run func(ctx context.Context, prefix string, transactionType string, limit int)) *MockTransactionRepository_GetDescriptionSuggestions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockTransactionRepository_GetDescriptionSuggestions_Call) Return(_a0 []*domain.DescriptionSuggestion, _a1 error) *MockTransactionRepository_GetDescriptionSuggestions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTransactionRepository_GetDescriptionSuggestions_Call) RunAndReturn(run func(context.Context, string, string, int) ([]*domain.DescriptionSuggestion, error)) *MockTransactionRepository_GetDescriptionSuggestions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDescriptionsWithoutPayee provides a mock function with given fields: ctx
+func (_m *MockTransactionRepository) GetDescriptionsWithoutPayee(ctx context.Context) ([]string, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDescriptionsWithoutPayee")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]string, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []string); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTransactionRepository_GetDescriptionsWithoutPayee_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDescriptionsWithoutPayee'
+type MockTransactionRepository_GetDescriptionsWithoutPayee_Call struct {
+	*mock.Call
+}
+
+// GetDescriptionsWithoutPayee is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockTransactionRepository_Expecter) GetDescriptionsWithoutPayee(ctx interface{}) *MockTransactionRepository_GetDescriptionsWithoutPayee_Call {
+	return &MockTransactionRepository_GetDescriptionsWithoutPayee_Call{Call: _e.mock.On("GetDescriptionsWithoutPayee", ctx)}
+}
+
+func (_c *MockTransactionRepository_GetDescriptionsWithoutPayee_Call) Run(run func(ctx context.Context)) *MockTransactionRepository_GetDescriptionsWithoutPayee_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockTransactionRepository_GetDescriptionsWithoutPayee_Call) Return(_a0 []string, _a1 error) *MockTransactionRepository_GetDescriptionsWithoutPayee_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTransactionRepository_GetDescriptionsWithoutPayee_Call) RunAndReturn(run func(context.Context) ([]string, error)) *MockTransactionRepository_GetDescriptionsWithoutPayee_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDismissedDuplicates provides a mock function with given fields: ctx
+func (_m *MockTransactionRepository) GetDismissedDuplicates(ctx context.Context) ([]domain.DuplicateKey, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDismissedDuplicates")
+	}
+
+	var r0 []domain.DuplicateKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]domain.DuplicateKey, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []domain.DuplicateKey); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.DuplicateKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTransactionRepository_GetDismissedDuplicates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDismissedDuplicates'
+type MockTransactionRepository_GetDismissedDuplicates_Call struct {
+	*mock.Call
+}
+
+// GetDismissedDuplicates is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockTransactionRepository_Expecter) GetDismissedDuplicates(ctx interface{}) *MockTransactionRepository_GetDismissedDuplicates_Call {
+	return &MockTransactionRepository_GetDismissedDuplicates_Call{Call: _e.mock.On("GetDismissedDuplicates", ctx)}
+}
+
+func (_c *MockTransactionRepository_GetDismissedDuplicates_Call) Run(run func(ctx context.Context)) *MockTransactionRepository_GetDismissedDuplicates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockTransactionRepository_GetDismissedDuplicates_Call) Return(_a0 []domain.DuplicateKey, _a1 error) *MockTransactionRepository_GetDismissedDuplicates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTransactionRepository_GetDismissedDuplicates_Call) RunAndReturn(run func(context.Context) ([]domain.DuplicateKey, error)) *MockTransactionRepository_GetDismissedDuplicates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLastStatement provides a mock function with given fields: ctx
+func (_m *MockTransactionRepository) GetLastStatement(ctx context.Context) (*domain.Statement, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLastStatement")
+	}
+
+	var r0 *domain.Statement
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*domain.Statement, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *domain.Statement); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Statement)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTransactionRepository_GetLastStatement_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLastStatement'
+type MockTransactionRepository_GetLastStatement_Call struct {
+	*mock.Call
+}
+
+// GetLastStatement is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockTransactionRepository_Expecter) GetLastStatement(ctx interface{}) *MockTransactionRepository_GetLastStatement_Call {
+	return &MockTransactionRepository_GetLastStatement_Call{Call: _e.mock.On("GetLastStatement", ctx)}
+}
+
+func (_c *MockTransactionRepository_GetLastStatement_Call) Run(run func(ctx context.Context)) *MockTransactionRepository_GetLastStatement_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockTransactionRepository_GetLastStatement_Call) Return(_a0 *domain.Statement, _a1 error) *MockTransactionRepository_GetLastStatement_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTransactionRepository_GetLastStatement_Call) RunAndReturn(run func(context.Context) (*domain.Statement, error)) *MockTransactionRepository_GetLastStatement_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPage provides a mock function with given fields: ctx, request
+func (_m *MockTransactionRepository) GetPage(ctx context.Context, request *domain.PageRequest) (*domain.TransactionPage, error) {
+	ret := _m.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPage")
+	}
+
+	var r0 *domain.TransactionPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.PageRequest) (*domain.TransactionPage, error)); ok {
+		return rf(ctx, request)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.PageRequest) *domain.TransactionPage); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.TransactionPage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.PageRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTransactionRepository_GetPage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPage'
+type MockTransactionRepository_GetPage_Call struct {
+	*mock.Call
+}
+
+// GetPage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *domain.PageRequest
+func (_e *MockTransactionRepository_Expecter) GetPage(ctx interface{}, request interface{}) *MockTransactionRepository_GetPage_Call {
+	return &MockTransactionRepository_GetPage_Call{Call: _e.mock.On("GetPage", ctx, request)}
+}
+
+func (_c *MockTransactionRepository_GetPage_Call) Run(run func(ctx context.Context, request *domain.PageRequest)) *MockTransactionRepository_GetPage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.PageRequest))
+	})
+	return _c
+}
+
+func (_c *MockTransactionRepository_GetPage_Call) Return(_a0 *domain.TransactionPage, _a1 error) *MockTransactionRepository_GetPage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTransactionRepository_GetPage_Call) RunAndReturn(run func(context.Context, *domain.PageRequest) (*domain.TransactionPage, error)) *MockTransactionRepository_GetPage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPayeeTotalsByDateRange provides a mock function with given fields: ctx, start, end, transactionType
+func (_m *MockTransactionRepository) GetPayeeTotalsByDateRange(ctx context.Context, start time.Time, end time.Time, transactionType string) ([]*domain.PayeeBreakdown, error) {
+	ret := _m.Called(ctx, start, end, transactionType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPayeeTotalsByDateRange")
+	}
+
+	var r0 []*domain.PayeeBreakdown
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, string) ([]*domain.PayeeBreakdown, error)); ok {
+		return rf(ctx, start, end, transactionType)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, string) []*domain.PayeeBreakdown); ok {
+		r0 = rf(ctx, start, end, transactionType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.PayeeBreakdown)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, time.Time, string) error); ok {
+		r1 = rf(ctx, start, end, transactionType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTransactionRepository_GetPayeeTotalsByDateRange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPayeeTotalsByDateRange'
+type MockTransactionRepository_GetPayeeTotalsByDateRange_Call struct {
+	*mock.Call
+}
+
+// GetPayeeTotalsByDateRange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - start time.Time
+//   - end time.Time
+//   - transactionType string
+func (_e *MockTransactionRepository_Expecter) GetPayeeTotalsByDateRange(ctx interface{}, start interface{}, end interface{}, transactionType interface{}) *MockTransactionRepository_GetPayeeTotalsByDateRange_Call {
+	return &MockTransactionRepository_GetPayeeTotalsByDateRange_Call{Call: _e.mock.On("GetPayeeTotalsByDateRange", ctx, start, end, transactionType)}
+}
+
+func (_c *MockTransactionRepository_GetPayeeTotalsByDateRange_Call) Run(run func(ctx context.Context, start time.Time, end time.Time, transactionType string)) *MockTransactionRepository_GetPayeeTotalsByDateRange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(time.Time), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockTransactionRepository_GetPayeeTotalsByDateRange_Call) Return(_a0 []*domain.PayeeBreakdown, _a1 error) *MockTransactionRepository_GetPayeeTotalsByDateRange_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTransactionRepository_GetPayeeTotalsByDateRange_Call) RunAndReturn(run func(context.Context, time.Time, time.Time, string) ([]*domain.PayeeBreakdown, error)) *MockTransactionRepository_GetPayeeTotalsByDateRange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRecentCategoryIDs provides a mock function with given fields: ctx, transactionType, limit
+func (_m *MockTransactionRepository) GetRecentCategoryIDs(ctx context.Context, transactionType string, limit int) ([]int, error) {
+	ret := _m.Called(ctx, transactionType, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRecentCategoryIDs")
+	}
+
+	var r0 []int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) ([]int, error)); ok {
+		return rf(ctx, transactionType, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) []int); ok {
+		r0 = rf(ctx, transactionType, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = rf(ctx, transactionType, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTransactionRepository_GetRecentCategoryIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRecentCategoryIDs'
+type MockTransactionRepository_GetRecentCategoryIDs_Call struct {
+	*mock.Call
+}
+
+// GetRecentCategoryIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transactionType string
+//   - limit int
+func (_e *MockTransactionRepository_Expecter) GetRecentCategoryIDs(ctx interface{}, transactionType interface{}, limit interface{}) *MockTransactionRepository_GetRecentCategoryIDs_Call {
+	return &MockTransactionRepository_GetRecentCategoryIDs_Call{Call: _e.mock.On("GetRecentCategoryIDs", ctx, transactionType, limit)}
+}
+
+func (_c *MockTransactionRepository_GetRecentCategoryIDs_Call) Run(run func(ctx context.Context, transactionType string, limit int)) *MockTransactionRepository_GetRecentCategoryIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockTransactionRepository_GetRecentCategoryIDs_Call) Return(_a0 []int, _a1 error) *MockTransactionRepository_GetRecentCategoryIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTransactionRepository_GetRecentCategoryIDs_Call) RunAndReturn(run func(context.Context, string, int) ([]int, error)) *MockTransactionRepository_GetRecentCategoryIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRecentTransactions provides a mock function with given fields: ctx, limit
+func (_m *MockTransactionRepository) GetRecentTransactions(ctx context.Context, limit int) ([]*domain.Transaction, error) {
+	ret := _m.Called(ctx, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRecentTransactions")
+	}
+
+	var r0 []*domain.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]*domain.Transaction, error)); ok {
+		return rf(ctx, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []*domain.Transaction); ok {
+		r0 = rf(ctx, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTransactionRepository_GetRecentTransactions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRecentTransactions'
+type MockTransactionRepository_GetRecentTransactions_Call struct {
+	*mock.Call
+}
+
+// GetRecentTransactions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+func (_e *MockTransactionRepository_Expecter) GetRecentTransactions(ctx interface{}, limit interface{}) *MockTransactionRepository_GetRecentTransactions_Call {
+	return &MockTransactionRepository_GetRecentTransactions_Call{Call: _e.mock.On("GetRecentTransactions", ctx, limit)}
+}
+
+func (_c *MockTransactionRepository_GetRecentTransactions_Call) Run(run func(ctx context.Context, limit int)) *MockTransactionRepository_GetRecentTransactions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockTransactionRepository_GetRecentTransactions_Call) Return(_a0 []*domain.Transaction, _a1 error) *MockTransactionRepository_GetRecentTransactions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTransactionRepository_GetRecentTransactions_Call) RunAndReturn(run func(context.Context, int) ([]*domain.Transaction, error)) *MockTransactionRepository_GetRecentTransactions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTotalByDateRange provides a mock function with given fields: ctx, start, end, transactionType
+func (_m *MockTransactionRepository) GetTotalByDateRange(ctx context.Context, start time.Time, end time.Time, transactionType string) (float64, error) {
+	ret := _m.Called(ctx, start, end, transactionType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTotalByDateRange")
+	}
+
+	var r0 float64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, string) (float64, error)); ok {
+		return rf(ctx, start, end, transactionType)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, string) float64); ok {
+		r0 = rf(ctx, start, end, transactionType)
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, time.Time, string) error); ok {
+		r1 = rf(ctx, start, end, transactionType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTransactionRepository_GetTotalByDateRange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTotalByDateRange'
+type MockTransactionRepository_GetTotalByDateRange_Call struct {
+	*mock.Call
+}
+
+// GetTotalByDateRange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - start time.Time
+//   - end time.Time
+//   - transactionType string
+func (_e *MockTransactionRepository_Expecter) GetTotalByDateRange(ctx interface{}, start interface{}, end interface{}, transactionType interface{}) *MockTransactionRepository_GetTotalByDateRange_Call {
+	return &MockTransactionRepository_GetTotalByDateRange_Call{Call: _e.mock.On("GetTotalByDateRange", ctx, start, end, transactionType)}
+}
+
+func (_c *MockTransactionRepository_GetTotalByDateRange_Call) Run(run func(ctx context.Context, start time.Time, end time.Time, transactionType string)) *MockTransactionRepository_GetTotalByDateRange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(time.Time), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockTransactionRepository_GetTotalByDateRange_Call) Return(_a0 float64, _a1 error) *MockTransactionRepository_GetTotalByDateRange_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTransactionRepository_GetTotalByDateRange_Call) RunAndReturn(run func(context.Context, time.Time, time.Time, string) (float64, error)) *MockTransactionRepository_GetTotalByDateRange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTransactionCountByDateRange provides a mock function with given fields: ctx, start, end, transactionType
+func (_m *MockTransactionRepository) GetTransactionCountByDateRange(ctx context.Context, start time.Time, end time.Time, transactionType string) (int, error) {
+	ret := _m.Called(ctx, start, end, transactionType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTransactionCountByDateRange")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, string) (int, error)); ok {
+		return rf(ctx, start, end, transactionType)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, string) int); ok {
+		r0 = rf(ctx, start, end, transactionType)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*domain.Transaction)
-		}
+		r0 = ret.Get(0).(int)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, time.Time, time.Time) error); ok {
-		r1 = rf(ctx, start, end)
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, time.Time, string) error); ok {
+		r1 = rf(ctx, start, end, transactionType)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -208,59 +1540,60 @@ func (_m *MockTransactionRepository) GetByDateRange(ctx context.Context, start t
 	return r0, r1
 }
 
-// MockTransactionRepository_GetByDateRange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByDateRange'
-type MockTransactionRepository_GetByDateRange_Call struct {
+// MockTransactionRepository_GetTransactionCountByDateRange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTransactionCountByDateRange'
+type MockTransactionRepository_GetTransactionCountByDateRange_Call struct {
 	*mock.Call
 }
 
-// GetByDateRange is a helper method to define mock.On call
+// GetTransactionCountByDateRange is a helper method to define mock.On call
 //   - ctx context.Context
 //   - start time.Time
 //   - end time.Time
-func (_e *MockTransactionRepository_Expecter) GetByDateRange(ctx interface{}, start interface{}, end interface{}) *MockTransactionRepository_GetByDateRange_Call {
-	return &MockTransactionRepository_GetByDateRange_Call{Call: _e.mock.On("GetByDateRange", ctx, start, end)}
+//   - transactionType string
+func (_e *MockTransactionRepository_Expecter) GetTransactionCountByDateRange(ctx interface{}, start interface{}, end interface{}, transactionType interface{}) *MockTransactionRepository_GetTransactionCountByDateRange_Call {
+	return &MockTransactionRepository_GetTransactionCountByDateRange_Call{Call: _e.mock.On("GetTransactionCountByDateRange", ctx, start, end, transactionType)}
 }
 
-func (_c *MockTransactionRepository_GetByDateRange_Call) Run(run func(ctx context.Context, start time.Time, end time.Time)) *MockTransactionRepository_GetByDateRange_Call {
+func (_c *MockTransactionRepository_GetTransactionCountByDateRange_Call) Run(run func(ctx context.Context, start time.Time, end time.Time, transactionType string)) *MockTransactionRepository_GetTransactionCountByDateRange_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(time.Time), args[2].(time.Time))
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(time.Time), args[3].(string))
 	})
 	return _c
 }
 
-func (_c *MockTransactionRepository_GetByDateRange_Call) Return(_a0 []*domain.Transaction, _a1 error) *MockTransactionRepository_GetByDateRange_Call {
+func (_c *MockTransactionRepository_GetTransactionCountByDateRange_Call) Return(_a0 int, _a1 error) *MockTransactionRepository_GetTransactionCountByDateRange_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockTransactionRepository_GetByDateRange_Call) RunAndReturn(run func(context.Context, time.Time, time.Time) ([]*domain.Transaction, error)) *MockTransactionRepository_GetByDateRange_Call {
+func (_c *MockTransactionRepository_GetTransactionCountByDateRange_Call) RunAndReturn(run func(context.Context, time.Time, time.Time, string) (int, error)) *MockTransactionRepository_GetTransactionCountByDateRange_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetByID provides a mock function with given fields: ctx, id
-func (_m *MockTransactionRepository) GetByID(ctx context.Context, id int) (*domain.Transaction, error) {
-	ret := _m.Called(ctx, id)
+// GetTrash provides a mock function with given fields: ctx
+func (_m *MockTransactionRepository) GetTrash(ctx context.Context) ([]*domain.Transaction, error) {
+	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetByID")
+		panic("no return value specified for GetTrash")
 	}
 
-	var r0 *domain.Transaction
+	var r0 []*domain.Transaction
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int) (*domain.Transaction, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*domain.Transaction, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int) *domain.Transaction); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context) []*domain.Transaction); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.Transaction)
+			r0 = ret.Get(0).([]*domain.Transaction)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -268,58 +1601,57 @@ func (_m *MockTransactionRepository) GetByID(ctx context.Context, id int) (*doma
 	return r0, r1
 }
 
-// MockTransactionRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
-type MockTransactionRepository_GetByID_Call struct {
+// MockTransactionRepository_GetTrash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTrash'
+type MockTransactionRepository_GetTrash_Call struct {
 	*mock.Call
 }
 
-// GetByID is a helper method to define mock.On call
+// GetTrash is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id int
-func (_e *MockTransactionRepository_Expecter) GetByID(ctx interface{}, id interface{}) *MockTransactionRepository_GetByID_Call {
-	return &MockTransactionRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+func (_e *MockTransactionRepository_Expecter) GetTrash(ctx interface{}) *MockTransactionRepository_GetTrash_Call {
+	return &MockTransactionRepository_GetTrash_Call{Call: _e.mock.On("GetTrash", ctx)}
 }
 
-func (_c *MockTransactionRepository_GetByID_Call) Run(run func(ctx context.Context, id int)) *MockTransactionRepository_GetByID_Call {
+func (_c *MockTransactionRepository_GetTrash_Call) Run(run func(ctx context.Context)) *MockTransactionRepository_GetTrash_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int))
+		run(args[0].(context.Context))
 	})
 	return _c
 }
 
-func (_c *MockTransactionRepository_GetByID_Call) Return(_a0 *domain.Transaction, _a1 error) *MockTransactionRepository_GetByID_Call {
+func (_c *MockTransactionRepository_GetTrash_Call) Return(_a0 []*domain.Transaction, _a1 error) *MockTransactionRepository_GetTrash_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockTransactionRepository_GetByID_Call) RunAndReturn(run func(context.Context, int) (*domain.Transaction, error)) *MockTransactionRepository_GetByID_Call {
+func (_c *MockTransactionRepository_GetTrash_Call) RunAndReturn(run func(context.Context) ([]*domain.Transaction, error)) *MockTransactionRepository_GetTrash_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetByType provides a mock function with given fields: ctx, transactionType, offset, limit
-func (_m *MockTransactionRepository) GetByType(ctx context.Context, transactionType string, offset int, limit int) ([]*domain.Transaction, error) {
-	ret := _m.Called(ctx, transactionType, offset, limit)
+// GetUnreconciled provides a mock function with given fields: ctx, end
+func (_m *MockTransactionRepository) GetUnreconciled(ctx context.Context, end time.Time) ([]*domain.Transaction, error) {
+	ret := _m.Called(ctx, end)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetByType")
+		panic("no return value specified for GetUnreconciled")
 	}
 
 	var r0 []*domain.Transaction
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, int, int) ([]*domain.Transaction, error)); ok {
-		return rf(ctx, transactionType, offset, limit)
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]*domain.Transaction, error)); ok {
+		return rf(ctx, end)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, int, int) []*domain.Transaction); ok {
-		r0 = rf(ctx, transactionType, offset, limit)
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []*domain.Transaction); ok {
+		r0 = rf(ctx, end)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*domain.Transaction)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, int, int) error); ok {
-		r1 = rf(ctx, transactionType, offset, limit)
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, end)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -327,119 +1659,104 @@ func (_m *MockTransactionRepository) GetByType(ctx context.Context, transactionT
 	return r0, r1
 }
 
-// MockTransactionRepository_GetByType_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByType'
-type MockTransactionRepository_GetByType_Call struct {
+// MockTransactionRepository_GetUnreconciled_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUnreconciled'
+type MockTransactionRepository_GetUnreconciled_Call struct {
 	*mock.Call
 }
 
-// GetByType is a helper method to define mock.On call
+// GetUnreconciled is a helper method to define mock.On call
 //   - ctx context.Context
-//   - transactionType string
-//   - offset int
-//   - limit int
-func (_e *MockTransactionRepository_Expecter) GetByType(ctx interface{}, transactionType interface{}, offset interface{}, limit interface{}) *MockTransactionRepository_GetByType_Call {
-	return &MockTransactionRepository_GetByType_Call{Call: _e.mock.On("GetByType", ctx, transactionType, offset, limit)}
+//   - end time.Time
+func (_e *MockTransactionRepository_Expecter) GetUnreconciled(ctx interface{}, end interface{}) *MockTransactionRepository_GetUnreconciled_Call {
+	return &MockTransactionRepository_GetUnreconciled_Call{Call: _e.mock.On("GetUnreconciled", ctx, end)}
 }
 
-func (_c *MockTransactionRepository_GetByType_Call) Run(run func(ctx context.Context, transactionType string, offset int, limit int)) *MockTransactionRepository_GetByType_Call {
+func (_c *MockTransactionRepository_GetUnreconciled_Call) Run(run func(ctx context.Context, end time.Time)) *MockTransactionRepository_GetUnreconciled_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(int), args[3].(int))
+		run(args[0].(context.Context), args[1].(time.Time))
 	})
 	return _c
 }
 
-func (_c *MockTransactionRepository_GetByType_Call) Return(_a0 []*domain.Transaction, _a1 error) *MockTransactionRepository_GetByType_Call {
+func (_c *MockTransactionRepository_GetUnreconciled_Call) Return(_a0 []*domain.Transaction, _a1 error) *MockTransactionRepository_GetUnreconciled_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockTransactionRepository_GetByType_Call) RunAndReturn(run func(context.Context, string, int, int) ([]*domain.Transaction, error)) *MockTransactionRepository_GetByType_Call {
+func (_c *MockTransactionRepository_GetUnreconciled_Call) RunAndReturn(run func(context.Context, time.Time) ([]*domain.Transaction, error)) *MockTransactionRepository_GetUnreconciled_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetCategoryTotalsByDateRange provides a mock function with given fields: ctx, start, end, transactionType
-func (_m *MockTransactionRepository) GetCategoryTotalsByDateRange(ctx context.Context, start time.Time, end time.Time, transactionType string) ([]*domain.CategoryBreakdown, error) {
-	ret := _m.Called(ctx, start, end, transactionType)
+// MergeDuplicate provides a mock function with given fields: ctx, merged, duplicateID
+func (_m *MockTransactionRepository) MergeDuplicate(ctx context.Context, merged *domain.Transaction, duplicateID int) error {
+	ret := _m.Called(ctx, merged, duplicateID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetCategoryTotalsByDateRange")
-	}
-
-	var r0 []*domain.CategoryBreakdown
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, string) ([]*domain.CategoryBreakdown, error)); ok {
-		return rf(ctx, start, end, transactionType)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, string) []*domain.CategoryBreakdown); ok {
-		r0 = rf(ctx, start, end, transactionType)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*domain.CategoryBreakdown)
-		}
+		panic("no return value specified for MergeDuplicate")
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, time.Time, time.Time, string) error); ok {
-		r1 = rf(ctx, start, end, transactionType)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Transaction, int) error); ok {
+		r0 = rf(ctx, merged, duplicateID)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockTransactionRepository_GetCategoryTotalsByDateRange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCategoryTotalsByDateRange'
-type MockTransactionRepository_GetCategoryTotalsByDateRange_Call struct {
+// MockTransactionRepository_MergeDuplicate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MergeDuplicate'
+type MockTransactionRepository_MergeDuplicate_Call struct {
 	*mock.Call
 }
 
-// GetCategoryTotalsByDateRange is a helper method to define mock.On call
+// MergeDuplicate is a helper method to define mock.On call
 //   - ctx context.Context
-//   - start time.Time
-//   - end time.Time
-//   - transactionType string
-func (_e *MockTransactionRepository_Expecter) GetCategoryTotalsByDateRange(ctx interface{}, start interface{}, end interface{}, transactionType interface{}) *MockTransactionRepository_GetCategoryTotalsByDateRange_Call {
-	return &MockTransactionRepository_GetCategoryTotalsByDateRange_Call{Call: _e.mock.On("GetCategoryTotalsByDateRange", ctx, start, end, transactionType)}
+//   - merged *domain.Transaction
+//   - duplicateID int
+func (_e *MockTransactionRepository_Expecter) MergeDuplicate(ctx interface{}, merged interface{}, duplicateID interface{}) *MockTransactionRepository_MergeDuplicate_Call {
+	return &MockTransactionRepository_MergeDuplicate_Call{Call: _e.mock.On("MergeDuplicate", ctx, merged, duplicateID)}
 }
 
-func (_c *MockTransactionRepository_GetCategoryTotalsByDateRange_Call) Run(run func(ctx context.Context, start time.Time, end time.Time, transactionType string)) *MockTransactionRepository_GetCategoryTotalsByDateRange_Call {
+func (_c *MockTransactionRepository_MergeDuplicate_Call) Run(run func(ctx context.Context, merged *domain.Transaction, duplicateID int)) *MockTransactionRepository_MergeDuplicate_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(time.Time), args[2].(time.Time), args[3].(string))
+		run(args[0].(context.Context), args[1].(*domain.Transaction), args[2].(int))
 	})
 	return _c
 }
 
-func (_c *MockTransactionRepository_GetCategoryTotalsByDateRange_Call) Return(_a0 []*domain.CategoryBreakdown, _a1 error) *MockTransactionRepository_GetCategoryTotalsByDateRange_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockTransactionRepository_MergeDuplicate_Call) Return(_a0 error) *MockTransactionRepository_MergeDuplicate_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockTransactionRepository_GetCategoryTotalsByDateRange_Call) RunAndReturn(run func(context.Context, time.Time, time.Time, string) ([]*domain.CategoryBreakdown, error)) *MockTransactionRepository_GetCategoryTotalsByDateRange_Call {
+func (_c *MockTransactionRepository_MergeDuplicate_Call) RunAndReturn(run func(context.Context, *domain.Transaction, int) error) *MockTransactionRepository_MergeDuplicate_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetCategoryTransactionCount provides a mock function with given fields: ctx, start, end, categoryID, transactionType
-func (_m *MockTransactionRepository) GetCategoryTransactionCount(ctx context.Context, start time.Time, end time.Time, categoryID int, transactionType string) (int, error) {
-	ret := _m.Called(ctx, start, end, categoryID, transactionType)
+// Purge provides a mock function with given fields: ctx, ids
+func (_m *MockTransactionRepository) Purge(ctx context.Context, ids []int) (int, error) {
+	ret := _m.Called(ctx, ids)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetCategoryTransactionCount")
+		panic("no return value specified for Purge")
 	}
 
 	var r0 int
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, int, string) (int, error)); ok {
-		return rf(ctx, start, end, categoryID, transactionType)
+	if rf, ok := ret.Get(0).(func(context.Context, []int) (int, error)); ok {
+		return rf(ctx, ids)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, int, string) int); ok {
-		r0 = rf(ctx, start, end, categoryID, transactionType)
+	if rf, ok := ret.Get(0).(func(context.Context, []int) int); ok {
+		r0 = rf(ctx, ids)
 	} else {
 		r0 = ret.Get(0).(int)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, time.Time, time.Time, int, string) error); ok {
-		r1 = rf(ctx, start, end, categoryID, transactionType)
+	if rf, ok := ret.Get(1).(func(context.Context, []int) error); ok {
+		r1 = rf(ctx, ids)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -447,61 +1764,58 @@ func (_m *MockTransactionRepository) GetCategoryTransactionCount(ctx context.Con
 	return r0, r1
 }
 
-// MockTransactionRepository_GetCategoryTransactionCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCategoryTransactionCount'
-type MockTransactionRepository_GetCategoryTransactionCount_Call struct {
+// MockTransactionRepository_Purge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Purge'
+type MockTransactionRepository_Purge_Call struct {
 	*mock.Call
 }
 
-// GetCategoryTransactionCount is a helper method to define mock.On call
+// Purge is a helper method to define mock.On call
 //   - ctx context.Context
-//   - start time.Time
-//   - end time.Time
-//   - categoryID int
-//   - transactionType string
-func (_e *MockTransactionRepository_Expecter) GetCategoryTransactionCount(ctx interface{}, start interface{}, end interface{}, categoryID interface{}, transactionType interface{}) *MockTransactionRepository_GetCategoryTransactionCount_Call {
-	return &MockTransactionRepository_GetCategoryTransactionCount_Call{Call: _e.mock.On("GetCategoryTransactionCount", ctx, start, end, categoryID, transactionType)}
+//   - ids []int
+func (_e *MockTransactionRepository_Expecter) Purge(ctx interface{}, ids interface{}) *MockTransactionRepository_Purge_Call {
+	return &MockTransactionRepository_Purge_Call{Call: _e.mock.On("Purge", ctx, ids)}
 }
 
-func (_c *MockTransactionRepository_GetCategoryTransactionCount_Call) Run(run func(ctx context.Context, start time.Time, end time.Time, categoryID int, transactionType string)) *MockTransactionRepository_GetCategoryTransactionCount_Call {
+func (_c *MockTransactionRepository_Purge_Call) Run(run func(ctx context.Context, ids []int)) *MockTransactionRepository_Purge_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(time.Time), args[2].(time.Time), args[3].(int), args[4].(string))
+		run(args[0].(context.Context), args[1].([]int))
 	})
 	return _c
 }
 
-func (_c *MockTransactionRepository_GetCategoryTransactionCount_Call) Return(_a0 int, _a1 error) *MockTransactionRepository_GetCategoryTransactionCount_Call {
+func (_c *MockTransactionRepository_Purge_Call) Return(_a0 int, _a1 error) *MockTransactionRepository_Purge_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockTransactionRepository_GetCategoryTransactionCount_Call) RunAndReturn(run func(context.Context, time.Time, time.Time, int, string) (int, error)) *MockTransactionRepository_GetCategoryTransactionCount_Call {
+func (_c *MockTransactionRepository_Purge_Call) RunAndReturn(run func(context.Context, []int) (int, error)) *MockTransactionRepository_Purge_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetRecentTransactions provides a mock function with given fields: ctx, limit
-func (_m *MockTransactionRepository) GetRecentTransactions(ctx context.Context, limit int) ([]*domain.Transaction, error) {
-	ret := _m.Called(ctx, limit)
+// PurgeDeletedBefore provides a mock function with given fields: ctx, before
+func (_m *MockTransactionRepository) PurgeDeletedBefore(ctx context.Context, before time.Time) ([]int, error) {
+	ret := _m.Called(ctx, before)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetRecentTransactions")
+		panic("no return value specified for PurgeDeletedBefore")
 	}
 
-	var r0 []*domain.Transaction
+	var r0 []int
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int) ([]*domain.Transaction, error)); ok {
-		return rf(ctx, limit)
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]int, error)); ok {
+		return rf(ctx, before)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int) []*domain.Transaction); ok {
-		r0 = rf(ctx, limit)
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []int); ok {
+		r0 = rf(ctx, before)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*domain.Transaction)
+			r0 = ret.Get(0).([]int)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
-		r1 = rf(ctx, limit)
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, before)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -509,115 +1823,104 @@ func (_m *MockTransactionRepository) GetRecentTransactions(ctx context.Context,
 	return r0, r1
 }
 
-// MockTransactionRepository_GetRecentTransactions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRecentTransactions'
-type MockTransactionRepository_GetRecentTransactions_Call struct {
+// MockTransactionRepository_PurgeDeletedBefore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeDeletedBefore'
+type MockTransactionRepository_PurgeDeletedBefore_Call struct {
 	*mock.Call
 }
 
-// GetRecentTransactions is a helper method to define mock.On call
+// PurgeDeletedBefore is a helper method to define mock.On call
 //   - ctx context.Context
-//   - limit int
-func (_e *MockTransactionRepository_Expecter) GetRecentTransactions(ctx interface{}, limit interface{}) *MockTransactionRepository_GetRecentTransactions_Call {
-	return &MockTransactionRepository_GetRecentTransactions_Call{Call: _e.mock.On("GetRecentTransactions", ctx, limit)}
+//   - before time.Time
+func (_e *MockTransactionRepository_Expecter) PurgeDeletedBefore(ctx interface{}, before interface{}) *MockTransactionRepository_PurgeDeletedBefore_Call {
+	return &MockTransactionRepository_PurgeDeletedBefore_Call{Call: _e.mock.On("PurgeDeletedBefore", ctx, before)}
 }
 
-func (_c *MockTransactionRepository_GetRecentTransactions_Call) Run(run func(ctx context.Context, limit int)) *MockTransactionRepository_GetRecentTransactions_Call {
+func (_c *MockTransactionRepository_PurgeDeletedBefore_Call) Run(run func(ctx context.Context, before time.Time)) *MockTransactionRepository_PurgeDeletedBefore_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int))
+		run(args[0].(context.Context), args[1].(time.Time))
 	})
 	return _c
 }
 
-func (_c *MockTransactionRepository_GetRecentTransactions_Call) Return(_a0 []*domain.Transaction, _a1 error) *MockTransactionRepository_GetRecentTransactions_Call {
+func (_c *MockTransactionRepository_PurgeDeletedBefore_Call) Return(_a0 []int, _a1 error) *MockTransactionRepository_PurgeDeletedBefore_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockTransactionRepository_GetRecentTransactions_Call) RunAndReturn(run func(context.Context, int) ([]*domain.Transaction, error)) *MockTransactionRepository_GetRecentTransactions_Call {
+func (_c *MockTransactionRepository_PurgeDeletedBefore_Call) RunAndReturn(run func(context.Context, time.Time) ([]int, error)) *MockTransactionRepository_PurgeDeletedBefore_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetTotalByDateRange provides a mock function with given fields: ctx, start, end, transactionType
-func (_m *MockTransactionRepository) GetTotalByDateRange(ctx context.Context, start time.Time, end time.Time, transactionType string) (float64, error) {
-	ret := _m.Called(ctx, start, end, transactionType)
+// Reconcile provides a mock function with given fields: ctx, statement, ids
+func (_m *MockTransactionRepository) Reconcile(ctx context.Context, statement *domain.Statement, ids []int) error {
+	ret := _m.Called(ctx, statement, ids)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetTotalByDateRange")
-	}
-
-	var r0 float64
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, string) (float64, error)); ok {
-		return rf(ctx, start, end, transactionType)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, string) float64); ok {
-		r0 = rf(ctx, start, end, transactionType)
-	} else {
-		r0 = ret.Get(0).(float64)
+		panic("no return value specified for Reconcile")
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, time.Time, time.Time, string) error); ok {
-		r1 = rf(ctx, start, end, transactionType)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Statement, []int) error); ok {
+		r0 = rf(ctx, statement, ids)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockTransactionRepository_GetTotalByDateRange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTotalByDateRange'
-type MockTransactionRepository_GetTotalByDateRange_Call struct {
+// MockTransactionRepository_Reconcile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reconcile'
+type MockTransactionRepository_Reconcile_Call struct {
 	*mock.Call
 }
 
-// GetTotalByDateRange is a helper method to define mock.On call
+// Reconcile is a helper method to define mock.On call
 //   - ctx context.Context
-//   - start time.Time
-//   - end time.Time
-//   - transactionType string
-func (_e *MockTransactionRepository_Expecter) GetTotalByDateRange(ctx interface{}, start interface{}, end interface{}, transactionType interface{}) *MockTransactionRepository_GetTotalByDateRange_Call {
-	return &MockTransactionRepository_GetTotalByDateRange_Call{Call: _e.mock.On("GetTotalByDateRange", ctx, start, end, transactionType)}
+//   - statement *domain.Statement
+//   - ids []int
+func (_e *MockTransactionRepository_Expecter) Reconcile(ctx interface{}, statement interface{}, ids interface{}) *MockTransactionRepository_Reconcile_Call {
+	return &MockTransactionRepository_Reconcile_Call{Call: _e.mock.On("Reconcile", ctx, statement, ids)}
 }
 
-func (_c *MockTransactionRepository_GetTotalByDateRange_Call) Run(run func(ctx context.Context, start time.Time, end time.Time, transactionType string)) *MockTransactionRepository_GetTotalByDateRange_Call {
+func (_c *MockTransactionRepository_Reconcile_Call) Run(run func(ctx context.Context, statement *domain.Statement, ids []int)) *MockTransactionRepository_Reconcile_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(time.Time), args[2].(time.Time), args[3].(string))
+		run(args[0].(context.Context), args[1].(*domain.Statement), args[2].([]int))
 	})
 	return _c
 }
 
-func (_c *MockTransactionRepository_GetTotalByDateRange_Call) Return(_a0 float64, _a1 error) *MockTransactionRepository_GetTotalByDateRange_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockTransactionRepository_Reconcile_Call) Return(_a0 error) *MockTransactionRepository_Reconcile_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockTransactionRepository_GetTotalByDateRange_Call) RunAndReturn(run func(context.Context, time.Time, time.Time, string) (float64, error)) *MockTransactionRepository_GetTotalByDateRange_Call {
+func (_c *MockTransactionRepository_Reconcile_Call) RunAndReturn(run func(context.Context, *domain.Statement, []int) error) *MockTransactionRepository_Reconcile_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetTransactionCountByDateRange provides a mock function with given fields: ctx, start, end, transactionType
-func (_m *MockTransactionRepository) GetTransactionCountByDateRange(ctx context.Context, start time.Time, end time.Time, transactionType string) (int, error) {
-	ret := _m.Called(ctx, start, end, transactionType)
+// RestoreDeleted provides a mock function with given fields: ctx, ids
+func (_m *MockTransactionRepository) RestoreDeleted(ctx context.Context, ids []int) (int, error) {
+	ret := _m.Called(ctx, ids)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetTransactionCountByDateRange")
+		panic("no return value specified for RestoreDeleted")
 	}
 
 	var r0 int
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, string) (int, error)); ok {
-		return rf(ctx, start, end, transactionType)
+	if rf, ok := ret.Get(0).(func(context.Context, []int) (int, error)); ok {
+		return rf(ctx, ids)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, string) int); ok {
-		r0 = rf(ctx, start, end, transactionType)
+	if rf, ok := ret.Get(0).(func(context.Context, []int) int); ok {
+		r0 = rf(ctx, ids)
 	} else {
 		r0 = ret.Get(0).(int)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, time.Time, time.Time, string) error); ok {
-		r1 = rf(ctx, start, end, transactionType)
+	if rf, ok := ret.Get(1).(func(context.Context, []int) error); ok {
+		r1 = rf(ctx, ids)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -625,33 +1928,31 @@ func (_m *MockTransactionRepository) GetTransactionCountByDateRange(ctx context.
 	return r0, r1
 }
 
-// MockTransactionRepository_GetTransactionCountByDateRange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTransactionCountByDateRange'
-type MockTransactionRepository_GetTransactionCountByDateRange_Call struct {
+// MockTransactionRepository_RestoreDeleted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreDeleted'
+type MockTransactionRepository_RestoreDeleted_Call struct {
 	*mock.Call
 }
 
-// GetTransactionCountByDateRange is a helper method to define mock.On call
+// RestoreDeleted is a helper method to define mock.On call
 //   - ctx context.Context
-//   - start time.Time
-//   - end time.Time
-//   - transactionType string
-func (_e *MockTransactionRepository_Expecter) GetTransactionCountByDateRange(ctx interface{}, start interface{}, end interface{}, transactionType interface{}) *MockTransactionRepository_GetTransactionCountByDateRange_Call {
-	return &MockTransactionRepository_GetTransactionCountByDateRange_Call{Call: _e.mock.On("GetTransactionCountByDateRange", ctx, start, end, transactionType)}
+//   - ids []int
+func (_e *MockTransactionRepository_Expecter) RestoreDeleted(ctx interface{}, ids interface{}) *MockTransactionRepository_RestoreDeleted_Call {
+	return &MockTransactionRepository_RestoreDeleted_Call{Call: _e.mock.On("RestoreDeleted", ctx, ids)}
 }
 
-func (_c *MockTransactionRepository_GetTransactionCountByDateRange_Call) Run(run func(ctx context.Context, start time.Time, end time.Time, transactionType string)) *MockTransactionRepository_GetTransactionCountByDateRange_Call {
+func (_c *MockTransactionRepository_RestoreDeleted_Call) Run(run func(ctx context.Context, ids []int)) *MockTransactionRepository_RestoreDeleted_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(time.Time), args[2].(time.Time), args[3].(string))
+		run(args[0].(context.Context), args[1].([]int))
 	})
 	return _c
 }
 
-func (_c *MockTransactionRepository_GetTransactionCountByDateRange_Call) Return(_a0 int, _a1 error) *MockTransactionRepository_GetTransactionCountByDateRange_Call {
+func (_c *MockTransactionRepository_RestoreDeleted_Call) Return(_a0 int, _a1 error) *MockTransactionRepository_RestoreDeleted_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockTransactionRepository_GetTransactionCountByDateRange_Call) RunAndReturn(run func(context.Context, time.Time, time.Time, string) (int, error)) *MockTransactionRepository_GetTransactionCountByDateRange_Call {
+func (_c *MockTransactionRepository_RestoreDeleted_Call) RunAndReturn(run func(context.Context, []int) (int, error)) *MockTransactionRepository_RestoreDeleted_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -717,6 +2018,64 @@ func (_c *MockTransactionRepository_SearchTransactions_Call) RunAndReturn(run fu
 	return _c
 }
 
+// SetStatus provides a mock function with given fields: ctx, ids, status
+func (_m *MockTransactionRepository) SetStatus(ctx context.Context, ids []int, status domain.TransactionStatus) (int, error) {
+	ret := _m.Called(ctx, ids, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetStatus")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int, domain.TransactionStatus) (int, error)); ok {
+		return rf(ctx, ids, status)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int, domain.TransactionStatus) int); ok {
+		r0 = rf(ctx, ids, status)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int, domain.TransactionStatus) error); ok {
+		r1 = rf(ctx, ids, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTransactionRepository_SetStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetStatus'
+type MockTransactionRepository_SetStatus_Call struct {
+	*mock.Call
+}
+
+// SetStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []int
+//   - status domain.TransactionStatus
+func (_e *MockTransactionRepository_Expecter) SetStatus(ctx interface{}, ids interface{}, status interface{}) *MockTransactionRepository_SetStatus_Call {
+	return &MockTransactionRepository_SetStatus_Call{Call: _e.mock.On("SetStatus", ctx, ids, status)}
+}
+
+func (_c *MockTransactionRepository_SetStatus_Call) Run(run func(ctx context.Context, ids []int, status domain.TransactionStatus)) *MockTransactionRepository_SetStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]int), args[2].(domain.TransactionStatus))
+	})
+	return _c
+}
+
+func (_c *MockTransactionRepository_SetStatus_Call) Return(_a0 int, _a1 error) *MockTransactionRepository_SetStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTransactionRepository_SetStatus_Call) RunAndReturn(run func(context.Context, []int, domain.TransactionStatus) (int, error)) *MockTransactionRepository_SetStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function with given fields: ctx, transaction
 func (_m *MockTransactionRepository) Update(ctx context.Context, transaction *domain.Transaction) error {
 	ret := _m.Called(ctx, transaction)